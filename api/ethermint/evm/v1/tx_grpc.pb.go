@@ -22,8 +22,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_EthereumTx_FullMethodName   = "/ethermint.evm.v1.Msg/EthereumTx"
-	Msg_UpdateParams_FullMethodName = "/ethermint.evm.v1.Msg/UpdateParams"
+	Msg_EthereumTx_FullMethodName           = "/ethermint.evm.v1.Msg/EthereumTx"
+	Msg_UpdateParams_FullMethodName         = "/ethermint.evm.v1.Msg/UpdateParams"
+	Msg_SetFeeSponsorship_FullMethodName    = "/ethermint.evm.v1.Msg/SetFeeSponsorship"
+	Msg_RevokeFeeSponsorship_FullMethodName = "/ethermint.evm.v1.Msg/RevokeFeeSponsorship"
+	Msg_SetFeeSponsor_FullMethodName        = "/ethermint.evm.v1.Msg/SetFeeSponsor"
 )
 
 // MsgClient is the client API for Msg service.
@@ -35,6 +38,14 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetFeeSponsorship defines a method for a sponsor to create or replace the
+	// allowance used to pay the fees of EVM transactions.
+	SetFeeSponsorship(ctx context.Context, in *MsgSetFeeSponsorship, opts ...grpc.CallOption) (*MsgSetFeeSponsorshipResponse, error)
+	// RevokeFeeSponsorship defines a method for a sponsor to revoke its allowance.
+	RevokeFeeSponsorship(ctx context.Context, in *MsgRevokeFeeSponsorship, opts ...grpc.CallOption) (*MsgRevokeFeeSponsorshipResponse, error)
+	// SetFeeSponsor defines a method for an account to choose the sponsor paying
+	// the fees of its EVM transactions.
+	SetFeeSponsor(ctx context.Context, in *MsgSetFeeSponsor, opts ...grpc.CallOption) (*MsgSetFeeSponsorResponse, error)
 }
 
 type msgClient struct {
@@ -63,6 +74,33 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetFeeSponsorship(ctx context.Context, in *MsgSetFeeSponsorship, opts ...grpc.CallOption) (*MsgSetFeeSponsorshipResponse, error) {
+	out := new(MsgSetFeeSponsorshipResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFeeSponsorship_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeFeeSponsorship(ctx context.Context, in *MsgRevokeFeeSponsorship, opts ...grpc.CallOption) (*MsgRevokeFeeSponsorshipResponse, error) {
+	out := new(MsgRevokeFeeSponsorshipResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeFeeSponsorship_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeeSponsor(ctx context.Context, in *MsgSetFeeSponsor, opts ...grpc.CallOption) (*MsgSetFeeSponsorResponse, error) {
+	out := new(MsgSetFeeSponsorResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFeeSponsor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -72,6 +110,14 @@ type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetFeeSponsorship defines a method for a sponsor to create or replace the
+	// allowance used to pay the fees of EVM transactions.
+	SetFeeSponsorship(context.Context, *MsgSetFeeSponsorship) (*MsgSetFeeSponsorshipResponse, error)
+	// RevokeFeeSponsorship defines a method for a sponsor to revoke its allowance.
+	RevokeFeeSponsorship(context.Context, *MsgRevokeFeeSponsorship) (*MsgRevokeFeeSponsorshipResponse, error)
+	// SetFeeSponsor defines a method for an account to choose the sponsor paying
+	// the fees of its EVM transactions.
+	SetFeeSponsor(context.Context, *MsgSetFeeSponsor) (*MsgSetFeeSponsorResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -85,6 +131,15 @@ func (UnimplementedMsgServer) EthereumTx(context.Context, *MsgEthereumTx) (*MsgE
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) SetFeeSponsorship(context.Context, *MsgSetFeeSponsorship) (*MsgSetFeeSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeSponsorship not implemented")
+}
+func (UnimplementedMsgServer) RevokeFeeSponsorship(context.Context, *MsgRevokeFeeSponsorship) (*MsgRevokeFeeSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeFeeSponsorship not implemented")
+}
+func (UnimplementedMsgServer) SetFeeSponsor(context.Context, *MsgSetFeeSponsor) (*MsgSetFeeSponsorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeSponsor not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -134,6 +189,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetFeeSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFeeSponsorship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeSponsorship(ctx, req.(*MsgSetFeeSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeFeeSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeFeeSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeFeeSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeFeeSponsorship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeFeeSponsorship(ctx, req.(*MsgRevokeFeeSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeSponsor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeSponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFeeSponsor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeSponsor(ctx, req.(*MsgSetFeeSponsor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -149,6 +258,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetFeeSponsorship",
+			Handler:    _Msg_SetFeeSponsorship_Handler,
+		},
+		{
+			MethodName: "RevokeFeeSponsorship",
+			Handler:    _Msg_RevokeFeeSponsorship_Handler,
+		},
+		{
+			MethodName: "SetFeeSponsor",
+			Handler:    _Msg_SetFeeSponsor_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/tx.proto",
@@ -22,9 +22,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName   = "/ethermint.feemarket.v1.Query/Params"
-	Query_BaseFee_FullMethodName  = "/ethermint.feemarket.v1.Query/BaseFee"
-	Query_BlockGas_FullMethodName = "/ethermint.feemarket.v1.Query/BlockGas"
+	Query_Params_FullMethodName       = "/ethermint.feemarket.v1.Query/Params"
+	Query_BaseFee_FullMethodName      = "/ethermint.feemarket.v1.Query/BaseFee"
+	Query_BlockGas_FullMethodName     = "/ethermint.feemarket.v1.Query/BlockGas"
+	Query_FeeTokenRate_FullMethodName = "/ethermint.feemarket.v1.Query/FeeTokenRate"
 )
 
 // QueryClient is the client API for Query service.
@@ -37,6 +38,8 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// FeeTokenRate queries the current conversion rate of a fee token
+	FeeTokenRate(ctx context.Context, in *QueryFeeTokenRateRequest, opts ...grpc.CallOption) (*QueryFeeTokenRateResponse, error)
 }
 
 type queryClient struct {
@@ -74,6 +77,15 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) FeeTokenRate(ctx context.Context, in *QueryFeeTokenRateRequest, opts ...grpc.CallOption) (*QueryFeeTokenRateResponse, error) {
+	out := new(QueryFeeTokenRateResponse)
+	err := c.cc.Invoke(ctx, Query_FeeTokenRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -84,6 +96,8 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// FeeTokenRate queries the current conversion rate of a fee token
+	FeeTokenRate(context.Context, *QueryFeeTokenRateRequest) (*QueryFeeTokenRateResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -100,6 +114,9 @@ func (UnimplementedQueryServer) BaseFee(context.Context, *QueryBaseFeeRequest) (
 func (UnimplementedQueryServer) BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (UnimplementedQueryServer) FeeTokenRate(context.Context, *QueryFeeTokenRateRequest) (*QueryFeeTokenRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeTokenRate not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -167,6 +184,24 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeTokenRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTokenRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeTokenRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeTokenRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeTokenRate(ctx, req.(*QueryFeeTokenRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -186,6 +221,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "FeeTokenRate",
+			Handler:    _Query_FeeTokenRate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/query.proto",
@@ -22,7 +22,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/ethermint.feemarket.v1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName          = "/ethermint.feemarket.v1.Msg/UpdateParams"
+	Msg_SetFeeTokenRate_FullMethodName       = "/ethermint.feemarket.v1.Msg/SetFeeTokenRate"
+	Msg_SetFeeTokenPreference_FullMethodName = "/ethermint.feemarket.v1.Msg/SetFeeTokenPreference"
 )
 
 // MsgClient is the client API for Msg service.
@@ -32,6 +34,12 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/feemarket module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetFeeTokenRate defines a method for the oracle of a fee token to update
+	// its conversion rate to the EVM denom.
+	SetFeeTokenRate(ctx context.Context, in *MsgSetFeeTokenRate, opts ...grpc.CallOption) (*MsgSetFeeTokenRateResponse, error)
+	// SetFeeTokenPreference defines a method for an account to choose the fee
+	// token used to pay the fees of its EVM transactions.
+	SetFeeTokenPreference(ctx context.Context, in *MsgSetFeeTokenPreference, opts ...grpc.CallOption) (*MsgSetFeeTokenPreferenceResponse, error)
 }
 
 type msgClient struct {
@@ -51,6 +59,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetFeeTokenRate(ctx context.Context, in *MsgSetFeeTokenRate, opts ...grpc.CallOption) (*MsgSetFeeTokenRateResponse, error) {
+	out := new(MsgSetFeeTokenRateResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFeeTokenRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeeTokenPreference(ctx context.Context, in *MsgSetFeeTokenPreference, opts ...grpc.CallOption) (*MsgSetFeeTokenPreferenceResponse, error) {
+	out := new(MsgSetFeeTokenPreferenceResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFeeTokenPreference_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -58,6 +84,12 @@ type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/feemarket module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetFeeTokenRate defines a method for the oracle of a fee token to update
+	// its conversion rate to the EVM denom.
+	SetFeeTokenRate(context.Context, *MsgSetFeeTokenRate) (*MsgSetFeeTokenRateResponse, error)
+	// SetFeeTokenPreference defines a method for an account to choose the fee
+	// token used to pay the fees of its EVM transactions.
+	SetFeeTokenPreference(context.Context, *MsgSetFeeTokenPreference) (*MsgSetFeeTokenPreferenceResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -68,6 +100,12 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) SetFeeTokenRate(context.Context, *MsgSetFeeTokenRate) (*MsgSetFeeTokenRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeTokenRate not implemented")
+}
+func (UnimplementedMsgServer) SetFeeTokenPreference(context.Context, *MsgSetFeeTokenPreference) (*MsgSetFeeTokenPreferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeTokenPreference not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -99,6 +137,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetFeeTokenRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeTokenRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeTokenRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFeeTokenRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeTokenRate(ctx, req.(*MsgSetFeeTokenRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeTokenPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeTokenPreference)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeTokenPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFeeTokenPreference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeTokenPreference(ctx, req.(*MsgSetFeeTokenPreference))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -110,6 +184,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetFeeTokenRate",
+			Handler:    _Msg_SetFeeTokenRate_Handler,
+		},
+		{
+			MethodName: "SetFeeTokenPreference",
+			Handler:    _Msg_SetFeeTokenPreference_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/tx.proto",
@@ -8,12 +8,21 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
 // maxNestedMsgs defines a cap for the number of nested messages on a MsgExec message
 const maxNestedMsgs = 7
 
+// DisabledAuthzMsgs defines the type urls of the msgs that cannot be granted or
+// executed within the authorization module.
+var DisabledAuthzMsgs = []string{
+	sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
+	sdk.MsgTypeURL(&sdkvesting.MsgCreateVestingAccount{}),
+}
+
 // AuthzLimiterDecorator blocks certain msg types from being granted or executed
 // within the authorization module.
 type AuthzLimiterDecorator struct {
@@ -35,6 +44,13 @@ func (ald AuthzLimiterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	return next(ctx, tx, simulate)
 }
 
+// CheckDisabledMsgs returns an error if the given msgs are authz msgs granting
+// or executing any of the disabled msg types. It allows to enforce the same
+// restrictions outside of the AnteHandler, e.g. on the authz precompile.
+func (ald AuthzLimiterDecorator) CheckDisabledMsgs(msgs []sdk.Msg) error {
+	return ald.checkDisabledMsgs(msgs, false, 1)
+}
+
 // checkDisabledMsgs iterates through the msgs and returns an error if it finds any unauthorized msgs.
 //
 // When searchOnlyInAuthzMsgs is enabled, only authz MsgGrant and MsgExec are blocked, if they contain unauthorized msg types.
@@ -54,6 +54,44 @@ func checkDisabledCreateCall(
 	return nil
 }
 
+// CheckSetCodeTxActivated returns an error if the transaction is an EIP-7702 set
+// code transaction and the Prague fork of the chain config isn't activated at
+// the given block.
+func CheckSetCodeTxActivated(txData evmtypes.TxData, chainConfig *evmtypes.ChainConfig, blockNum *big.Int) error {
+	if txData.TxType() != evmtypes.SetCodeTxType || chainConfig.IsPrague(blockNum) {
+		return nil
+	}
+	return errorsmod.Wrapf(evmtypes.ErrSetCodeTxNotActivated, "block %s", blockNum)
+}
+
+// ValidateAuthorizations checks that the EIP-7702 authorizations of set code
+// transactions are signed for the given chain, or for all chains, and that their
+// authorities can be recovered.
+//
+// NOTE: the invalid authorizations are skipped on execution without failing the
+// transaction, so this check is only meant to keep them out of the mempool.
+func ValidateAuthorizations(txData evmtypes.TxData, chainID *big.Int) error {
+	setCodeTx, ok := txData.(*evmtypes.SetCodeTx)
+	if !ok {
+		return nil
+	}
+
+	for i, auth := range setCodeTx.GetAuthorizations() {
+		if authChainID := auth.GetChainID(); authChainID.Sign() != 0 && authChainID.Cmp(chainID) != 0 {
+			return errorsmod.Wrapf(
+				evmtypes.ErrInvalidAuthorization,
+				"authorization %d: chain ID %s doesn't match %s", i, authChainID, chainID,
+			)
+		}
+
+		if _, err := auth.Authority(); err != nil {
+			return errorsmod.Wrapf(evmtypes.ErrInvalidAuthorization, "authorization %d: %s", i, err)
+		}
+	}
+
+	return nil
+}
+
 // ValidateTx validates an Ethereum specific transaction type and returns an error if invalid.
 //
 // FIXME: this shouldn't be required if the tx was an Ethereum transaction type.
@@ -104,7 +142,8 @@ func ValidateTx(tx sdktypes.Tx) (*tx.Fee, error) {
 // CheckTxFee checks if the Amount and GasLimit fields of the txFeeInfo input
 // are equal to the txFee coins and the txGasLimit value.
 // The function expects txFeeInfo to contains coins in the original decimal
-// representation.
+// representation. The Amount is always expressed in the EVM denom, even when
+// the fees are paid with an alternate fee token.
 func CheckTxFee(txFeeInfo *tx.Fee, txFee *big.Int, txGasLimit uint64) error {
 	if txFeeInfo == nil {
 		return nil
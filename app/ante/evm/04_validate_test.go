@@ -5,6 +5,7 @@ package evm_test
 import (
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
@@ -194,6 +195,127 @@ func (suite *EvmAnteTestSuite) TestValidateMsg() {
 	}
 }
 
+func (suite *EvmAnteTestSuite) TestCheckSetCodeTxActivated() {
+	chainConfig := evmtypes.DefaultChainConfig("")
+	pragueBlock := sdkmath.NewInt(10)
+	chainConfig.PragueBlock = &pragueBlock
+
+	txArgs := getTxByType("transfer", testkeyring.New(1).GetAddr(0))
+	transferTx, err := txArgs.ToTxData()
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name          string
+		expectedError error
+		txData        evmtypes.TxData
+		blockNum      int64
+	}{
+		{
+			name:     "success: not a set code tx",
+			txData:   transferTx,
+			blockNum: 1,
+		},
+		{
+			name:     "success: set code tx after the fork",
+			txData:   &evmtypes.SetCodeTx{},
+			blockNum: 10,
+		},
+		{
+			name:          "fail: set code tx before the fork",
+			expectedError: evmtypes.ErrSetCodeTxNotActivated,
+			txData:        &evmtypes.SetCodeTx{},
+			blockNum:      9,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Function under test
+			err := evm.CheckSetCodeTxActivated(tc.txData, chainConfig, big.NewInt(tc.blockNum))
+
+			if tc.expectedError != nil {
+				suite.Require().ErrorIs(err, tc.expectedError)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *EvmAnteTestSuite) TestValidateAuthorizations() {
+	keyring := testkeyring.New(2)
+	chainID := big.NewInt(9001)
+
+	signedAuth := func(authChainID int64) evmtypes.SetCodeAuthorization {
+		auth := evmtypes.SetCodeAuthorization{
+			ChainID: sdkmath.NewInt(authChainID),
+			Address: keyring.GetAddr(1).Hex(),
+		}
+		sig, err := keyring.Sign(0, auth.SigHash().Bytes())
+		suite.Require().NoError(err)
+		auth.R, auth.S, auth.V = sig[:32], sig[32:64], big.NewInt(int64(sig[64])).Bytes()
+		return auth
+	}
+
+	testCases := []struct {
+		name          string
+		expectedError error
+		getTxData     func() evmtypes.TxData
+	}{
+		{
+			name: "success: not a set code tx",
+			getTxData: func() evmtypes.TxData {
+				txArgs := getTxByType("transfer", keyring.GetAddr(1))
+				txData, err := txArgs.ToTxData()
+				suite.Require().NoError(err)
+				return txData
+			},
+		},
+		{
+			name: "success: authorizations for the chain and for all chains",
+			getTxData: func() evmtypes.TxData {
+				return &evmtypes.SetCodeTx{
+					Authorizations: evmtypes.AuthorizationList{signedAuth(0), signedAuth(chainID.Int64())},
+				}
+			},
+		},
+		{
+			name:          "fail: authorization for another chain",
+			expectedError: evmtypes.ErrInvalidAuthorization,
+			getTxData: func() evmtypes.TxData {
+				return &evmtypes.SetCodeTx{
+					Authorizations: evmtypes.AuthorizationList{signedAuth(1)},
+				}
+			},
+		},
+		{
+			name:          "fail: invalid signature",
+			expectedError: evmtypes.ErrInvalidAuthorization,
+			getTxData: func() evmtypes.TxData {
+				auth := signedAuth(0)
+				auth.R = nil
+				return &evmtypes.SetCodeTx{
+					Authorizations: evmtypes.AuthorizationList{auth},
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Function under test
+			err := evm.ValidateAuthorizations(tc.getTxData(), chainID)
+
+			if tc.expectedError != nil {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.expectedError.Error())
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
 func getTxByType(typeTx string, recipient common.Address) evmtypes.EvmTxArgs {
 	switch typeTx {
 	case "call":
@@ -65,6 +65,23 @@ func SignatureVerification(
 	signer ethtypes.Signer,
 	allowUnprotectedTxs bool,
 ) error {
+	txData, err := evmtypes.UnpackTxData(msg.Data)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to unpack tx data")
+	}
+
+	// set code txs are always protected and signed with their own signature hash
+	if txData.TxType() == evmtypes.SetCodeTxType {
+		if _, err := msg.GetSender(signer.ChainID()); err != nil {
+			return errorsmod.Wrapf(
+				errortypes.ErrorInvalidSigner,
+				"couldn't retrieve sender address from the ethereum transaction: %s",
+				err.Error(),
+			)
+		}
+		return nil
+	}
+
 	ethTx := msg.AsTransaction()
 
 	if !allowUnprotectedTxs && !ethTx.Protected() {
@@ -86,3 +103,30 @@ func SignatureVerification(
 	msg.From = sender.Hex()
 	return nil
 }
+
+// GetTxSender returns the sender of the Ethereum message of the transaction,
+// recovered from its signature, before the signature verification. It's used
+// to look up the fee options chosen by the sender, which must not be taken
+// from the unsigned fields of the transaction. A nil sender is returned if the
+// transaction doesn't hold an Ethereum message or if its signature is
+// invalid, in which case it's rejected by the signature verification.
+func GetTxSender(tx sdk.Tx, chainID *big.Int) sdk.AccAddress {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	// the protocol only supports a single Ethereum message per transaction
+	ethMsg, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return nil
+	}
+
+	// the sender is recovered on a copy since the From field must be left
+	// empty until the signature verification
+	from, err := (&evmtypes.MsgEthereumTx{Data: ethMsg.Data}).GetSender(chainID)
+	if err != nil {
+		return nil
+	}
+	return from.Bytes()
+}
@@ -8,25 +8,30 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	"github.com/evmos/evmos/v20/x/evm/keeper"
 	"github.com/evmos/evmos/v20/x/evm/statedb"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
 // VerifyAccountBalance checks that the account balance is greater than the total transaction cost.
+// When the sender doesn't pay the fees in the EVM denom, i.e. they are paid with an alternate
+// fee token or by a fee sponsor, the balance only needs to cover the value of the transaction.
 // The account will be set to store if it doesn't exist, i.e. cannot be found on store.
 // This method will fail if:
-// - from address is NOT an EOA
+// - from address is NOT an EOA, or an EOA that delegates its code (EIP-7702)
 // - account balance is lower than the transaction cost
 func VerifyAccountBalance(
 	ctx sdk.Context,
 	accountKeeper evmtypes.AccountKeeper,
+	evmKeeper EVMKeeper,
 	account *statedb.Account,
 	from common.Address,
 	txData evmtypes.TxData,
+	payFees bool,
 ) error {
 	// Only EOA are allowed to send transactions.
-	if account != nil && account.IsContract() {
+	if account != nil && account.IsContract() && !isDelegated(ctx, evmKeeper, account) {
 		return errorsmod.Wrapf(
 			errortypes.ErrInvalidType,
 			"the sender is not EOA: address %s", from,
@@ -39,9 +44,27 @@ func VerifyAccountBalance(
 		account = statedb.NewEmptyAccount()
 	}
 
-	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance), txData); err != nil {
+	balance := sdkmath.NewIntFromBigInt(account.Balance)
+	if !payFees {
+		if balance.BigInt().Cmp(txData.GetValue()) < 0 {
+			return errorsmod.Wrapf(
+				errortypes.ErrInsufficientFunds,
+				"failed to check sender balance: sender balance < tx value (%s < %s)", balance, txData.GetValue(),
+			)
+		}
+		return nil
+	}
+
+	if err := keeper.CheckSenderBalance(balance, txData); err != nil {
 		return errorsmod.Wrap(err, "failed to check sender balance")
 	}
 
 	return nil
 }
+
+// isDelegated returns true if the code of the account is an EIP-7702 delegation
+// designator.
+func isDelegated(ctx sdk.Context, evmKeeper EVMKeeper, account *statedb.Account) bool {
+	_, ok := vm.ParseDelegation(evmKeeper.GetCode(ctx, common.BytesToHash(account.CodeHash)))
+	return ok
+}
@@ -108,9 +108,11 @@ func (suite *EvmAnteTestSuite) TestVerifyAccountBalance() {
 			err = evm.VerifyAccountBalance(
 				unitNetwork.GetContext(),
 				unitNetwork.App.AccountKeeper,
+				unitNetwork.App.EvmKeeper,
 				statedbAccount,
 				senderKey.Addr,
 				txData,
+				true,
 			)
 
 			if tc.expectedError != nil {
@@ -5,7 +5,10 @@ package evm_test
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v20/app/ante/evm"
+	evmosmempool "github.com/evmos/evmos/v20/app/mempool"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/grpc"
 	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
@@ -70,3 +73,84 @@ func (suite *EvmAnteTestSuite) TestIncrementSequence() {
 		})
 	}
 }
+
+// mockTxPool is a transaction pool holding a single transaction.
+type mockTxPool struct {
+	hash        common.Hash
+	sender      common.Address
+	nonce       uint64
+	maxNonceGap uint64
+}
+
+func (p mockTxPool) Has(hash common.Hash) bool { return hash == p.hash }
+
+func (p mockTxPool) HasNonce(sender common.Address, nonce uint64) bool {
+	return sender == p.sender && nonce == p.nonce
+}
+
+func (p mockTxPool) CanQueue(_ common.Address, accountNonce, nonce uint64) error {
+	if nonce-accountNonce > p.maxNonceGap {
+		return evmosmempool.ErrNonceGap
+	}
+	return nil
+}
+
+func (suite *EvmAnteTestSuite) TestCheckTxNonce() {
+	keyring := testkeyring.New(1)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	accAddr := keyring.GetAccAddr(0)
+	from := keyring.GetAddr(0)
+	pooledHash := common.HexToHash("0x01")
+	const sequence = uint64(5)
+
+	testCases := []struct {
+		name          string
+		nonce         uint64
+		recheck       bool
+		expectedError error
+		expSequence   uint64
+	}{
+		{"success: next nonce increments sequence", sequence, false, nil, sequence + 1},
+		{"success: future nonce is queued", sequence + 2, false, nil, sequence},
+		{"fail: future nonce beyond the nonce gap", sequence + 4, false, evmosmempool.ErrNonceGap, sequence},
+		{"success: replacement of a pooled tx", sequence - 2, false, nil, sequence},
+		{"fail: stale nonce", sequence - 1, false, errortypes.ErrInvalidSequence, sequence},
+		{"fail: recheck of a tx no longer in the pool", sequence, true, mempool.ErrTxNotFound, sequence},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			account, err := grpcHandler.GetAccount(accAddr.String())
+			suite.Require().NoError(err)
+			suite.Require().NoError(account.SetSequence(sequence))
+
+			ctx := unitNetwork.GetContext().WithIsCheckTx(true).WithIsReCheckTx(tc.recheck)
+			txPool := mockTxPool{hash: pooledHash, sender: from, nonce: sequence - 2, maxNonceGap: 3}
+			txHash := pooledHash
+			if tc.recheck {
+				txHash = common.HexToHash("0x02")
+			}
+
+			// Function under test
+			err = evm.CheckTxNonce(
+				ctx,
+				unitNetwork.App.AccountKeeper,
+				txPool,
+				account,
+				from,
+				txHash,
+				tc.nonce,
+			)
+
+			if tc.expectedError != nil {
+				suite.Require().ErrorIs(err, tc.expectedError)
+			} else {
+				suite.Require().NoError(err)
+			}
+			suite.Require().Equal(tc.expSequence, account.GetSequence())
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/ethereum/go-ethereum/common"
 
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
@@ -38,3 +40,40 @@ func IncrementNonce(
 	accountKeeper.SetAccount(ctx, account)
 	return nil
 }
+
+// CheckTxNonce verifies the nonce of a transaction against the app-side mempool
+// in CheckTx. A transaction with the next nonce of the account increments the
+// sequence, while a transaction with a future nonce is accepted without doing
+// so in order to be queued by the mempool, as long as it's within the nonce gap
+// and per-sender limits of the mempool. A transaction reusing the nonce of a
+// pooled transaction is accepted as a replacement, whose price bump is enforced
+// by the mempool on insertion. On ReCheckTx, the transactions that are no longer
+// in the mempool (i.e. replaced, evicted, expired or stale ones) are rejected.
+func CheckTxNonce(
+	ctx sdk.Context,
+	accountKeeper evmtypes.AccountKeeper,
+	txPool TxPool,
+	account sdk.AccountI,
+	from common.Address,
+	txHash common.Hash,
+	txNonce uint64,
+) error {
+	if ctx.IsReCheckTx() && !txPool.Has(txHash) {
+		return errorsmod.Wrapf(mempool.ErrTxNotFound, "tx %s", txHash.Hex())
+	}
+
+	nonce := account.GetSequence()
+	switch {
+	case txNonce == nonce:
+		return IncrementNonce(ctx, accountKeeper, account, txNonce)
+	case txPool.HasNonce(from, txNonce):
+		return nil
+	case txNonce > nonce:
+		return txPool.CanQueue(from, nonce, txNonce)
+	default:
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidSequence,
+			"invalid nonce; got %d, expected %d", txNonce, nonce,
+		)
+	}
+}
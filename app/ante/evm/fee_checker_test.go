@@ -41,6 +41,14 @@ func (m MockFeemarketKeeper) GetParams(_ sdk.Context) (params feemarkettypes.Par
 	return feemarkettypes.DefaultParams()
 }
 
+func (m MockFeemarketKeeper) GetFeeTokenRate(_ sdk.Context, _ string) (math.LegacyDec, error) {
+	return math.LegacyDec{}, feemarkettypes.ErrFeeTokenNotFound
+}
+
+func (m MockFeemarketKeeper) GetAccountFeeToken(_ sdk.Context, _ sdk.AccAddress) (string, bool) {
+	return "", false
+}
+
 func TestSDKTxFeeChecker(t *testing.T) {
 	// testCases:
 	//   fallback
@@ -0,0 +1,29 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package evm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetTxFeeSponsor returns the sponsor paying the fees of the transaction,
+// which is chosen by the sender of the Ethereum message through a Cosmos
+// transaction signed beforehand. The sponsor agrees to pay the fees through
+// its fee sponsorship, whose scope is checked when the fees are deducted. A
+// nil sponsor is returned when the fees are paid by the sender, which is also
+// the case when the chosen sponsor has no fee sponsorship anymore, so that the
+// sender isn't prevented from sending transactions.
+//
+// NOTE: the fee granter of the Cosmos transaction wrapping the Ethereum
+// message isn't covered by the Ethereum signature, so it can't be used to
+// choose the sponsor.
+func GetTxFeeSponsor(ctx sdk.Context, evmKeeper EVMKeeper, sender sdk.AccAddress) sdk.AccAddress {
+	sponsor, found := evmKeeper.GetAccountFeeSponsor(ctx, sender)
+	if !found {
+		return nil
+	}
+	if _, found := evmKeeper.GetFeeSponsorship(ctx, sponsor); !found {
+		return nil
+	}
+	return sponsor
+}
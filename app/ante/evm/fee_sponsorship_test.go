@@ -0,0 +1,156 @@
+package evm_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v20/app/ante/evm"
+	commonfactory "github.com/evmos/evmos/v20/testutil/integration/common/factory"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/factory"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/grpc"
+	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (suite *EvmAnteTestSuite) TestFeeSponsorship() {
+	gasPrice := big.NewInt(1e9)
+	gasLimit := uint64(100_000)
+	value := big.NewInt(1000)
+	maxFee := math.NewIntFromBigInt(new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gasLimit)))
+
+	keyring := testkeyring.New(3)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+	txConfig := unitNetwork.GetEncodingConfig().TxConfig
+	denom := evmtypes.GetEVMCoinDenom()
+	sender := keyring.GetKey(0)
+	receiver := keyring.GetAddr(1)
+	sponsor := keyring.GetKey(2)
+
+	// the sponsorship covers the transfers of the sender to the receiver, up
+	// to one and a half times the max fee of a transaction
+	spendLimit := sdk.NewCoins(sdk.NewCoin(denom, maxFee.MulRaw(3).QuoRaw(2)))
+	_, err := txFactory.CommitCosmosTx(sponsor.Priv, commonfactory.CosmosTxArgs{
+		Msgs: []sdk.Msg{&evmtypes.MsgSetFeeSponsorship{
+			Sponsor: sponsor.AccAddr.String(),
+			Sponsorship: evmtypes.FeeSponsorship{
+				SpendLimit:   spendLimit,
+				MaxFeePerGas: math.NewIntFromBigInt(gasPrice),
+				Contracts:    []string{receiver.Hex()},
+				Senders:      []string{sender.Addr.Hex()},
+			},
+		}},
+	})
+	suite.Require().NoError(err)
+
+	buildTx := func(to common.Address, gasPrice *big.Int, feeGranter sdk.AccAddress) []byte {
+		txArgs, err := txFactory.GenerateDefaultTxTypeArgs(sender.Addr, suite.ethTxType)
+		suite.Require().NoError(err)
+		txArgs.To = &to
+		txArgs.Amount = value
+		txArgs.GasLimit = gasLimit
+		if suite.ethTxType == gethtypes.DynamicFeeTxType {
+			txArgs.GasFeeCap, txArgs.GasTipCap = gasPrice, gasPrice
+		} else {
+			txArgs.GasPrice = gasPrice
+		}
+
+		msg, err := txFactory.GenerateSignedMsgEthereumTx(sender.Priv, txArgs)
+		suite.Require().NoError(err)
+
+		txBuilder := txConfig.NewTxBuilder()
+		_, err = msg.BuildTx(txBuilder, denom)
+		suite.Require().NoError(err)
+		txBuilder.SetFeeGranter(feeGranter)
+
+		bz, err := txConfig.TxEncoder()(txBuilder.GetTx())
+		suite.Require().NoError(err)
+		return bz
+	}
+
+	getBalance := func(addr sdk.AccAddress) math.Int {
+		res, err := grpcHandler.GetBalance(addr, denom)
+		suite.Require().NoError(err)
+		return res.Balance.Amount
+	}
+
+	// the fee granter isn't covered by the Ethereum signature, so it can't be
+	// used to choose the sponsor
+	res, err := unitNetwork.CheckTx(buildTx(receiver, gasPrice, sponsor.AccAddr))
+	suite.Require().NoError(err)
+	suite.Require().False(res.IsOK(), "fee granter set on the Cosmos transaction")
+	suite.Require().Contains(res.Log, "Fee payer and granter should be empty")
+
+	// the sender chooses the sponsor through a signed Cosmos transaction
+	txRes, err := txFactory.CommitCosmosTx(sender.Priv, commonfactory.CosmosTxArgs{
+		Msgs: []sdk.Msg{&evmtypes.MsgSetFeeSponsor{
+			Sender:  sender.AccAddr.String(),
+			Sponsor: sponsor.AccAddr.String(),
+		}},
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(txRes.IsOK(), txRes.Log)
+
+	res, err = unitNetwork.CheckTx(buildTx(sponsor.Addr, gasPrice, nil))
+	suite.Require().NoError(err)
+	suite.Require().False(res.IsOK(), "contract out of the sponsorship scope")
+	suite.Require().Contains(res.Log, "is not sponsored")
+
+	res, err = unitNetwork.CheckTx(buildTx(receiver, new(big.Int).Add(gasPrice, big.NewInt(1)), nil))
+	suite.Require().NoError(err)
+	suite.Require().False(res.IsOK(), "gas price higher than the max fee per gas")
+	suite.Require().Contains(res.Log, "exceeds the max fee per gas")
+
+	senderBalance := getBalance(sender.AccAddr)
+	sponsorBalance := getBalance(sponsor.AccAddr)
+
+	txRes, err = unitNetwork.BroadcastTxSync(buildTx(receiver, gasPrice, nil))
+	suite.Require().NoError(err)
+	suite.Require().True(txRes.IsOK(), txRes.Log)
+	suite.Require().NoError(unitNetwork.NextBlock())
+
+	// the sponsor pays the fees and is refunded for the leftover gas, while the
+	// sender only pays the transferred value
+	leftoverGas := gasLimit - uint64(txRes.GasUsed) //#nosec G115 -- gas used is never negative
+	refund := math.NewIntFromBigInt(new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(leftoverGas)))
+	suite.Require().Equal(sponsorBalance.Sub(maxFee).Add(refund), getBalance(sponsor.AccAddr))
+	suite.Require().Equal(senderBalance.Sub(math.NewIntFromBigInt(value)), getBalance(sender.AccAddr))
+
+	// the spend limit is deducted by the max fee, regardless of the refund
+	queryRes, err := unitNetwork.GetEvmClient().FeeSponsorship(
+		unitNetwork.GetContext(),
+		&evmtypes.QueryFeeSponsorshipRequest{Sponsor: sponsor.AccAddr.String()},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(spendLimit.Sub(sdk.NewCoin(denom, maxFee)), queryRes.Sponsorship.SpendLimit)
+
+	res, err = unitNetwork.CheckTx(buildTx(receiver, gasPrice, nil))
+	suite.Require().NoError(err)
+	suite.Require().False(res.IsOK(), "spend limit exceeded")
+	suite.Require().Contains(res.Log, "exceed the spend limit")
+
+	// the revocation of the sponsorship clears the choice of the sponsor, so
+	// that the sender pays its own fees again
+	txRes, err = txFactory.CommitCosmosTx(sponsor.Priv, commonfactory.CosmosTxArgs{
+		Msgs: []sdk.Msg{&evmtypes.MsgRevokeFeeSponsorship{Sponsor: sponsor.AccAddr.String()}},
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(txRes.IsOK(), txRes.Log)
+
+	res, err = unitNetwork.CheckTx(buildTx(receiver, gasPrice, nil))
+	suite.Require().NoError(err)
+	suite.Require().True(res.IsOK(), res.Log)
+
+	// a sponsor without fee sponsorship is ignored
+	ctx := unitNetwork.GetContext()
+	unitNetwork.App.EvmKeeper.SetAccountFeeSponsor(ctx, sender.AccAddr, sponsor.AccAddr)
+	suite.Require().Nil(evm.GetTxFeeSponsor(ctx, unitNetwork.App.EvmKeeper, sender.AccAddr))
+}
@@ -0,0 +1,52 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package evm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
+)
+
+// GetTxFeeToken returns the alternate token used to pay the fees of the
+// transaction along with its conversion rate to the EVM denom. The fees are
+// paid in an alternate token when the sender of the Ethereum message has
+// chosen one accepted by the fee market, through a Cosmos transaction signed
+// beforehand. A nil fee token is returned when the fees are paid in the EVM
+// denom, which is also the case when the chosen token is no longer accepted or
+// its rate isn't available, so that the sender isn't locked out.
+//
+// NOTE: the fee amount of the Cosmos transaction wrapping the Ethereum message
+// isn't covered by the Ethereum signature, so it can't be used to choose the
+// fee token.
+func GetTxFeeToken(ctx sdk.Context, feeMarketKeeper FeeMarketKeeper, sender sdk.AccAddress) *evmtypes.FeeTokenRate {
+	if sender.Empty() {
+		return nil
+	}
+
+	denom, found := feeMarketKeeper.GetAccountFeeToken(ctx, sender)
+	if !found {
+		return nil
+	}
+
+	rate, err := feeMarketKeeper.GetFeeTokenRate(ctx, denom)
+	if err != nil {
+		return nil
+	}
+
+	return &evmtypes.FeeTokenRate{
+		Denom: denom,
+		Rate:  rate,
+	}
+}
+
+// ConvertFeesToFeeToken converts the fees computed in the EVM denom, using 18
+// decimals, into the fee token.
+func ConvertFeesToFeeToken(fees sdk.Coins, feeToken evmtypes.FeeTokenRate) sdk.Coins {
+	amount := feemarkettypes.ConvertToFeeToken(fees.AmountOf(evmtypes.GetEVMCoinDenom()), feeToken.Rate)
+	if amount.IsZero() {
+		return sdk.Coins{}
+	}
+	return sdk.Coins{sdk.NewCoin(feeToken.Denom, amount)}
+}
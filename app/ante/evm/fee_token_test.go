@@ -0,0 +1,176 @@
+package evm_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v20/app/ante/evm"
+	commonfactory "github.com/evmos/evmos/v20/testutil/integration/common/factory"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/factory"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/grpc"
+	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	integrationutils "github.com/evmos/evmos/v20/testutil/integration/evmos/utils"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
+)
+
+func (suite *EvmAnteTestSuite) TestFeeTokenPayment() {
+	feeDenom := "ibc/usdc"
+	oracleDenom := "ibc/oracle"
+	// each base unit of the fee token is worth 10^12 units of the EVM coin,
+	// like a 6 decimals token with the same price as the 18 decimals EVM coin
+	rate := math.LegacyNewDec(1_000_000_000_000)
+	gasPrice := big.NewInt(1e9)
+	gasLimit := uint64(100_000)
+	value := big.NewInt(1000)
+
+	keyring := testkeyring.New(2)
+	customGenesis := network.CustomGenesisState{}
+	feemarketGenesis := feemarkettypes.DefaultGenesisState()
+	feemarketGenesis.Params.FeeTokens = []feemarkettypes.FeeToken{
+		feemarkettypes.NewFixedRateFeeToken(feeDenom, rate),
+	}
+	customGenesis[feemarkettypes.ModuleName] = feemarketGenesis
+
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+		network.WithOtherDenoms([]string{feeDenom}),
+		network.WithCustomGenesis(customGenesis),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(unitNetwork)
+	txFactory := factory.New(unitNetwork, grpcHandler)
+	txConfig := unitNetwork.GetEncodingConfig().TxConfig
+	sender := keyring.GetKey(0)
+	receiver := keyring.GetAddr(1)
+
+	buildTx := func(fee sdk.Coins) []byte {
+		txArgs, err := txFactory.GenerateDefaultTxTypeArgs(sender.Addr, suite.ethTxType)
+		suite.Require().NoError(err)
+		txArgs.To = &receiver
+		txArgs.Amount = value
+		txArgs.GasLimit = gasLimit
+		if suite.ethTxType == gethtypes.DynamicFeeTxType {
+			txArgs.GasFeeCap, txArgs.GasTipCap = gasPrice, gasPrice
+		} else {
+			txArgs.GasPrice = gasPrice
+		}
+
+		msg, err := txFactory.GenerateSignedMsgEthereumTx(sender.Priv, txArgs)
+		suite.Require().NoError(err)
+
+		txBuilder := txConfig.NewTxBuilder()
+		_, err = msg.BuildTx(txBuilder, evmtypes.GetEVMCoinDenom())
+		suite.Require().NoError(err)
+		txBuilder.SetFeeAmount(fee)
+
+		bz, err := txConfig.TxEncoder()(txBuilder.GetTx())
+		suite.Require().NoError(err)
+		return bz
+	}
+
+	getBalance := func(denom string) math.Int {
+		res, err := grpcHandler.GetBalance(sender.AccAddr, denom)
+		suite.Require().NoError(err)
+		return res.Balance.Amount
+	}
+
+	setFeeToken := func(denom string) (abcitypes.ExecTxResult, error) {
+		return txFactory.CommitCosmosTx(sender.Priv, commonfactory.CosmosTxArgs{
+			Msgs: []sdk.Msg{&feemarkettypes.MsgSetFeeTokenPreference{
+				Sender: sender.AccAddr.String(),
+				Denom:  denom,
+			}},
+		})
+	}
+
+	// the fee amount isn't covered by the Ethereum signature, so it can't be
+	// used to pay the fees in another token
+	maxFee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gasLimit))
+	feeAmount := feemarkettypes.ConvertToFeeToken(math.NewIntFromBigInt(maxFee), rate)
+
+	res, err := unitNetwork.CheckTx(buildTx(sdk.NewCoins(sdk.NewCoin(feeDenom, feeAmount))))
+	suite.Require().NoError(err)
+	suite.Require().False(res.IsOK(), "fee amount in a fee token without preference")
+	suite.Require().Contains(res.Log, "invalid AuthInfo Fee Amount")
+
+	_, err = setFeeToken("ibc/unknown")
+	suite.Require().ErrorContains(err, "fee token not found", "fee token not accepted by the fee market")
+
+	txRes, err := setFeeToken(feeDenom)
+	suite.Require().NoError(err)
+	suite.Require().True(txRes.IsOK(), txRes.Log)
+
+	evmBalance := getBalance(evmtypes.GetEVMCoinDenom())
+	feeTokenBalance := getBalance(feeDenom)
+
+	// the fee amount of the Cosmos transaction is always in the EVM denom
+	evmFee := sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), math.NewIntFromBigInt(maxFee)))
+	txRes, err = unitNetwork.BroadcastTxSync(buildTx(evmFee))
+	suite.Require().NoError(err)
+	suite.Require().True(txRes.IsOK(), txRes.Log)
+	suite.Require().NoError(unitNetwork.NextBlock())
+
+	// the fees are deducted in the fee token and the leftover gas is refunded
+	// in the same token, rounding down
+	leftoverGas := gasLimit - uint64(txRes.GasUsed) //#nosec G115 -- gas used is never negative
+	refund := feemarkettypes.ConvertRefundToFeeToken(
+		math.NewIntFromBigInt(new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(leftoverGas))),
+		rate,
+	)
+	suite.Require().Equal(feeTokenBalance.Sub(feeAmount).Add(refund), getBalance(feeDenom))
+
+	// the EVM coin balance only covers the transferred value
+	suite.Require().Equal(evmBalance.Sub(math.NewIntFromBigInt(value)), getBalance(evmtypes.GetEVMCoinDenom()))
+
+	// an oracle token without rate can't be used to pay the fees, so the
+	// sender pays them in the EVM denom
+	params, err := grpcHandler.GetFeeMarketParams()
+	suite.Require().NoError(err)
+	params.Params.FeeTokens = append(
+		params.Params.FeeTokens,
+		feemarkettypes.NewOracleFeeToken(oracleDenom, keyring.GetAccAddr(1).String()),
+	)
+	err = integrationutils.UpdateFeeMarketParams(integrationutils.UpdateParamsInput{
+		Tf:      txFactory,
+		Network: unitNetwork,
+		Pk:      sender.Priv,
+		Params:  params.Params,
+	})
+	suite.Require().NoError(err)
+
+	txRes, err = setFeeToken(oracleDenom)
+	suite.Require().NoError(err)
+	suite.Require().True(txRes.IsOK(), txRes.Log)
+
+	res, err = unitNetwork.CheckTx(buildTx(evmFee))
+	suite.Require().NoError(err)
+	suite.Require().True(res.IsOK(), res.Log)
+	suite.Require().Nil(evm.GetTxFeeToken(unitNetwork.GetContext(), unitNetwork.App.FeeMarketKeeper, sender.AccAddr))
+
+	// removing the fee token through governance clears the choice of the
+	// token, so that the sender pays the fees in the EVM denom again
+	txRes, err = setFeeToken(feeDenom)
+	suite.Require().NoError(err)
+	suite.Require().True(txRes.IsOK(), txRes.Log)
+
+	params.Params.FeeTokens = nil
+	err = integrationutils.UpdateFeeMarketParams(integrationutils.UpdateParamsInput{
+		Tf:      txFactory,
+		Network: unitNetwork,
+		Pk:      sender.Priv,
+		Params:  params.Params,
+	})
+	suite.Require().NoError(err)
+
+	_, found := unitNetwork.App.FeeMarketKeeper.GetAccountFeeToken(unitNetwork.GetContext(), sender.AccAddr)
+	suite.Require().False(found)
+
+	res, err = unitNetwork.CheckTx(buildTx(evmFee))
+	suite.Require().NoError(err)
+	suite.Require().True(res.IsOK(), res.Log)
+}
@@ -33,6 +33,14 @@ type EVMKeeper interface { //nolint: revive
 	// GetMinGasPrice returns the MinGasPrice param from the fee market module
 	// adapted according to the evm denom decimals
 	GetMinGasPrice(ctx sdk.Context) math.LegacyDec
+	// UseFeeSponsorship checks that the transaction is in the scope of the fee
+	// sponsorship of the sponsor and deducts the fees from its spend limit
+	UseFeeSponsorship(ctx sdk.Context, sponsor sdk.AccAddress, from common.Address, txData evmtypes.TxData, fees sdk.Coins) error
+	// GetAccountFeeSponsor returns the sponsor chosen by the account to pay the
+	// fees of its EVM transactions
+	GetAccountFeeSponsor(ctx sdk.Context, addr sdk.AccAddress) (sdk.AccAddress, bool)
+	// GetFeeSponsorship returns the fee sponsorship registered by the sponsor
+	GetFeeSponsorship(ctx sdk.Context, sponsor sdk.AccAddress) (evmtypes.FeeSponsorship, bool)
 }
 
 type FeeMarketKeeper interface {
@@ -40,6 +48,21 @@ type FeeMarketKeeper interface {
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
 	GetBaseFeeEnabled(ctx sdk.Context) bool
 	GetBaseFee(ctx sdk.Context) math.LegacyDec
+	GetFeeTokenRate(ctx sdk.Context, denom string) (math.LegacyDec, error)
+	GetAccountFeeToken(ctx sdk.Context, addr sdk.AccAddress) (string, bool)
+}
+
+// TxPool defines the expected interface of the app-side mempool used on the
+// AnteHandler to accept nonce-gapped and replacement transactions in CheckTx.
+type TxPool interface {
+	// Has returns true if the Ethereum transaction with the given hash is in the pool.
+	Has(hash common.Hash) bool
+	// HasNonce returns true if the pool contains a transaction from the given
+	// sender with the given nonce.
+	HasNonce(sender common.Address, nonce uint64) bool
+	// CanQueue returns an error if a transaction of the sender with a nonce ahead
+	// of the given account nonce can't be pooled.
+	CanQueue(sender common.Address, accountNonce, nonce uint64) error
 }
 
 type protoTxProvider interface {
@@ -33,6 +33,7 @@ type MonoDecorator struct {
 	evmKeeper          EVMKeeper
 	distributionKeeper anteutils.DistributionKeeper
 	stakingKeeper      anteutils.StakingKeeper
+	txPool             TxPool
 	maxGasWanted       uint64
 }
 
@@ -48,6 +49,12 @@ type DecoratorUtils struct {
 	GasWanted          uint64
 	MinPriority        int64
 	TxFee              *big.Int
+	// FeeToken is the alternate token used to pay the fees, nil if the fees
+	// are paid in the EVM denom.
+	FeeToken *evmtypes.FeeTokenRate
+	// FeeSponsor is the account paying the fees on behalf of the sender, nil
+	// if the fees are paid by the sender.
+	FeeSponsor sdk.AccAddress
 }
 
 // NewMonoDecorator creates a new MonoDecorator
@@ -58,6 +65,7 @@ func NewMonoDecorator(
 	evmKeeper EVMKeeper,
 	distributionKeeper anteutils.DistributionKeeper,
 	stakingKeeper anteutils.StakingKeeper,
+	txPool TxPool,
 	maxGasWanted uint64,
 ) MonoDecorator {
 	return MonoDecorator{
@@ -67,6 +75,7 @@ func NewMonoDecorator(
 		evmKeeper:          evmKeeper,
 		distributionKeeper: distributionKeeper,
 		stakingKeeper:      stakingKeeper,
+		txPool:             txPool,
 		maxGasWanted:       maxGasWanted,
 	}
 }
@@ -153,6 +162,27 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
+	// the fee options are chosen by the sender of the Ethereum message
+	sender := GetTxSender(tx, decUtils.Signer.ChainID())
+
+	decUtils.FeeToken = GetTxFeeToken(ctx, md.feeMarketKeeper, sender)
+
+	if feeToken := decUtils.FeeToken; feeToken != nil {
+		// The local min gas price of the fee token takes precedence over the
+		// one of the EVM denom when it's configured.
+		if minGasPrice := ctx.MinGasPrices().AmountOf(feeToken.Denom); minGasPrice.IsPositive() {
+			decUtils.MempoolMinGasPrice = minGasPrice.Mul(feeToken.Rate)
+		}
+		// the fee token is used to refund the leftover gas
+		ctx = evmtypes.WithFeeTokenRate(ctx, *feeToken)
+	}
+
+	decUtils.FeeSponsor = GetTxFeeSponsor(ctx, md.evmKeeper, sender)
+	if decUtils.FeeSponsor != nil {
+		// the sponsor is refunded for the leftover gas
+		ctx = evmtypes.WithFeeSponsor(ctx, decUtils.FeeSponsor)
+	}
+
 	msgs := tx.GetMsgs()
 	if msgs == nil {
 		return ctx, errorsmod.Wrap(errortypes.ErrUnknownRequest, "invalid transaction. Transaction without messages")
@@ -166,6 +196,10 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			return ctx, err
 		}
 
+		if err := CheckSetCodeTxActivated(txData, evmtypes.GetChainConfig(), big.NewInt(ctx.BlockHeight())); err != nil {
+			return ctx, err
+		}
+
 		feeAmt := txData.Fee()
 		gas := txData.GetGas()
 		fee := sdkmath.LegacyNewDecFromBigInt(feeAmt)
@@ -182,7 +216,8 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			}
 		}
 
-		if txData.TxType() == ethtypes.DynamicFeeTxType && decUtils.BaseFee != nil {
+		isDynamicFee := txData.TxType() == ethtypes.DynamicFeeTxType || txData.TxType() == evmtypes.SetCodeTxType
+		if isDynamicFee && decUtils.BaseFee != nil {
 			// If the base fee is not empty, we compute the effective gas price
 			// according to current base fee price. The gas limit is specified
 			// by the user, while the price is given by the minimum between the
@@ -206,6 +241,12 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			return ctx, err
 		}
 
+		if ctx.IsCheckTx() {
+			if err := ValidateAuthorizations(txData, decUtils.Signer.ChainID()); err != nil {
+				return ctx, err
+			}
+		}
+
 		// 5. signature verification
 		if err := SignatureVerification(
 			ethMsg,
@@ -226,9 +267,11 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		if err := VerifyAccountBalance(
 			ctx,
 			md.accountKeeper,
+			md.evmKeeper,
 			account,
 			fromAddr,
 			txData,
+			decUtils.FeeToken == nil && decUtils.FeeSponsor == nil,
 		); err != nil {
 			return ctx, err
 		}
@@ -286,6 +329,18 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			return ctx, err
 		}
 
+		if decUtils.FeeToken != nil {
+			msgFees = ConvertFeesToFeeToken(msgFees, *decUtils.FeeToken)
+		}
+
+		feePayer := sdk.AccAddress(from)
+		if decUtils.FeeSponsor != nil {
+			if err := md.evmKeeper.UseFeeSponsorship(ctx, decUtils.FeeSponsor, fromAddr, txData, msgFees); err != nil {
+				return ctx, err
+			}
+			feePayer = decUtils.FeeSponsor
+		}
+
 		err = ConsumeFeesAndEmitEvent(
 			ctx,
 			&ConsumeGasKeepers{
@@ -295,7 +350,7 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 				Staking:      md.stakingKeeper,
 			},
 			msgFees,
-			from,
+			feePayer,
 		)
 		if err != nil {
 			return ctx, err
@@ -325,7 +380,12 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		decUtils.TxGasLimit += gas
 
 		// 10. increment sequence
-		if err := IncrementNonce(ctx, md.accountKeeper, acc, txData.GetNonce()); err != nil {
+		if ctx.IsCheckTx() && md.txPool != nil {
+			err = CheckTxNonce(ctx, md.accountKeeper, md.txPool, acc, fromAddr, ethMsg.TxHash(), txData.GetNonce())
+		} else {
+			err = IncrementNonce(ctx, md.accountKeeper, acc, txData.GetNonce())
+		}
+		if err != nil {
 			return ctx, err
 		}
 
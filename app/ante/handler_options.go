@@ -36,6 +36,9 @@ type HandlerOptions struct {
 	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params authtypes.Params) error
 	MaxTxGasWanted         uint64
 	TxFeeChecker           ante.TxFeeChecker
+	// TxPool is the optional app-side mempool used to accept nonce-gapped and
+	// replacement Ethereum transactions in CheckTx.
+	TxPool evmante.TxPool
 }
 
 // Validate checks if the keepers are defined
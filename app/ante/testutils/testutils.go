@@ -98,6 +98,9 @@ func (suite *AnteTestSuite) SetupTest() {
 		chainConfig.MergeNetsplitBlock = &maxInt
 		chainConfig.ShanghaiBlock = &maxInt
 		chainConfig.CancunBlock = &maxInt
+	} else {
+		pragueBlock := sdkmath.ZeroInt()
+		chainConfig.PragueBlock = &pragueBlock
 	}
 
 	// get the denom and decimals set when initialized the chain
@@ -25,6 +25,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmos "github.com/cometbft/cometbft/libs/os"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 
 	errorsmod "cosmossdk.io/errors"
@@ -139,9 +140,14 @@ import (
 	inflationtypes "github.com/evmos/evmos/v20/x/inflation/v1/types"
 
 	"github.com/evmos/evmos/v20/app/ante"
+	cosmosante "github.com/evmos/evmos/v20/app/ante/cosmos"
 	ethante "github.com/evmos/evmos/v20/app/ante/evm"
+	evmosmempool "github.com/evmos/evmos/v20/app/mempool"
 	"github.com/evmos/evmos/v20/app/post"
 	v20 "github.com/evmos/evmos/v20/app/upgrades/v20"
+	v21 "github.com/evmos/evmos/v20/app/upgrades/v21"
+	ibccallbacks "github.com/evmos/evmos/v20/ibc/callbacks"
+	srvconfig "github.com/evmos/evmos/v20/server/config"
 	srvflags "github.com/evmos/evmos/v20/server/flags"
 	"github.com/evmos/evmos/v20/x/erc20"
 	erc20keeper "github.com/evmos/evmos/v20/x/erc20/keeper"
@@ -304,15 +310,6 @@ func NewEvmos(
 	// setup memiavl if it's enabled in config
 	baseAppOptions = memiavlstore.SetupMemIAVL(logger, homePath, appOpts, false, false, baseAppOptions)
 
-	// Setup Mempool and Proposal Handlers
-	baseAppOptions = append(baseAppOptions, func(app *baseapp.BaseApp) {
-		mempool := mempool.NoOpMempool{}
-		app.SetMempool(mempool)
-		handler := baseapp.NewDefaultProposalHandler(mempool, app)
-		app.SetPrepareProposal(handler.PrepareProposalHandler())
-		app.SetProcessProposal(handler.ProcessProposalHandler())
-	})
-
 	// NOTE we use custom transaction decoder that supports the sdk.Tx interface instead of sdk.StdTx
 	bApp := baseapp.NewBaseApp(
 		Name,
@@ -539,6 +536,12 @@ func NewEvmos(
 			app.TransferKeeper,
 			app.IBCKeeper.ChannelKeeper,
 			app.GovKeeper,
+			app.SlashingKeeper,
+			app.FeeGrantKeeper,
+			evmKeeper,
+			app.MsgServiceRouter(),
+			cosmosante.NewAuthzLimiterDecorator(cosmosante.DisabledAuthzMsgs...),
+			app.appCodec,
 		),
 	)
 
@@ -566,6 +569,7 @@ func NewEvmos(
 		Create Transfer Stack
 
 		transfer stack contains (from bottom to top):
+			- IBC Callbacks Middleware
 			- ERC-20 Middleware
 		 	- Recovery Middleware
 			- IBC Transfer
@@ -574,7 +578,7 @@ func NewEvmos(
 		 	transferKeeper.SendPacket -> claim.SendPacket -> recovery.SendPacket -> erc20.SendPacket -> channel.SendPacket
 
 		RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
-			channel.RecvPacket -> erc20.OnRecvPacket -> recovery.OnRecvPacket -> claim.OnRecvPacket -> transfer.OnRecvPacket
+			channel.RecvPacket -> callbacks.OnRecvPacket -> erc20.OnRecvPacket -> recovery.OnRecvPacket -> claim.OnRecvPacket -> transfer.OnRecvPacket
 	*/
 
 	// create IBC module from top to bottom of stack
@@ -583,6 +587,7 @@ func NewEvmos(
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
 	transferStack = ratelimit.NewIBCMiddleware(app.RateLimitKeeper, transferStack)
 	transferStack = erc20.NewIBCMiddleware(app.Erc20Keeper, transferStack)
+	transferStack = ibccallbacks.NewIBCMiddleware(app.EvmKeeper, app.AccountKeeper, transferStack)
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
@@ -791,7 +796,8 @@ func NewEvmos(
 
 	maxGasWanted := cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted))
 
-	app.setAnteHandler(app.txConfig, maxGasWanted)
+	txPool := app.setMempool(appOpts)
+	app.setAnteHandler(app.txConfig, maxGasWanted, txPool)
 	app.setPostHandler()
 	app.SetEndBlocker(app.EndBlocker)
 	app.setupUpgradeHandlers()
@@ -815,6 +821,11 @@ func NewEvmos(
 			os.Exit(1)
 		}
 
+		if err := app.activatePragueFork(); err != nil {
+			logger.Error("error on activating the prague fork", "err", err)
+			os.Exit(1)
+		}
+
 		// queryMultiStore will be only defined when using versionDB
 		// when defined, we check if the iavl & versionDB versions match
 		if app.qms != nil {
@@ -850,7 +861,61 @@ func NewEvmos(
 // Name returns the name of the App
 func (app *Evmos) Name() string { return app.BaseApp.Name() }
 
-func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
+// setMempool sets the app-side mempool and the proposal handlers. The EVM
+// mempool is used unless it's disabled with a negative maximum number of
+// transactions, in which case the transactions are proposed in the order of
+// the CometBFT mempool. The unset (or zero) limits fall back to their default
+// values. It returns the mempool used by the AnteHandler, if any.
+//
+// NOTE: the proposals are accepted without verifying their transactions,
+// whatever the mempool, as with the NoOp mempool. The mempool is a local
+// setting, so verifying the proposals only on the validators running the EVM
+// mempool would make them reject the blocks of the ones that disabled it. The
+// invalid transactions of a proposal fail on their own when the block is
+// finalized.
+func (app *Evmos) setMempool(appOpts servertypes.AppOptions) ethante.TxPool {
+	maxTxs := cast.ToInt(appOpts.Get(srvflags.EVMMempoolMaxTxs))
+	if maxTxs < 0 {
+		noOpMempool := mempool.NoOpMempool{}
+		app.SetMempool(noOpMempool)
+		handler := baseapp.NewDefaultProposalHandler(noOpMempool, app.BaseApp)
+		app.SetPrepareProposal(handler.PrepareProposalHandler())
+		app.SetProcessProposal(baseapp.NoOpProcessProposal())
+		return nil
+	}
+
+	cfg := evmosmempool.Config{
+		MaxTxs:          maxTxs,
+		PriceBump:       cast.ToUint64(appOpts.Get(srvflags.EVMMempoolPriceBump)),
+		MaxNonceGap:     cast.ToUint64(appOpts.Get(srvflags.EVMMempoolMaxNonceGap)),
+		MaxTxsPerSender: cast.ToInt(appOpts.Get(srvflags.EVMMempoolMaxTxsPerSender)),
+		QueuedTTL:       cast.ToDuration(appOpts.Get(srvflags.EVMMempoolQueuedTTL)),
+	}
+	if cfg.MaxTxs == 0 {
+		cfg.MaxTxs = srvconfig.DefaultEVMMempoolMaxTxs
+	}
+	if cfg.MaxNonceGap == 0 {
+		cfg.MaxNonceGap = srvconfig.DefaultEVMMempoolMaxNonceGap
+	}
+	if cfg.MaxTxsPerSender <= 0 {
+		cfg.MaxTxsPerSender = srvconfig.DefaultEVMMempoolMaxTxsPerSender
+	}
+	if cfg.QueuedTTL <= 0 {
+		cfg.QueuedTTL = srvconfig.DefaultEVMMempoolQueuedTTL
+	}
+
+	evmMempool := evmosmempool.NewEVMMempool(app.EvmKeeper, cfg)
+	app.SetMempool(evmMempool)
+	handler := baseapp.NewDefaultProposalHandler(evmMempool, app.BaseApp)
+	app.SetPrepareProposal(handler.PrepareProposalHandler())
+	app.SetProcessProposal(baseapp.NoOpProcessProposal())
+	// drop the transactions made stale by the committed block before the
+	// mempool is rechecked
+	app.SetPrepareCheckStater(evmMempool.Prune)
+	return evmMempool
+}
+
+func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64, txPool ethante.TxPool) {
 	options := ante.HandlerOptions{
 		Cdc:                    app.appCodec,
 		AccountKeeper:          app.AccountKeeper,
@@ -866,6 +931,7 @@ func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 		SigGasConsumer:         ante.SigVerificationGasConsumer,
 		MaxTxGasWanted:         maxGasWanted,
 		TxFeeChecker:           ethante.NewDynamicFeeChecker(app.FeeMarketKeeper),
+		TxPool:                 txPool,
 	}
 
 	if err := options.Validate(); err != nil {
@@ -1189,6 +1255,18 @@ func initParamsKeeper(
 	return paramsKeeper
 }
 
+// activatePragueFork activates the Prague fork at the height of the v21
+// upgrade, once it has been applied, since the chain config of the EVM isn't
+// persisted.
+func (app *Evmos) activatePragueFork() error {
+	ctx := app.NewUncachedContext(false, cmtproto.Header{})
+	height, err := app.UpgradeKeeper.GetDoneHeight(ctx, v21.UpgradeName)
+	if err != nil || height == 0 {
+		return err
+	}
+	return evmtypes.ActivatePrague(height)
+}
+
 func (app *Evmos) setupUpgradeHandlers() {
 	// v20 upgrade handler
 	app.UpgradeKeeper.SetUpgradeHandler(
@@ -1200,6 +1278,15 @@ func (app *Evmos) setupUpgradeHandlers() {
 		),
 	)
 
+	// v21 upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v21.UpgradeName,
+		v21.CreateUpgradeHandler(
+			app.mm, app.configurator,
+			app.EvmKeeper,
+		),
+	)
+
 	// When a planned update height is reached, the old binary will panic
 	// writing on disk the height and name of the update that triggered it
 	// This will read that value, and execute the preparations for the upgrade.
@@ -36,6 +36,9 @@ func InitializeAppConfiguration(chainID string) error {
 	}
 
 	ethCfg := evmtypes.DefaultChainConfig(chainID)
+	// the tests run with the set code transactions enabled
+	pragueBlock := math.ZeroInt()
+	ethCfg.PragueBlock = &pragueBlock
 
 	configurator := evmtypes.NewEVMConfigurator()
 	// reset configuration to set the new one
@@ -0,0 +1,32 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package mempool
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// codespace is the codespace of the app-side mempool errors
+const codespace = "mempool"
+
+var (
+	// ErrReplacementUnderpriced is returned when a transaction replacing a pooled
+	// transaction with the same nonce doesn't bump its price enough.
+	ErrReplacementUnderpriced = errorsmod.Register(codespace, 2, "replacement transaction underpriced")
+
+	// ErrUnderpriced is returned when the mempool is full and the priority of a
+	// transaction is not higher than the one of the cheapest pooled transaction.
+	ErrUnderpriced = errorsmod.Register(codespace, 3, "transaction underpriced")
+
+	// ErrInvalidSigner is returned when the sender and nonce of a transaction
+	// can't be determined.
+	ErrInvalidSigner = errorsmod.Register(codespace, 4, "invalid transaction signer")
+
+	// ErrNonceGap is returned when the nonce of a transaction is too far ahead of
+	// the committed nonce of its sender.
+	ErrNonceGap = errorsmod.Register(codespace, 5, "nonce too far in the future")
+
+	// ErrSenderLimit is returned when the sender of a transaction already has the
+	// maximum number of pooled transactions.
+	ErrSenderLimit = errorsmod.Register(codespace, 6, "too many pooled transactions for the sender")
+)
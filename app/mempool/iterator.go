@@ -0,0 +1,71 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package mempool
+
+import (
+	"container/heap"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var (
+	_ sdkmempool.Iterator = &iterator{}
+	_ heap.Interface      = &txQueues{}
+)
+
+// iterator returns the executable transactions of the mempool in priority
+// order while preserving the nonce order of the transactions of each sender.
+type iterator struct {
+	queues txQueues
+}
+
+// Next returns the iterator positioned at the next transaction, or nil if all
+// the transactions have been returned.
+func (it *iterator) Next() sdkmempool.Iterator {
+	if queue := it.queues[0][1:]; len(queue) > 0 {
+		it.queues[0] = queue
+		heap.Fix(&it.queues, 0)
+	} else {
+		heap.Pop(&it.queues)
+	}
+
+	if it.queues.Len() == 0 {
+		return nil
+	}
+	return it
+}
+
+// Tx returns the transaction at the current position of the iterator.
+func (it *iterator) Tx() sdk.Tx {
+	return it.queues[0][0].tx
+}
+
+// txQueues is a max-heap of the nonce-ordered transaction queues of the
+// senders, sorted by the priority of the first transaction of each queue.
+// Transactions with the same priority are sorted by insertion order.
+type txQueues [][]*poolTx
+
+func (q txQueues) Len() int { return len(q) }
+
+func (q txQueues) Less(i, j int) bool {
+	a, b := q[i][0], q[j][0]
+	if a.priority != b.priority {
+		return a.priority > b.priority
+	}
+	return a.seq < b.seq
+}
+
+func (q txQueues) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *txQueues) Push(x any) {
+	*q = append(*q, x.([]*poolTx))
+}
+
+func (q *txQueues) Pop() any {
+	old := *q
+	n := len(old)
+	queue := old[n-1]
+	*q = old[:n-1]
+	return queue
+}
@@ -0,0 +1,441 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package mempool
+
+import (
+	"container/heap"
+	"context"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmostypes "github.com/evmos/evmos/v20/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+var (
+	_ sdkmempool.Mempool   = &EVMMempool{}
+	_ evmostypes.EVMTxPool = &EVMMempool{}
+)
+
+// EVMKeeper defines the expected keeper interface used by the mempool to
+// retrieve the committed nonces of the accounts.
+type EVMKeeper interface {
+	GetNonce(ctx sdk.Context, addr common.Address) uint64
+}
+
+// Config defines the configuration of the EVM mempool.
+type Config struct {
+	// MaxTxs is the maximum number of transactions in the mempool. When the
+	// mempool is full, the transaction with the lowest priority is evicted.
+	// Zero means unbounded.
+	MaxTxs int
+	// PriceBump is the minimum price bump percentage required to replace a
+	// pooled transaction with the same sender and nonce.
+	PriceBump uint64
+	// MaxNonceGap is the maximum distance between the nonce of a queued
+	// transaction and the committed nonce of its sender. Zero means unbounded.
+	MaxNonceGap uint64
+	// MaxTxsPerSender is the maximum number of pooled transactions of a single
+	// sender. Zero means unbounded.
+	MaxTxsPerSender int
+	// QueuedTTL is the maximum time, measured in block time, a transaction that
+	// isn't executable because of a nonce gap stays in the mempool. Zero means
+	// they are never dropped.
+	QueuedTTL time.Duration
+}
+
+// EVMMempool is an app-side mempool aware of the Ethereum transaction
+// semantics. The transactions are kept in per-sender queues indexed by nonce,
+// using the account sequence as nonce for the Cosmos transactions. This allows
+// to queue the transactions with a future nonce until the gap is filled, and
+// to replace a pending transaction with one paying a higher price.
+//
+// The transactions are selected for a proposal in priority order, only taking
+// the ones that are executable on top of the committed nonce of each sender.
+type EVMMempool struct {
+	mtx       sync.RWMutex
+	evmKeeper EVMKeeper
+	cfg       Config
+
+	// senders maps each sender to its pooled transactions indexed by nonce
+	senders map[common.Address]map[uint64]*poolTx
+	// ethTxs indexes the pooled Ethereum transactions by hash
+	ethTxs map[common.Hash]*poolTx
+	count  int
+	// seq is the insertion counter used to order transactions with the same priority
+	seq uint64
+}
+
+// poolTx is a transaction held by the mempool along with its ordering data.
+type poolTx struct {
+	tx       sdk.Tx
+	sender   common.Address
+	nonce    uint64
+	priority int64
+	seq      uint64
+	// added is the block time at which the transaction was inserted
+	added time.Time
+
+	// the fields below are only set for Ethereum transactions
+	hash      common.Hash
+	gasFeeCap *big.Int
+	gasTipCap *big.Int
+}
+
+// NewEVMMempool creates a new EVM mempool with the given configuration.
+func NewEVMMempool(evmKeeper EVMKeeper, cfg Config) *EVMMempool {
+	return &EVMMempool{
+		evmKeeper: evmKeeper,
+		cfg:       cfg,
+		senders:   make(map[common.Address]map[uint64]*poolTx),
+		ethTxs:    make(map[common.Hash]*poolTx),
+	}
+}
+
+// Insert adds a transaction to the mempool using the priority set on the
+// context by the AnteHandler. A transaction with the same sender and nonce as
+// a pooled one replaces it as long as it bumps its price by the configured
+// percentage. When the mempool is full, the pooled transaction with the lowest
+// priority is evicted if the new one pays more.
+//
+// A new transaction is rejected if its nonce is too far ahead of the committed
+// nonce of its sender, or if the sender already has too many pooled transactions.
+func (mp *EVMMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	ptx, err := newPoolTx(tx)
+	if err != nil {
+		return err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	ptx.priority = sdkCtx.Priority()
+	ptx.added = sdkCtx.BlockTime()
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	if old, ok := mp.senders[ptx.sender][ptx.nonce]; ok {
+		if ptx.isEthereumTx() && old.hash == ptx.hash {
+			return nil
+		}
+		if !mp.canReplace(old, ptx) {
+			return errorsmod.Wrapf(
+				ErrReplacementUnderpriced,
+				"nonce %d of %s requires a price bump of %d%%", ptx.nonce, ptx.sender.Hex(), mp.cfg.PriceBump,
+			)
+		}
+		mp.remove(old)
+	} else if err := mp.checkSenderLimits(ptx.sender, mp.evmKeeper.GetNonce(sdkCtx, ptx.sender), ptx.nonce); err != nil {
+		return err
+	} else if mp.cfg.MaxTxs > 0 && mp.count >= mp.cfg.MaxTxs {
+		lowest := mp.lowestPriorityTx()
+		if lowest == nil || lowest.priority >= ptx.priority {
+			return errorsmod.Wrapf(ErrUnderpriced, "mempool is full: %s", sdkmempool.ErrMempoolTxMaxCapacity)
+		}
+		mp.remove(lowest)
+	}
+
+	mp.seq++
+	ptx.seq = mp.seq
+	mp.add(ptx)
+	return nil
+}
+
+// Select returns an iterator over the executable transactions of the mempool
+// in priority order. For each sender, the transactions are returned in nonce
+// order starting from the committed nonce of the account, and the ones after
+// a nonce gap are skipped.
+func (mp *EVMMempool) Select(ctx context.Context, _ [][]byte) sdkmempool.Iterator {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	queues := make(txQueues, 0, len(mp.senders))
+	for sender, txs := range mp.senders {
+		var queue []*poolTx
+		for nonce := mp.evmKeeper.GetNonce(sdkCtx, sender); ; nonce++ {
+			ptx, ok := txs[nonce]
+			if !ok {
+				break
+			}
+			queue = append(queue, ptx)
+		}
+		if len(queue) > 0 {
+			queues = append(queues, queue)
+		}
+	}
+
+	if len(queues) == 0 {
+		return nil
+	}
+
+	heap.Init(&queues)
+	return &iterator{queues: queues}
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *EVMMempool) CountTx() int {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.count
+}
+
+// Txs returns all the transactions of the mempool, including the ones queued
+// after a nonce gap, grouped by sender in nonce order.
+func (mp *EVMMempool) Txs() []sdk.Tx {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	txs := make([]sdk.Tx, 0, mp.count)
+	for _, senderTxs := range mp.senders {
+		nonces := make([]uint64, 0, len(senderTxs))
+		for nonce := range senderTxs {
+			nonces = append(nonces, nonce)
+		}
+		slices.Sort(nonces)
+		for _, nonce := range nonces {
+			txs = append(txs, senderTxs[nonce].tx)
+		}
+	}
+	return txs
+}
+
+// Remove removes a transaction from the mempool. Ethereum transactions are
+// only removed if the pooled transaction with the same sender and nonce has the
+// same hash, so that removing a replaced transaction doesn't affect the one
+// that replaced it.
+func (mp *EVMMempool) Remove(tx sdk.Tx) error {
+	ptx, err := newPoolTx(tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	old, ok := mp.senders[ptx.sender][ptx.nonce]
+	if !ok || old.hash != ptx.hash {
+		return sdkmempool.ErrTxNotFound
+	}
+
+	mp.remove(old)
+	return nil
+}
+
+// Prune removes the transactions with a nonce lower than the committed nonce
+// of their sender. These are either included in a block or replaced by
+// transactions included in a block by other proposers. The transactions queued
+// after a nonce gap for longer than the configured TTL are removed as well. It
+// is meant to be set as the PrepareCheckStater of the app, so that it runs after
+// every commit before the mempool is rechecked.
+func (mp *EVMMempool) Prune(ctx sdk.Context) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	for sender, txs := range mp.senders {
+		nonce := mp.evmKeeper.GetNonce(ctx, sender)
+		executable := nonce
+		for _, ok := txs[executable]; ok; _, ok = txs[executable] {
+			executable++
+		}
+
+		for _, ptx := range txs {
+			switch {
+			case ptx.nonce < nonce:
+				mp.remove(ptx)
+			case ptx.nonce > executable && mp.isExpired(ctx, ptx):
+				mp.remove(ptx)
+			}
+		}
+	}
+}
+
+// CanQueue returns an error if a transaction of the sender with a nonce ahead of
+// the given account nonce can't be pooled, either because of its nonce gap or
+// because the sender already has too many pooled transactions.
+func (mp *EVMMempool) CanQueue(sender common.Address, accountNonce, nonce uint64) error {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	return mp.checkSenderLimits(sender, accountNonce, nonce)
+}
+
+// Has returns true if the Ethereum transaction with the given hash is in the mempool.
+func (mp *EVMMempool) Has(hash common.Hash) bool {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	_, ok := mp.ethTxs[hash]
+	return ok
+}
+
+// HasNonce returns true if the mempool contains a transaction from the given
+// sender with the given nonce.
+func (mp *EVMMempool) HasNonce(sender common.Address, nonce uint64) bool {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	_, ok := mp.senders[sender][nonce]
+	return ok
+}
+
+// checkSenderLimits returns an error if a new transaction of the sender with
+// the given nonce exceeds the nonce gap or the per-sender limits. It must be
+// called with the lock held.
+func (mp *EVMMempool) checkSenderLimits(sender common.Address, accountNonce, nonce uint64) error {
+	if mp.cfg.MaxNonceGap > 0 && nonce > accountNonce && nonce-accountNonce > mp.cfg.MaxNonceGap {
+		return errorsmod.Wrapf(
+			ErrNonceGap,
+			"nonce %d of %s is more than %d ahead of %d", nonce, sender.Hex(), mp.cfg.MaxNonceGap, accountNonce,
+		)
+	}
+
+	if mp.cfg.MaxTxsPerSender > 0 && len(mp.senders[sender]) >= mp.cfg.MaxTxsPerSender {
+		return errorsmod.Wrapf(
+			ErrSenderLimit,
+			"%s has %d pooled transactions", sender.Hex(), len(mp.senders[sender]),
+		)
+	}
+
+	return nil
+}
+
+// isExpired returns true if the transaction was queued for longer than the
+// configured TTL at the block time of the context.
+func (mp *EVMMempool) isExpired(ctx sdk.Context, ptx *poolTx) bool {
+	return mp.cfg.QueuedTTL > 0 && ctx.BlockTime().Sub(ptx.added) > mp.cfg.QueuedTTL
+}
+
+// add adds the transaction to the indexes of the mempool.
+func (mp *EVMMempool) add(ptx *poolTx) {
+	txs, ok := mp.senders[ptx.sender]
+	if !ok {
+		txs = make(map[uint64]*poolTx)
+		mp.senders[ptx.sender] = txs
+	}
+	txs[ptx.nonce] = ptx
+
+	if ptx.isEthereumTx() {
+		mp.ethTxs[ptx.hash] = ptx
+	}
+	mp.count++
+}
+
+// remove removes the transaction from the indexes of the mempool.
+func (mp *EVMMempool) remove(ptx *poolTx) {
+	txs := mp.senders[ptx.sender]
+	delete(txs, ptx.nonce)
+	if len(txs) == 0 {
+		delete(mp.senders, ptx.sender)
+	}
+
+	if ptx.isEthereumTx() {
+		delete(mp.ethTxs, ptx.hash)
+	}
+	mp.count--
+}
+
+// lowestPriorityTx returns the transaction with the lowest priority among the
+// ones with the highest nonce of each sender, so that the eviction doesn't
+// create nonce gaps. Ties are broken by evicting the most recent transaction.
+func (mp *EVMMempool) lowestPriorityTx() *poolTx {
+	var lowest *poolTx
+	for _, txs := range mp.senders {
+		var last *poolTx
+		for _, ptx := range txs {
+			if last == nil || ptx.nonce > last.nonce {
+				last = ptx
+			}
+		}
+
+		if lowest == nil ||
+			last.priority < lowest.priority ||
+			(last.priority == lowest.priority && last.seq > lowest.seq) {
+			lowest = last
+		}
+	}
+	return lowest
+}
+
+// canReplace returns true if the new transaction bumps the price of the old one
+// by at least the configured percentage. For Ethereum transactions, both the
+// fee cap and the tip cap need to be bumped, while the priority is used for
+// the Cosmos transactions.
+func (mp *EVMMempool) canReplace(old, ptx *poolTx) bool {
+	if !old.isEthereumTx() || !ptx.isEthereumTx() {
+		return isBumped(big.NewInt(old.priority), big.NewInt(ptx.priority), mp.cfg.PriceBump)
+	}
+
+	return isBumped(old.gasFeeCap, ptx.gasFeeCap, mp.cfg.PriceBump) &&
+		isBumped(old.gasTipCap, ptx.gasTipCap, mp.cfg.PriceBump)
+}
+
+// isBumped returns true if the new price is higher than the old one and at
+// least the given percentage above it.
+func isBumped(oldPrice, newPrice *big.Int, priceBump uint64) bool {
+	if newPrice.Cmp(oldPrice) <= 0 {
+		return false
+	}
+
+	// newPrice * 100 >= oldPrice * (100 + priceBump)
+	threshold := new(big.Int).Mul(oldPrice, new(big.Int).SetUint64(100+priceBump))
+	return new(big.Int).Mul(newPrice, big.NewInt(100)).Cmp(threshold) >= 0
+}
+
+// newPoolTx returns the pool representation of the given transaction. The
+// sender and nonce of an Ethereum transaction are the ones of its message, the
+// sender being recovered from the signature when it isn't set by the
+// AnteHandler, while the first signer and its sequence are used for a Cosmos
+// transaction.
+func newPoolTx(tx sdk.Tx) (*poolTx, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 1 {
+		if ethMsg, ok := msgs[0].(*evmtypes.MsgEthereumTx); ok {
+			txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+			if err != nil {
+				return nil, err
+			}
+			sender := common.HexToAddress(ethMsg.From)
+			if ethMsg.From == "" {
+				if sender, err = ethMsg.GetSender(txData.GetChainID()); err != nil {
+					return nil, errorsmod.Wrapf(ErrInvalidSigner, "cannot recover sender of tx %s: %s", ethMsg.Hash, err)
+				}
+			}
+
+			return &poolTx{
+				tx:        tx,
+				sender:    sender,
+				nonce:     txData.GetNonce(),
+				hash:      ethMsg.TxHash(),
+				gasFeeCap: txData.GetGasFeeCap(),
+				gasTipCap: txData.GetGasTipCap(),
+			}, nil
+		}
+	}
+
+	signers, err := sdkmempool.NewDefaultSignerExtractionAdapter().GetSigners(tx)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidSigner, err.Error())
+	}
+	if len(signers) == 0 {
+		return nil, errorsmod.Wrap(ErrInvalidSigner, "tx must have at least one signer")
+	}
+
+	return &poolTx{
+		tx:     tx,
+		sender: common.BytesToAddress(signers[0].Signer),
+		nonce:  signers[0].Sequence,
+	}, nil
+}
+
+// isEthereumTx returns true if the pooled transaction is an Ethereum transaction.
+func (ptx *poolTx) isEthereumTx() bool {
+	return ptx.hash != (common.Hash{})
+}
@@ -0,0 +1,251 @@
+package mempool_test
+
+import (
+	"math/big"
+	"slices"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"github.com/evmos/evmos/v20/app/mempool"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+var (
+	alice = common.HexToAddress("0xa11ce")
+	bob   = common.HexToAddress("0xb0b")
+)
+
+// mockEVMKeeper returns the committed nonces of the accounts.
+type mockEVMKeeper map[common.Address]uint64
+
+func (k mockEVMKeeper) GetNonce(_ sdk.Context, addr common.Address) uint64 {
+	return k[addr]
+}
+
+// testTx is a transaction wrapping a single Ethereum message.
+type testTx struct {
+	msg *evmtypes.MsgEthereumTx
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg { return []sdk.Msg{tx.msg} }
+
+func (tx testTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+func newTx(from common.Address, nonce uint64, gasFeeCap, gasTipCap int64) testTx {
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:   big.NewInt(9001),
+		Nonce:     nonce,
+		To:        &common.Address{},
+		GasLimit:  21000,
+		GasFeeCap: big.NewInt(gasFeeCap),
+		GasTipCap: big.NewInt(gasTipCap),
+	})
+	msg.From = from.Hex()
+	return testTx{msg: msg}
+}
+
+func insert(t *testing.T, mp *mempool.EVMMempool, tx testTx, priority int64) error {
+	t.Helper()
+	return mp.Insert(sdk.Context{}.WithPriority(priority), tx)
+}
+
+func selectAll(mp *mempool.EVMMempool) []sdk.Tx {
+	var txs []sdk.Tx
+	for it := mp.Select(sdk.Context{}, nil); it != nil; it = it.Next() {
+		txs = append(txs, it.Tx())
+	}
+	return txs
+}
+
+func TestSelect(t *testing.T) {
+	keeper := mockEVMKeeper{alice: 1}
+	mp := mempool.NewEVMMempool(keeper, mempool.Config{PriceBump: 10})
+
+	stale := newTx(alice, 0, 100, 10)
+	alice1 := newTx(alice, 1, 100, 10)
+	alice2 := newTx(alice, 2, 100, 30)
+	gapped := newTx(alice, 4, 100, 50)
+	bob0 := newTx(bob, 0, 100, 20)
+
+	require.NoError(t, insert(t, mp, stale, 10))
+	require.NoError(t, insert(t, mp, alice2, 30))
+	require.NoError(t, insert(t, mp, gapped, 50))
+	require.NoError(t, insert(t, mp, alice1, 10))
+	require.NoError(t, insert(t, mp, bob0, 20))
+	require.Equal(t, 5, mp.CountTx())
+
+	// the transactions are ordered by priority while preserving the nonce order
+	// of each sender, skipping the stale and nonce-gapped ones
+	require.Equal(t, []sdk.Tx{bob0, alice1, alice2}, selectAll(mp))
+
+	// once the gap is filled, the queued transaction becomes executable
+	require.NoError(t, insert(t, mp, newTx(alice, 3, 100, 10), 10))
+	require.Len(t, selectAll(mp), 5)
+
+	require.Nil(t, mempool.NewEVMMempool(keeper, mempool.Config{}).Select(sdk.Context{}, nil))
+}
+
+func TestReplacement(t *testing.T) {
+	mp := mempool.NewEVMMempool(mockEVMKeeper{}, mempool.Config{PriceBump: 10})
+
+	original := newTx(alice, 0, 100, 10)
+	require.NoError(t, insert(t, mp, original, 10))
+
+	// inserting the same transaction again is a no-op
+	require.NoError(t, insert(t, mp, original, 10))
+	require.Equal(t, 1, mp.CountTx())
+
+	// both the fee cap and the tip cap must be bumped
+	err := insert(t, mp, newTx(alice, 0, 105, 11), 11)
+	require.ErrorIs(t, err, mempool.ErrReplacementUnderpriced)
+	err = insert(t, mp, newTx(alice, 0, 200, 10), 10)
+	require.ErrorIs(t, err, mempool.ErrReplacementUnderpriced)
+
+	replacement := newTx(alice, 0, 110, 11)
+	require.NoError(t, insert(t, mp, replacement, 11))
+	require.Equal(t, 1, mp.CountTx())
+	require.False(t, mp.Has(original.msg.TxHash()))
+	require.True(t, mp.Has(replacement.msg.TxHash()))
+	require.True(t, mp.HasNonce(alice, 0))
+
+	// removing the replaced transaction doesn't affect the replacement
+	require.ErrorIs(t, mp.Remove(original), sdkmempool.ErrTxNotFound)
+	require.Equal(t, []sdk.Tx{replacement}, selectAll(mp))
+
+	require.NoError(t, mp.Remove(replacement))
+	require.Equal(t, 0, mp.CountTx())
+	require.False(t, mp.HasNonce(alice, 0))
+}
+
+func TestEviction(t *testing.T) {
+	mp := mempool.NewEVMMempool(mockEVMKeeper{}, mempool.Config{MaxTxs: 3, PriceBump: 10})
+
+	alice0 := newTx(alice, 0, 100, 50)
+	alice1 := newTx(alice, 1, 100, 5)
+	bob0 := newTx(bob, 0, 100, 10)
+	require.NoError(t, insert(t, mp, alice0, 50))
+	require.NoError(t, insert(t, mp, alice1, 5))
+	require.NoError(t, insert(t, mp, bob0, 10))
+
+	carol := common.HexToAddress("0xca201")
+	err := insert(t, mp, newTx(carol, 0, 100, 5), 5)
+	require.ErrorIs(t, err, mempool.ErrUnderpriced)
+
+	// the cheapest transaction at the tail of a sender queue is evicted
+	carol0 := newTx(carol, 0, 100, 20)
+	require.NoError(t, insert(t, mp, carol0, 20))
+	require.Equal(t, 3, mp.CountTx())
+	require.False(t, mp.Has(alice1.msg.TxHash()))
+
+	// alice0 is now the tail of alice, so bob0 is the cheapest one
+	require.NoError(t, insert(t, mp, newTx(common.HexToAddress("0xda7e"), 0, 100, 30), 30))
+	require.False(t, mp.Has(bob0.msg.TxHash()))
+	require.True(t, mp.Has(alice0.msg.TxHash()))
+	require.True(t, mp.Has(carol0.msg.TxHash()))
+}
+
+func TestPrune(t *testing.T) {
+	keeper := mockEVMKeeper{}
+	mp := mempool.NewEVMMempool(keeper, mempool.Config{})
+
+	for nonce := uint64(0); nonce < 3; nonce++ {
+		require.NoError(t, insert(t, mp, newTx(alice, nonce, 100, 10), 10))
+	}
+	require.NoError(t, insert(t, mp, newTx(bob, 0, 100, 10), 10))
+
+	keeper[alice] = 2
+	mp.Prune(sdk.Context{})
+	require.Equal(t, 2, mp.CountTx())
+	require.False(t, mp.HasNonce(alice, 1))
+	require.True(t, mp.HasNonce(alice, 2))
+	require.True(t, mp.HasNonce(bob, 0))
+}
+
+func TestSenderLimits(t *testing.T) {
+	keeper := mockEVMKeeper{alice: 1}
+	mp := mempool.NewEVMMempool(keeper, mempool.Config{PriceBump: 10, MaxNonceGap: 3, MaxTxsPerSender: 2})
+
+	require.ErrorIs(t, insert(t, mp, newTx(alice, 5, 100, 10), 10), mempool.ErrNonceGap)
+	require.ErrorIs(t, mp.CanQueue(alice, 1, 5), mempool.ErrNonceGap)
+
+	require.NoError(t, insert(t, mp, newTx(alice, 1, 100, 10), 10))
+	require.NoError(t, insert(t, mp, newTx(alice, 4, 100, 10), 10))
+	require.ErrorIs(t, insert(t, mp, newTx(alice, 2, 100, 10), 10), mempool.ErrSenderLimit)
+	require.ErrorIs(t, mp.CanQueue(alice, 1, 2), mempool.ErrSenderLimit)
+
+	// replacements and other senders aren't affected by the limits
+	require.NoError(t, insert(t, mp, newTx(alice, 4, 110, 11), 11))
+	require.NoError(t, insert(t, mp, newTx(bob, 0, 100, 10), 10))
+	require.NoError(t, mp.CanQueue(bob, 0, 3))
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestPruneQueued(t *testing.T) {
+	keeper := mockEVMKeeper{}
+	mp := mempool.NewEVMMempool(keeper, mempool.Config{QueuedTTL: time.Hour})
+
+	start := time.Unix(1_700_000_000, 0)
+	ctx := sdk.Context{}.WithBlockTime(start)
+	for _, nonce := range []uint64{0, 1, 3} {
+		require.NoError(t, mp.Insert(ctx.WithPriority(10), newTx(alice, nonce, 100, 10)))
+	}
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), newTx(bob, 2, 100, 10)))
+
+	mp.Prune(ctx.WithBlockTime(start.Add(time.Hour)))
+	require.Equal(t, 4, mp.CountTx())
+
+	// the executable transactions are kept, while the queued ones expire
+	mp.Prune(ctx.WithBlockTime(start.Add(time.Hour + time.Second)))
+	require.Equal(t, 2, mp.CountTx())
+	require.True(t, mp.HasNonce(alice, 0))
+	require.True(t, mp.HasNonce(alice, 1))
+	require.False(t, mp.HasNonce(alice, 3))
+	require.False(t, mp.HasNonce(bob, 2))
+}
+
+func TestTxs(t *testing.T) {
+	mp := mempool.NewEVMMempool(mockEVMKeeper{}, mempool.Config{})
+
+	alice0, alice2, bob0 := newTx(alice, 0, 100, 10), newTx(alice, 2, 100, 10), newTx(bob, 0, 100, 10)
+	require.NoError(t, insert(t, mp, alice2, 10))
+	require.NoError(t, insert(t, mp, bob0, 10))
+	require.NoError(t, insert(t, mp, alice0, 10))
+
+	// the queued transactions are returned along with the executable ones
+	txs := mp.Txs()
+	require.ElementsMatch(t, []sdk.Tx{alice0, alice2, bob0}, txs)
+	require.Less(t, slices.Index(txs, sdk.Tx(alice0)), slices.Index(txs, sdk.Tx(alice2)))
+}
+
+func TestSenderRecovery(t *testing.T) {
+	mp := mempool.NewEVMMempool(mockEVMKeeper{}, mempool.Config{})
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	chainID := big.NewInt(9001)
+	ethTx := ethtypes.MustSignNewTx(key, ethtypes.LatestSignerForChainID(chainID), &ethtypes.DynamicFeeTx{
+		ChainID:   chainID,
+		Gas:       21000,
+		GasFeeCap: big.NewInt(100),
+		GasTipCap: big.NewInt(10),
+		To:        &common.Address{},
+	})
+	msg := &evmtypes.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(ethTx))
+	require.Empty(t, msg.From)
+
+	// the sender is recovered from the signature when it isn't set
+	tx := testTx{msg: msg}
+	require.NoError(t, insert(t, mp, tx, 10))
+	require.True(t, mp.HasNonce(crypto.PubkeyToAddress(key.PublicKey), 0))
+	require.NoError(t, mp.Remove(testTx{msg: &evmtypes.MsgEthereumTx{Data: msg.Data, Hash: msg.Hash}}))
+	require.Equal(t, 0, mp.CountTx())
+}
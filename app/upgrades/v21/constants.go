@@ -0,0 +1,9 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package v21
+
+const (
+	// UpgradeName is the shared upgrade plan name for mainnet
+	UpgradeName = "v21.0.0"
+)
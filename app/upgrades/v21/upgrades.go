@@ -0,0 +1,76 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package v21
+
+import (
+	"context"
+	"slices"
+
+	"cosmossdk.io/log"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmkeeper "github.com/evmos/evmos/v20/x/evm/keeper"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// NewStaticPrecompiles defines the static precompiles added to the default
+// active precompiles since v20, which are enabled on the upgrade. The dispatch
+// precompile is not enabled, since it has to be enabled through governance.
+var NewStaticPrecompiles = []string{
+	evmtypes.SlashingPrecompileAddress,
+	evmtypes.AuthzPrecompileAddress,
+	evmtypes.FeegrantPrecompileAddress,
+}
+
+// CreateUpgradeHandler creates an SDK upgrade handler for v21
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	ek *evmkeeper.Keeper,
+) upgradetypes.UpgradeHandler {
+	return func(c context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		ctx := sdk.UnwrapSDKContext(c)
+		logger := ctx.Logger().With("upgrade", UpgradeName)
+
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := EnableNewStaticPrecompiles(ctx, logger, ek); err != nil {
+			return nil, err
+		}
+
+		logger.Info("activating the Prague fork", "height", ctx.BlockHeight())
+		if err := evmtypes.ActivatePrague(ctx.BlockHeight()); err != nil {
+			return nil, err
+		}
+
+		return vm, nil
+	}
+}
+
+// EnableNewStaticPrecompiles enables the new static precompiles that are not
+// already active, e.g. because they were enabled through governance.
+func EnableNewStaticPrecompiles(ctx sdk.Context, logger log.Logger, ek *evmkeeper.Keeper) error {
+	active := ek.GetParams(ctx).ActiveStaticPrecompiles
+
+	var addresses []common.Address
+	for _, precompile := range NewStaticPrecompiles {
+		if slices.Contains(active, precompile) {
+			continue
+		}
+		addresses = append(addresses, common.HexToAddress(precompile))
+	}
+
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	logger.Info("enabling static precompiles", "addresses", addresses)
+	return ek.EnableStaticPrecompiles(ctx, addresses...)
+}
@@ -0,0 +1,71 @@
+package v21_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v21 "github.com/evmos/evmos/v20/app/upgrades/v21"
+	testnetwork "github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func TestEnableNewStaticPrecompiles(t *testing.T) {
+	// the precompiles active before the upgrade
+	v20Precompiles := []string{
+		evmtypes.P256PrecompileAddress,
+		evmtypes.Bech32PrecompileAddress,
+		evmtypes.StakingPrecompileAddress,
+		evmtypes.DistributionPrecompileAddress,
+		evmtypes.ICS20PrecompileAddress,
+		evmtypes.VestingPrecompileAddress,
+		evmtypes.BankPrecompileAddress,
+		evmtypes.GovPrecompileAddress,
+	}
+
+	testCases := []struct {
+		name   string
+		active []string
+		expLen int
+	}{
+		{
+			name:   "none of the new precompiles is active",
+			active: v20Precompiles,
+			expLen: 11,
+		},
+		{
+			name:   "some of the new precompiles are active",
+			active: append(slices.Clone(v20Precompiles), evmtypes.AuthzPrecompileAddress),
+			expLen: 11,
+		},
+		{
+			name:   "the dispatch precompile is active",
+			active: append(slices.Clone(v20Precompiles), evmtypes.DispatchPrecompileAddress),
+			expLen: 12,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			evmGenesisState := evmtypes.DefaultGenesisState()
+			evmGenesisState.Params.ActiveStaticPrecompiles = tc.active
+			network := testnetwork.NewUnitTestNetwork(
+				testnetwork.WithCustomGenesis(testnetwork.CustomGenesisState{
+					evmtypes.ModuleName: evmGenesisState,
+				}),
+			)
+			ctx := network.GetContext()
+
+			err := v21.EnableNewStaticPrecompiles(ctx, ctx.Logger(), network.App.EvmKeeper)
+			require.NoError(t, err)
+
+			active := network.App.EvmKeeper.GetParams(ctx).ActiveStaticPrecompiles
+			for _, precompile := range append(tc.active, v21.NewStaticPrecompiles...) {
+				require.Contains(t, active, precompile)
+			}
+			// the dispatch precompile is only active if it already was
+			require.Len(t, active, tc.expLen)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @author The Evmos Core Team
+/// @title IBC Callbacks Interface
+/// @dev The interface that contracts registered as IBC callbacks in the memo of
+/// an ICS20 transfer must implement. The callbacks are called by the ICS20
+/// transfer module account, so contracts should check the caller.
+interface IIBCCallbacks {
+    /// @dev onPacketAcknowledgement is called on the source callback contract
+    /// when the packet is acknowledged by the destination chain.
+    /// @param channelId The source channel of the packet
+    /// @param portId The source port of the packet
+    /// @param sequence The sequence of the packet
+    /// @param data The JSON-encoded packet data
+    /// @param acknowledgement The JSON-encoded acknowledgement
+    function onPacketAcknowledgement(
+        string calldata channelId,
+        string calldata portId,
+        uint64 sequence,
+        bytes calldata data,
+        bytes calldata acknowledgement
+    ) external;
+
+    /// @dev onPacketTimeout is called on the source callback contract when the
+    /// packet times out.
+    /// @param channelId The source channel of the packet
+    /// @param portId The source port of the packet
+    /// @param sequence The sequence of the packet
+    /// @param data The JSON-encoded packet data
+    function onPacketTimeout(
+        string calldata channelId,
+        string calldata portId,
+        uint64 sequence,
+        bytes calldata data
+    ) external;
+
+    /// @dev onPacketReceive is called on the destination callback contract
+    /// after the packet is received successfully.
+    /// @param channelId The destination channel of the packet
+    /// @param portId The destination port of the packet
+    /// @param sequence The sequence of the packet
+    /// @param data The JSON-encoded packet data
+    function onPacketReceive(
+        string calldata channelId,
+        string calldata portId,
+        uint64 sequence,
+        bytes calldata data
+    ) external;
+}
@@ -0,0 +1,11 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package callbacks
+
+import "errors"
+
+var (
+	ErrInvalidCallbackData = errors.New("invalid callback data")
+	ErrCallbackFailed      = errors.New("callback execution failed")
+	ErrInvalidCallbackAddr = errors.New("callback contract is not the packet sender")
+)
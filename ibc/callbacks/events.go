@@ -0,0 +1,52 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package callbacks
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// callbacks events
+const (
+	EventTypeSourceCallback      = "ibc_src_callback"
+	EventTypeDestinationCallback = "ibc_dest_callback"
+
+	AttributeKeyCallbackMethod   = "callback_method"
+	AttributeKeyContractAddress  = "callback_address"
+	AttributeKeyGasLimit         = "callback_gas_limit"
+	AttributeKeyPacketSequence   = "packet_sequence"
+	AttributeKeyPacketSrcChannel = "packet_src_channel"
+	AttributeKeyPacketDstChannel = "packet_dst_channel"
+	AttributeKeySuccess          = "success"
+	AttributeKeyError            = "error"
+)
+
+// emitCallbackEvent emits the event of a callback execution, along with its
+// error if the callback failed.
+func emitCallbackEvent(
+	ctx sdk.Context,
+	eventType string,
+	packet channeltypes.Packet,
+	method string,
+	callback CallbackData,
+	err error,
+) {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(AttributeKeyCallbackMethod, method),
+		sdk.NewAttribute(AttributeKeyContractAddress, callback.ContractAddress.Hex()),
+		sdk.NewAttribute(AttributeKeyGasLimit, strconv.FormatUint(callback.GasLimit, 10)),
+		sdk.NewAttribute(AttributeKeyPacketSequence, strconv.FormatUint(packet.Sequence, 10)),
+		sdk.NewAttribute(AttributeKeyPacketSrcChannel, packet.SourceChannel),
+		sdk.NewAttribute(AttributeKeyPacketDstChannel, packet.DestinationChannel),
+		sdk.NewAttribute(AttributeKeySuccess, strconv.FormatBool(err == nil)),
+	}
+	if err != nil {
+		attrs = append(attrs, sdk.NewAttribute(AttributeKeyError, err.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(eventType, attrs...))
+}
@@ -0,0 +1,242 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package callbacks
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/evmos/evmos/v20/ibc"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+var _ porttypes.IBCModule = &IBCMiddleware{}
+
+// EVMKeeper defines the expected EVM keeper used to call the callback contracts
+// and to retrieve their gas limit.
+type EVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+	CallEVMWithDataAndGasLimit(
+		ctx sdk.Context,
+		from common.Address,
+		contract *common.Address,
+		data []byte,
+		gasLimit uint64,
+	) (*evmtypes.MsgEthereumTxResponse, error)
+}
+
+// AccountKeeper defines the expected account keeper used to retrieve the
+// account calling the callback contracts.
+type AccountKeeper interface {
+	GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI
+}
+
+// IBCMiddleware implements the ICS26 callbacks for the ADR-8 callbacks
+// middleware of the transfer stack. The contracts registered in the memo of
+// an ICS20 transfer are called back through the EVM keeper:
+//   - the source callback on acknowledgement and timeout of the packet, which
+//     must be the sender of the transfer
+//   - the destination callback on the receipt of the packet
+//
+// The callbacks are called by the ICS20 transfer module account.
+type IBCMiddleware struct {
+	*ibc.Module
+	evmKeeper     EVMKeeper
+	accountKeeper AccountKeeper
+	abi           abi.ABI
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keepers and the
+// underlying application. The maximum gas limit of a callback is defined by
+// the EVM parameters.
+func NewIBCMiddleware(
+	evmKeeper EVMKeeper,
+	accountKeeper AccountKeeper,
+	app porttypes.IBCModule,
+) IBCMiddleware {
+	callbacksABI, err := LoadABI()
+	if err != nil {
+		panic(fmt.Errorf("failed to load IBC callbacks ABI: %w", err))
+	}
+
+	return IBCMiddleware{
+		Module:        ibc.NewModule(app),
+		evmKeeper:     evmKeeper,
+		accountKeeper: accountKeeper,
+		abi:           callbacksABI,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// It receives the packet through the underlying application and then calls
+// the destination callback contract, if any. An error acknowledgement is
+// returned if the callback fails, which reverts the receipt of the packet.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	ack := im.Module.OnRecvPacket(ctx, packet, relayer)
+
+	// return if the acknowledgement is an error ACK
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// NOTE: shouldn't happen as the packet has already
+		// been decoded on ICS20 transfer logic
+		return ack
+	}
+
+	callback, found, err := GetCallbackData(data.Memo, DestinationCallbackKey, im.evmKeeper.GetParams(ctx).GetCallbackGasLimit())
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if !found {
+		return ack
+	}
+
+	if err := im.processCallback(
+		ctx, EventTypeDestinationCallback, packet, callback, OnPacketReceiveMethod,
+		packet.DestinationChannel, packet.DestinationPort, packet.Sequence, packet.GetData(),
+	); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return ack
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+// It processes the acknowledgement through the underlying application and
+// then calls the source callback contract, if any. A failed callback doesn't
+// revert the acknowledgement.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.Module.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	callback, found := im.getSourceCallback(ctx, packet, OnPacketAcknowledgementMethod)
+	if !found {
+		return nil
+	}
+
+	// the error is emitted on the callback event
+	_ = im.processCallback(
+		ctx, EventTypeSourceCallback, packet, callback, OnPacketAcknowledgementMethod,
+		packet.SourceChannel, packet.SourcePort, packet.Sequence, packet.GetData(), acknowledgement,
+	)
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+// It processes the timeout through the underlying application and then calls
+// the source callback contract, if any. A failed callback doesn't revert the
+// timeout.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.Module.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	callback, found := im.getSourceCallback(ctx, packet, OnPacketTimeoutMethod)
+	if !found {
+		return nil
+	}
+
+	// the error is emitted on the callback event
+	_ = im.processCallback(
+		ctx, EventTypeSourceCallback, packet, callback, OnPacketTimeoutMethod,
+		packet.SourceChannel, packet.SourcePort, packet.Sequence, packet.GetData(),
+	)
+	return nil
+}
+
+// getSourceCallback returns the source callback registered in the memo of the
+// packet, if any. The callback is only returned if its contract is the sender
+// of the transfer, so that a contract can't be called back for a transfer it
+// didn't send. An invalid callback is reported through the callback event.
+func (im IBCMiddleware) getSourceCallback(ctx sdk.Context, packet channeltypes.Packet, method string) (CallbackData, bool) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return CallbackData{}, false
+	}
+
+	callback, found, err := GetCallbackData(data.Memo, SourceCallbackKey, im.evmKeeper.GetParams(ctx).GetCallbackGasLimit())
+	if err != nil {
+		emitCallbackEvent(ctx, EventTypeSourceCallback, packet, method, callback, err)
+		return CallbackData{}, false
+	}
+	if !found {
+		return CallbackData{}, false
+	}
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil || common.BytesToAddress(sender) != callback.ContractAddress {
+		err = errorsmod.Wrapf(ErrInvalidCallbackAddr, "%s, sender %s", callback.ContractAddress.Hex(), data.Sender)
+		emitCallbackEvent(ctx, EventTypeSourceCallback, packet, method, callback, err)
+		return CallbackData{}, false
+	}
+
+	return callback, true
+}
+
+// processCallback calls the method of the callback contract with the given
+// arguments and gas limit. The call is executed on a cached context, so that
+// its state changes are only written if it succeeds. The gas used by the call,
+// or its whole gas limit if it fails, is consumed from the context gas meter.
+func (im IBCMiddleware) processCallback(
+	ctx sdk.Context,
+	eventType string,
+	packet channeltypes.Packet,
+	callback CallbackData,
+	method string,
+	args ...interface{},
+) error {
+	input, err := im.abi.Pack(method, args...)
+	if err != nil {
+		return err
+	}
+
+	caller := im.accountKeeper.GetModuleAccount(ctx, transfertypes.ModuleName)
+	from := common.BytesToAddress(caller.GetAddress())
+
+	// the EVM gas is charged below, so the store operations of the
+	// callback are not charged on the context gas meter
+	cacheCtx, writeFn := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	gasUsed := callback.GasLimit
+	res, err := im.evmKeeper.CallEVMWithDataAndGasLimit(cacheCtx, from, &callback.ContractAddress, input, callback.GasLimit)
+	if err == nil {
+		gasUsed = res.GasUsed
+		writeFn()
+	} else {
+		err = errorsmod.Wrapf(ErrCallbackFailed, "%s of %s: %s", method, callback.ContractAddress.Hex(), err)
+	}
+
+	ctx.GasMeter().ConsumeGas(gasUsed, "ibc callback")
+	emitCallbackEvent(ctx, eventType, packet, method, callback, err)
+	return err
+}
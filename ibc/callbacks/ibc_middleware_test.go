@@ -0,0 +1,219 @@
+package callbacks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// mockApp is the underlying application of the middleware, which only
+// implements the packet callbacks.
+type mockApp struct {
+	porttypes.IBCModule
+	ack exported.Acknowledgement
+}
+
+func (m mockApp) OnRecvPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) exported.Acknowledgement {
+	return m.ack
+}
+
+func (mockApp) OnAcknowledgementPacket(sdk.Context, channeltypes.Packet, []byte, sdk.AccAddress) error {
+	return nil
+}
+
+func (mockApp) OnTimeoutPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) error {
+	return nil
+}
+
+// maxCallbackGas is the gas limit of the callbacks set in the EVM params.
+const maxCallbackGas uint64 = 500_000
+
+// call is a call to the EVM keeper.
+type call struct {
+	from     common.Address
+	contract common.Address
+	method   string
+	gasLimit uint64
+}
+
+// mockEVMKeeper records the calls to the contracts.
+type mockEVMKeeper struct {
+	calls []call
+	err   error
+}
+
+func (m *mockEVMKeeper) GetParams(_ sdk.Context) evmtypes.Params {
+	params := evmtypes.DefaultParams()
+	params.MaxCallbackGas = maxCallbackGas
+	return params
+}
+
+func (m *mockEVMKeeper) CallEVMWithDataAndGasLimit(
+	_ sdk.Context,
+	from common.Address,
+	contract *common.Address,
+	data []byte,
+	gasLimit uint64,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	callbacksABI, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+	method, err := callbacksABI.MethodById(data[:4])
+	if err != nil {
+		return nil, err
+	}
+
+	m.calls = append(m.calls, call{from: from, contract: *contract, method: method.Name, gasLimit: gasLimit})
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &evmtypes.MsgEthereumTxResponse{GasUsed: gasLimit / 2}, nil
+}
+
+// mockAccountKeeper returns the module accounts.
+type mockAccountKeeper struct{}
+
+func (mockAccountKeeper) GetModuleAccount(_ context.Context, moduleName string) sdk.ModuleAccountI {
+	return authtypes.NewEmptyModuleAccount(moduleName)
+}
+
+func TestIBCMiddleware(t *testing.T) {
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	transferModule := common.BytesToAddress(authtypes.NewModuleAddress(transfertypes.ModuleName))
+	successAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+
+	newPacket := func(sender common.Address, memo string) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData(
+			"aevmos", "100", sdk.AccAddress(sender.Bytes()).String(), sdk.AccAddress(contract.Bytes()).String(), memo,
+		)
+		return channeltypes.NewPacket(
+			data.GetBytes(), 1, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-1", clienttypes.NewHeight(0, 100), 0,
+		)
+	}
+
+	testCases := []struct {
+		name      string
+		run       func(im IBCMiddleware, ctx sdk.Context)
+		evmErr    error
+		expCalls  []call
+		expEvents []string
+	}{
+		{
+			"receive - no callback",
+			func(im IBCMiddleware, ctx sdk.Context) {
+				ack := im.OnRecvPacket(ctx, newPacket(contract, ""), nil)
+				require.True(t, ack.Success())
+			},
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"receive - invalid callback returns an error ack",
+			func(im IBCMiddleware, ctx sdk.Context) {
+				ack := im.OnRecvPacket(ctx, newPacket(contract, `{"dest_callback": {"address": "invalid"}}`), nil)
+				require.False(t, ack.Success())
+			},
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"receive - destination callback is called",
+			func(im IBCMiddleware, ctx sdk.Context) {
+				ack := im.OnRecvPacket(ctx, newPacket(contract, `{"dest_callback": {"address": "0x1000000000000000000000000000000000000001", "gas_limit": "200000"}}`), nil)
+				require.True(t, ack.Success())
+				require.Equal(t, uint64(100_000), ctx.GasMeter().GasConsumed())
+			},
+			nil,
+			[]call{{from: transferModule, contract: contract, method: OnPacketReceiveMethod, gasLimit: 200_000}},
+			[]string{EventTypeDestinationCallback},
+		},
+		{
+			"receive - failed destination callback returns an error ack",
+			func(im IBCMiddleware, ctx sdk.Context) {
+				ack := im.OnRecvPacket(ctx, newPacket(contract, `{"dest_callback": {"address": "0x1000000000000000000000000000000000000001", "gas_limit": "200000"}}`), nil)
+				require.False(t, ack.Success())
+				require.Equal(t, uint64(200_000), ctx.GasMeter().GasConsumed())
+			},
+			errors.New("execution reverted"),
+			[]call{{from: transferModule, contract: contract, method: OnPacketReceiveMethod, gasLimit: 200_000}},
+			[]string{EventTypeDestinationCallback},
+		},
+		{
+			"acknowledgement - source callback is called",
+			func(im IBCMiddleware, ctx sdk.Context) {
+				err := im.OnAcknowledgementPacket(ctx, newPacket(contract, `{"src_callback": {"address": "0x1000000000000000000000000000000000000001"}}`), successAck.Acknowledgement(), nil)
+				require.NoError(t, err)
+			},
+			nil,
+			[]call{{from: transferModule, contract: contract, method: OnPacketAcknowledgementMethod, gasLimit: maxCallbackGas}},
+			[]string{EventTypeSourceCallback},
+		},
+		{
+			"acknowledgement - failed source callback doesn't fail the acknowledgement",
+			func(im IBCMiddleware, ctx sdk.Context) {
+				err := im.OnAcknowledgementPacket(ctx, newPacket(contract, `{"src_callback": {"address": "0x1000000000000000000000000000000000000001"}}`), successAck.Acknowledgement(), nil)
+				require.NoError(t, err)
+			},
+			errors.New("execution reverted"),
+			[]call{{from: transferModule, contract: contract, method: OnPacketAcknowledgementMethod, gasLimit: maxCallbackGas}},
+			[]string{EventTypeSourceCallback},
+		},
+		{
+			"acknowledgement - source callback is not the sender",
+			func(im IBCMiddleware, ctx sdk.Context) {
+				sender := common.HexToAddress("0x2000000000000000000000000000000000000002")
+				err := im.OnAcknowledgementPacket(ctx, newPacket(sender, `{"src_callback": {"address": "0x1000000000000000000000000000000000000001"}}`), successAck.Acknowledgement(), nil)
+				require.NoError(t, err)
+			},
+			nil,
+			nil,
+			[]string{EventTypeSourceCallback},
+		},
+		{
+			"timeout - source callback is called",
+			func(im IBCMiddleware, ctx sdk.Context) {
+				err := im.OnTimeoutPacket(ctx, newPacket(contract, `{"src_callback": {"address": "0x1000000000000000000000000000000000000001", "gas_limit": "50000"}}`), nil)
+				require.NoError(t, err)
+			},
+			nil,
+			[]call{{from: transferModule, contract: contract, method: OnPacketTimeoutMethod, gasLimit: 50_000}},
+			[]string{EventTypeSourceCallback},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"))
+			evmKeeper := &mockEVMKeeper{err: tc.evmErr}
+			im := NewIBCMiddleware(evmKeeper, mockAccountKeeper{}, mockApp{ack: successAck})
+
+			tc.run(im, ctx)
+
+			require.Equal(t, tc.expCalls, evmKeeper.calls)
+
+			var events []string
+			for _, event := range ctx.EventManager().Events() {
+				events = append(events, event.Type)
+			}
+			require.Equal(t, tc.expEvents, events)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package callbacks
+
+import (
+	"embed"
+	"encoding/json"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+)
+
+const (
+	// SourceCallbackKey is the memo key of the callback called on the source
+	// chain when the packet is acknowledged or times out.
+	SourceCallbackKey = "src_callback"
+	// DestinationCallbackKey is the memo key of the callback called on the
+	// destination chain when the packet is received.
+	DestinationCallbackKey = "dest_callback"
+)
+
+const (
+	// OnPacketAcknowledgementMethod defines the ABI method called on acknowledgement.
+	OnPacketAcknowledgementMethod = "onPacketAcknowledgement"
+	// OnPacketTimeoutMethod defines the ABI method called on timeout.
+	OnPacketTimeoutMethod = "onPacketTimeout"
+	// OnPacketReceiveMethod defines the ABI method called on receive.
+	OnPacketReceiveMethod = "onPacketReceive"
+)
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// LoadABI loads the IBC callbacks ABI from the embedded abi.json file.
+func LoadABI() (abi.ABI, error) {
+	return cmn.LoadABI(f, "abi.json")
+}
+
+// CallbackData defines a callback registered in the memo of a packet.
+type CallbackData struct {
+	// ContractAddress is the address of the contract called back
+	ContractAddress common.Address
+	// GasLimit is the gas limit of the call to the contract
+	GasLimit uint64
+}
+
+// callbackMemo is the JSON representation of a callback in the packet memo,
+// as defined in ADR-8:
+//
+//	{"src_callback": {"address": "0x...", "gas_limit": "100000"}}
+type callbackMemo struct {
+	Address  string `json:"address"`
+	GasLimit string `json:"gas_limit,omitempty"`
+}
+
+// GetCallbackData returns the callback registered under the given key of the
+// packet memo, if any. The gas limit of the callback is capped to the given
+// maximum, which is also used when the memo doesn't define it. A memo which is
+// not a JSON object doesn't register any callback.
+func GetCallbackData(memo, key string, maxCallbackGas uint64) (CallbackData, bool, error) {
+	if memo == "" {
+		return CallbackData{}, false, nil
+	}
+
+	var memoFields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &memoFields); err != nil {
+		return CallbackData{}, false, nil
+	}
+
+	rawCallback, ok := memoFields[key]
+	if !ok {
+		return CallbackData{}, false, nil
+	}
+
+	var callback callbackMemo
+	if err := json.Unmarshal(rawCallback, &callback); err != nil {
+		return CallbackData{}, false, errorsmod.Wrapf(ErrInvalidCallbackData, "%s: %s", key, err)
+	}
+
+	if !common.IsHexAddress(callback.Address) {
+		return CallbackData{}, false, errorsmod.Wrapf(ErrInvalidCallbackData, "invalid %s contract address %q", key, callback.Address)
+	}
+
+	gasLimit := maxCallbackGas
+	if callback.GasLimit != "" {
+		userGasLimit, err := strconv.ParseUint(callback.GasLimit, 10, 64)
+		if err != nil {
+			return CallbackData{}, false, errorsmod.Wrapf(ErrInvalidCallbackData, "invalid %s gas limit %q", key, callback.GasLimit)
+		}
+		if userGasLimit > 0 && userGasLimit < maxCallbackGas {
+			gasLimit = userGasLimit
+		}
+	}
+
+	return CallbackData{
+		ContractAddress: common.HexToAddress(callback.Address),
+		GasLimit:        gasLimit,
+	}, true, nil
+}
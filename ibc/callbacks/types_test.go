@@ -0,0 +1,91 @@
+package callbacks
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCallbackData(t *testing.T) {
+	const maxGas uint64 = 1_000_000
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	testCases := []struct {
+		name     string
+		memo     string
+		key      string
+		expFound bool
+		expData  CallbackData
+		expError bool
+	}{
+		{
+			name: "empty memo",
+			memo: "",
+			key:  SourceCallbackKey,
+		},
+		{
+			name: "memo is not a JSON object",
+			memo: "hello",
+			key:  SourceCallbackKey,
+		},
+		{
+			name: "no callback under the key",
+			memo: `{"dest_callback": {"address": "0x1000000000000000000000000000000000000001"}}`,
+			key:  SourceCallbackKey,
+		},
+		{
+			name:     "invalid callback object",
+			memo:     `{"src_callback": "0x1000000000000000000000000000000000000001"}`,
+			key:      SourceCallbackKey,
+			expError: true,
+		},
+		{
+			name:     "invalid contract address",
+			memo:     `{"src_callback": {"address": "evmos1invalid"}}`,
+			key:      SourceCallbackKey,
+			expError: true,
+		},
+		{
+			name:     "invalid gas limit",
+			memo:     `{"src_callback": {"address": "0x1000000000000000000000000000000000000001", "gas_limit": "-1"}}`,
+			key:      SourceCallbackKey,
+			expError: true,
+		},
+		{
+			name:     "no gas limit uses the maximum",
+			memo:     `{"src_callback": {"address": "0x1000000000000000000000000000000000000001"}}`,
+			key:      SourceCallbackKey,
+			expFound: true,
+			expData:  CallbackData{ContractAddress: contract, GasLimit: maxGas},
+		},
+		{
+			name:     "gas limit above the maximum is capped",
+			memo:     `{"dest_callback": {"address": "0x1000000000000000000000000000000000000001", "gas_limit": "5000000"}}`,
+			key:      DestinationCallbackKey,
+			expFound: true,
+			expData:  CallbackData{ContractAddress: contract, GasLimit: maxGas},
+		},
+		{
+			name:     "gas limit and other memo fields",
+			memo:     `{"forward": {}, "src_callback": {"address": "0x1000000000000000000000000000000000000001", "gas_limit": "100000"}}`,
+			key:      SourceCallbackKey,
+			expFound: true,
+			expData:  CallbackData{ContractAddress: contract, GasLimit: 100_000},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, found, err := GetCallbackData(tc.memo, tc.key, maxGas)
+			if tc.expError {
+				require.ErrorIs(t, err, ErrInvalidCallbackData)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expFound, found)
+			require.Equal(t, tc.expData, data)
+		})
+	}
+}
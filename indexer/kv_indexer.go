@@ -3,7 +3,9 @@
 package indexer
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -15,17 +17,24 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	rpctypes "github.com/evmos/evmos/v20/rpc/types"
 	evmostypes "github.com/evmos/evmos/v20/types"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash     = 1
+	KeyPrefixTxIndex    = 2
+	KeyPrefixBlockBloom = 3
+	KeyPrefixBlockLogs  = 4
+	KeyPrefixLogAddress = 5
+	KeyPrefixLogTopic   = 6
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
+	// BlockKeyLength is the length of the block bloom and block logs keys
+	BlockKeyLength = 1 + 8
 )
 
 var _ evmostypes.EVMTxIndexer = &KVIndexer{}
@@ -55,6 +64,8 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 
 	// record index of valid eth tx during the iteration
 	var ethTxIndex int32
+	// logs of the eth txs, grouped by tx
+	var blockLogs [][]*ethtypes.Log
 	for txIndex, tx := range block.Txs {
 		result := txResults[txIndex]
 		if !rpctypes.TxSucessOrExpectedFailure(result) {
@@ -77,6 +88,13 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 			continue
 		}
 
+		txLogs, err := rpctypes.AllTxLogsFromEvents(result.Events)
+		if err != nil {
+			kv.logger.Error("Fail to parse tx logs", "err", err, "block", height, "txIndex", txIndex)
+		} else {
+			blockLogs = append(blockLogs, txLogs...)
+		}
+
 		var cumulativeGasUsed uint64
 		for msgIndex, msg := range tx.GetMsgs() {
 			ethMsg := msg.(*evmtypes.MsgEthereumTx)
@@ -115,12 +133,68 @@ func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxR
 			}
 		}
 	}
+	// the log index is only extended from its bounds, to avoid creating gaps
+	adjacent, err := kv.adjacentToLogIndex(height)
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	if adjacent {
+		if err := saveBlockLogs(batch, height, blockLogs); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+	}
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
 	}
 	return nil
 }
 
+// IndexBlockLogs indexes the bloom and the logs of the eth txs in a block, without
+// indexing the txs themselves. It's used to backfill the log index of the blocks
+// indexed before it was introduced.
+func (kv *KVIndexer) IndexBlockLogs(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	height := block.Header.Height
+
+	adjacent, err := kv.adjacentToLogIndex(height)
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlockLogs %d", height)
+	}
+	if !adjacent {
+		return fmt.Errorf("IndexBlockLogs %d, block is not adjacent to the indexed range", height)
+	}
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	var blockLogs [][]*ethtypes.Log
+	for txIndex, tx := range block.Txs {
+		result := txResults[txIndex]
+		if result.Code != abci.CodeTypeOK {
+			continue
+		}
+
+		tx, err := kv.clientCtx.TxConfig.TxDecoder()(tx)
+		if err != nil || !isEthTx(tx) {
+			continue
+		}
+
+		txLogs, err := rpctypes.AllTxLogsFromEvents(result.Events)
+		if err != nil {
+			kv.logger.Error("Fail to parse tx logs", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+		blockLogs = append(blockLogs, txLogs...)
+	}
+
+	if err := saveBlockLogs(batch, height, blockLogs); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlockLogs %d", height)
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlockLogs %d, write batch", height)
+	}
+	return nil
+}
+
 // LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
 func (kv *KVIndexer) LastIndexedBlock() (int64, error) {
 	return LoadLastBlock(kv.db)
@@ -159,6 +233,159 @@ func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*evmo
 	return kv.GetByTxHash(common.BytesToHash(bz))
 }
 
+// LogIndexRange returns the first and the last block numbers covered by the log
+// index, returns -1 for both if the log index is empty
+func (kv *KVIndexer) LogIndexRange() (int64, int64, error) {
+	first, err := loadBlockKeyBound(kv.db, false)
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "LogIndexRange")
+	}
+	last, err := loadBlockKeyBound(kv.db, true)
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "LogIndexRange")
+	}
+	return first, last, nil
+}
+
+// adjacentToLogIndex returns true if indexing the logs of the block keeps the
+// log index contiguous, i.e. the block is within or next to the indexed range
+func (kv *KVIndexer) adjacentToLogIndex(height int64) (bool, error) {
+	first, last, err := kv.LogIndexRange()
+	if err != nil {
+		return false, err
+	}
+	return first == -1 || (height >= first-1 && height <= last+1), nil
+}
+
+// GetBlockBloom returns the bloom of the logs emitted in the block
+func (kv *KVIndexer) GetBlockBloom(blockNumber int64) (ethtypes.Bloom, error) {
+	has, err := kv.db.Has(BlockBloomKey(blockNumber))
+	if err != nil {
+		return ethtypes.Bloom{}, errorsmod.Wrapf(err, "GetBlockBloom %d", blockNumber)
+	}
+	if !has {
+		return ethtypes.Bloom{}, fmt.Errorf("block logs not indexed, block: %d", blockNumber)
+	}
+	bz, err := kv.db.Get(BlockBloomKey(blockNumber))
+	if err != nil {
+		return ethtypes.Bloom{}, errorsmod.Wrapf(err, "GetBlockBloom %d", blockNumber)
+	}
+	return ethtypes.BytesToBloom(bz), nil
+}
+
+// GetLogsByHeight returns the logs of the eth txs in the block, grouped by tx
+func (kv *KVIndexer) GetLogsByHeight(blockNumber int64) ([][]*ethtypes.Log, error) {
+	has, err := kv.db.Has(BlockBloomKey(blockNumber))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetLogsByHeight %d", blockNumber)
+	}
+	if !has {
+		return nil, fmt.Errorf("block logs not indexed, block: %d", blockNumber)
+	}
+
+	bz, err := kv.db.Get(BlockLogsKey(blockNumber))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetLogsByHeight %d", blockNumber)
+	}
+	blockLogs := [][]*ethtypes.Log{}
+	if len(bz) == 0 {
+		return blockLogs, nil
+	}
+	if err := json.Unmarshal(bz, &blockLogs); err != nil {
+		return nil, errorsmod.Wrapf(err, "GetLogsByHeight %d", blockNumber)
+	}
+	return blockLogs, nil
+}
+
+// FilterLogBlocks returns the numbers of the blocks within [from, to] that contain
+// logs emitted by one of the addresses and with one of the topics of each position.
+// The criteria are matched per block and not per log, so the logs of the returned
+// blocks still need to be filtered.
+func (kv *KVIndexer) FilterLogBlocks(
+	from, to int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+) ([]int64, error) {
+	if from > to {
+		return []int64{}, nil
+	}
+
+	var candidates map[int64]struct{}
+	intersect := func(keys [][]byte) error {
+		blocks := make(map[int64]struct{})
+		for _, key := range keys {
+			if err := kv.iterateLogIndex(key, from, to, func(height int64) {
+				if candidates == nil {
+					blocks[height] = struct{}{}
+				} else if _, ok := candidates[height]; ok {
+					blocks[height] = struct{}{}
+				}
+			}); err != nil {
+				return err
+			}
+		}
+		candidates = blocks
+		return nil
+	}
+
+	if len(addresses) > 0 {
+		keys := make([][]byte, len(addresses))
+		for i, address := range addresses {
+			keys[i] = LogAddressPrefix(address)
+		}
+		if err := intersect(keys); err != nil {
+			return nil, errorsmod.Wrap(err, "FilterLogBlocks")
+		}
+	}
+	for position, topicList := range topics {
+		// an empty position matches any topic
+		if len(topicList) == 0 {
+			continue
+		}
+		keys := make([][]byte, len(topicList))
+		for i, topic := range topicList {
+			keys[i] = LogTopicPrefix(position, topic)
+		}
+		if err := intersect(keys); err != nil {
+			return nil, errorsmod.Wrap(err, "FilterLogBlocks")
+		}
+	}
+
+	heights := make([]int64, 0, len(candidates))
+	if candidates == nil {
+		// no address nor topic criteria, all the blocks with logs match
+		if err := kv.iterateLogIndex([]byte{KeyPrefixBlockLogs}, from, to, func(height int64) {
+			heights = append(heights, height)
+		}); err != nil {
+			return nil, errorsmod.Wrap(err, "FilterLogBlocks")
+		}
+		return heights, nil
+	}
+
+	for height := range candidates {
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights, nil
+}
+
+// iterateLogIndex calls cb with the block numbers within [from, to] of the
+// entries with the given key prefix. The block number is the key suffix.
+func (kv *KVIndexer) iterateLogIndex(prefix []byte, from, to int64, cb func(int64)) error {
+	start := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(from))...) //nolint:gosec // G115
+	end := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(to+1))...)   //nolint:gosec // G115
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		cb(int64(sdk.BigEndianToUint64(key[len(key)-8:]))) // #nosec G115
+	}
+	return it.Error()
+}
+
 // TxHashKey returns the key for db entry: `tx hash -> tx result struct`
 func TxHashKey(hash common.Hash) []byte {
 	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
@@ -171,6 +398,26 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// BlockBloomKey returns the key for db entry: `block number -> block bloom`
+func BlockBloomKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixBlockBloom}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115
+}
+
+// BlockLogsKey returns the key for db entry: `block number -> block logs`
+func BlockLogsKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixBlockLogs}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115
+}
+
+// LogAddressPrefix returns the key prefix of the db entries: `(address, block number) -> nil`
+func LogAddressPrefix(address common.Address) []byte {
+	return append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+}
+
+// LogTopicPrefix returns the key prefix of the db entries: `(topic position, topic, block number) -> nil`
+func LogTopicPrefix(position int, topic common.Hash) []byte {
+	return append([]byte{KeyPrefixLogTopic, byte(position)}, topic.Bytes()...)
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -222,6 +469,90 @@ func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResu
 	return nil
 }
 
+// logTopic is a log topic with its position in the topics of the log
+type logTopic struct {
+	position int
+	hash     common.Hash
+}
+
+// saveBlockLogs index the bloom, the logs and the log addresses and topics of a
+// block into the kv db batch. The bloom is saved for every block, so that it
+// tells which blocks are covered by the log index.
+func saveBlockLogs(batch dbm.Batch, height int64, blockLogs [][]*ethtypes.Log) error {
+	var (
+		bloom     ethtypes.Bloom
+		addresses = make(map[common.Address]struct{})
+		topics    = make(map[logTopic]struct{})
+	)
+	for _, txLogs := range blockLogs {
+		for _, log := range txLogs {
+			bloom.Add(log.Address.Bytes())
+			addresses[log.Address] = struct{}{}
+			for position, topic := range log.Topics {
+				bloom.Add(topic.Bytes())
+				topics[logTopic{position, topic}] = struct{}{}
+			}
+		}
+	}
+
+	if len(addresses) == 0 {
+		// the empty bloom is not stored, to save space on the blocks without logs
+		if err := batch.Set(BlockBloomKey(height), []byte{}); err != nil {
+			return errorsmod.Wrap(err, "set block-bloom key")
+		}
+		return nil
+	}
+	if err := batch.Set(BlockBloomKey(height), bloom.Bytes()); err != nil {
+		return errorsmod.Wrap(err, "set block-bloom key")
+	}
+
+	bz, err := json.Marshal(blockLogs)
+	if err != nil {
+		return errorsmod.Wrap(err, "marshal block logs")
+	}
+	if err := batch.Set(BlockLogsKey(height), bz); err != nil {
+		return errorsmod.Wrap(err, "set block-logs key")
+	}
+	heightBz := sdk.Uint64ToBigEndian(uint64(height)) //nolint:gosec // G115
+	for address := range addresses {
+		if err := batch.Set(append(LogAddressPrefix(address), heightBz...), []byte{}); err != nil {
+			return errorsmod.Wrap(err, "set log-address key")
+		}
+	}
+	for topic := range topics {
+		if err := batch.Set(append(LogTopicPrefix(topic.position, topic.hash), heightBz...), []byte{}); err != nil {
+			return errorsmod.Wrap(err, "set log-topic key")
+		}
+	}
+	return nil
+}
+
+// loadBlockKeyBound returns the first or the last block number of the block bloom
+// entries, returns -1 if there are none
+func loadBlockKeyBound(db dbm.DB, last bool) (int64, error) {
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if last {
+		it, err = db.ReverseIterator([]byte{KeyPrefixBlockBloom}, []byte{KeyPrefixBlockBloom + 1})
+	} else {
+		it, err = db.Iterator([]byte{KeyPrefixBlockBloom}, []byte{KeyPrefixBlockBloom + 1})
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	key := it.Key()
+	if len(key) != BlockKeyLength {
+		return 0, fmt.Errorf("wrong block key length, expect: %d, got: %d", BlockKeyLength, len(key))
+	}
+	return int64(sdk.BigEndianToUint64(key[1:])), nil // #nosec G115
+}
+
 func parseBlockNumberFromKey(key []byte) (int64, error) {
 	if len(key) != TxIndexKeyLength {
 		return 0, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
@@ -1,6 +1,7 @@
 package indexer_test
 
 import (
+	"encoding/json"
 	"math/big"
 	"testing"
 
@@ -186,3 +187,152 @@ func TestKVIndexer(t *testing.T) {
 		})
 	}
 }
+
+func TestKVIndexerLogs(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(&types.EvmTxArgs{
+		Nonce:    0,
+		To:       &to,
+		Amount:   big.NewInt(1000),
+		GasLimit: 21000,
+	})
+	tx.From = from.Hex()
+	require.NoError(t, tx.Sign(ethSigner, signer))
+	txHash := tx.AsTransaction().Hash()
+
+	nw := network.New()
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), evmostypes.BaseDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	var (
+		token    = common.HexToAddress("0x10")
+		other    = common.HexToAddress("0x20")
+		transfer = common.HexToHash("0x01")
+		approval = common.HexToHash("0x02")
+		holder   = common.HexToHash("0x03")
+	)
+
+	// execTxResult returns the result of the tx emitting a log per address, with the given topics
+	execTxResult := func(height int64, addresses []common.Address, topics []common.Hash) []*abci.ExecTxResult {
+		logAttrs := make([]abci.EventAttribute, len(addresses))
+		for i, address := range addresses {
+			log := types.NewLogFromEth(&ethtypes.Log{
+				Address:     address,
+				Topics:      topics,
+				BlockNumber: uint64(height),
+				TxHash:      txHash,
+				Index:       uint(i),
+			})
+			bz, err := json.Marshal(log)
+			require.NoError(t, err)
+			logAttrs[i] = abci.EventAttribute{Key: types.AttributeKeyTxLog, Value: string(bz)}
+		}
+
+		return []*abci.ExecTxResult{
+			{
+				Code: 0,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "amount", Value: "1000"},
+						{Key: "txGasUsed", Value: "21000"},
+						{Key: "txHash", Value: ""},
+						{Key: "recipient", Value: to.Hex()},
+					}},
+					{Type: types.EventTypeTxLog, Attributes: logAttrs},
+				},
+			},
+		}
+	}
+	block := func(height int64) *cmttypes.Block {
+		return &cmttypes.Block{Header: cmttypes.Header{Height: height}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+	}
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+
+	first, last, err := idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+	require.Equal(t, int64(-1), last)
+
+	require.NoError(t, idxer.IndexBlock(block(10), execTxResult(10, []common.Address{token}, []common.Hash{transfer, holder})))
+	require.NoError(t, idxer.IndexBlock(block(11), execTxResult(11, nil, nil)))
+	require.NoError(t, idxer.IndexBlock(block(12), execTxResult(12, []common.Address{token, other}, []common.Hash{approval})))
+	// not adjacent to the log index, only the tx is indexed
+	require.NoError(t, idxer.IndexBlock(block(20), execTxResult(20, []common.Address{token}, []common.Hash{transfer})))
+	// backfill the log index
+	require.NoError(t, idxer.IndexBlockLogs(block(9), execTxResult(9, []common.Address{other}, []common.Hash{transfer})))
+	require.Error(t, idxer.IndexBlockLogs(block(7), execTxResult(7, []common.Address{other}, nil)))
+
+	first, last, err = idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(9), first)
+	require.Equal(t, int64(12), last)
+
+	logs, err := idxer.GetLogsByHeight(12)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	require.Len(t, logs[0], 2)
+	require.Equal(t, token, logs[0][0].Address)
+	require.Equal(t, other, logs[0][1].Address)
+	require.Equal(t, []common.Hash{approval}, logs[0][1].Topics)
+	require.Equal(t, txHash, logs[0][1].TxHash)
+
+	logs, err = idxer.GetLogsByHeight(11)
+	require.NoError(t, err)
+	require.Empty(t, logs)
+
+	_, err = idxer.GetLogsByHeight(20)
+	require.Error(t, err)
+
+	bloom, err := idxer.GetBlockBloom(10)
+	require.NoError(t, err)
+	require.True(t, ethtypes.BloomLookup(bloom, token))
+	require.True(t, ethtypes.BloomLookup(bloom, holder))
+	require.False(t, ethtypes.BloomLookup(bloom, other))
+
+	bloom, err = idxer.GetBlockBloom(11)
+	require.NoError(t, err)
+	require.Equal(t, ethtypes.Bloom{}, bloom)
+
+	testCases := []struct {
+		name      string
+		from, to  int64
+		addresses []common.Address
+		topics    [][]common.Hash
+		expBlocks []int64
+	}{
+		{"no criteria", 0, 100, nil, nil, []int64{9, 10, 12}},
+		{"no criteria within range", 10, 11, nil, nil, []int64{10}},
+		{"address", 0, 100, []common.Address{token}, nil, []int64{10, 12}},
+		{"any of the addresses", 0, 100, []common.Address{token, other}, nil, []int64{9, 10, 12}},
+		{"address and topic", 0, 100, []common.Address{other}, [][]common.Hash{{transfer}}, []int64{9}},
+		{"wildcard topic position", 0, 100, nil, [][]common.Hash{{}, {holder}}, []int64{10}},
+		{"any of the topics", 0, 100, nil, [][]common.Hash{{transfer, approval}}, []int64{9, 10, 12}},
+		{"topic at another position", 0, 100, nil, [][]common.Hash{{holder}}, []int64{}},
+		{"topic at another position within the block", 0, 100, nil, [][]common.Hash{{}, {transfer}}, []int64{}},
+		{"no match", 0, 100, []common.Address{token}, [][]common.Hash{{transfer}, {approval}}, []int64{}},
+		{"empty range", 12, 10, nil, nil, []int64{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			blocks, err := idxer.FilterLogBlocks(tc.from, tc.to, tc.addresses, tc.topics)
+			require.NoError(t, err)
+			require.Equal(t, tc.expBlocks, blocks)
+		})
+	}
+}
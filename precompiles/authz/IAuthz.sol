@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IAuthz contract's address.
+address constant AUTHZ_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000807;
+
+/// @dev The IAuthz contract's instance.
+IAuthz constant AUTHZ_CONTRACT = IAuthz(AUTHZ_PRECOMPILE_ADDRESS);
+
+/// @dev GrantData represents an authorization granted by a granter to a grantee.
+struct GrantData {
+    /// @dev The address of the granter
+    address granter;
+    /// @dev The address of the grantee
+    address grantee;
+    /// @dev The type URL of the authorization
+    string authorization;
+    /// @dev The type URL of the message the authorization is granted for
+    string msgTypeUrl;
+    /// @dev The expiration of the grant as a unix timestamp in seconds, zero if it doesn't expire
+    int64 expiration;
+}
+
+/// @author The Evmos Core Team
+/// @title Authz Precompile Contract
+/// @dev The interface through which solidity contracts will interact with the authz module
+interface IAuthz {
+    /// @dev Grant defines an Event emitted when a generic authorization is granted.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrl the type URL of the authorized message
+    event Grant(address indexed granter, address indexed grantee, string msgTypeUrl);
+
+    /// @dev Revoke defines an Event emitted when an authorization is revoked.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrl the type URL of the revoked message
+    event Revoke(address indexed granter, address indexed grantee, string msgTypeUrl);
+
+    /// @dev Exec defines an Event emitted when messages are executed on behalf of their granters.
+    /// @param grantee the address of the grantee
+    /// @param msgTypeUrls the type URLs of the executed messages
+    event Exec(address indexed grantee, string[] msgTypeUrls);
+
+    /// TRANSACTIONS
+
+    /// @dev grant defines a method to grant a generic authorization to the grantee
+    /// for the given message type. The messages types that are disabled within
+    /// authz cannot be granted.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the authorized message
+    /// @param expiration The expiration of the grant as a unix timestamp in
+    /// seconds, zero for a grant that doesn't expire
+    /// @return success Whether the transaction was successful or not
+    function grant(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev revoke defines a method to revoke the authorization granted to the
+    /// grantee for the given message type.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the authorized message
+    /// @return success Whether the transaction was successful or not
+    function revoke(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl
+    ) external returns (bool success);
+
+    /// @dev exec defines a method to execute messages on behalf of their
+    /// granters, using the authorizations granted to the grantee. The messages
+    /// are encoded with their JSON representation including the type URL, and
+    /// their signer cannot be the grantee.
+    /// @param grantee The address of the grantee
+    /// @param msgs The JSON-encoded messages to execute
+    /// @return results The results of the executed messages
+    function exec(
+        address grantee,
+        bytes[] calldata msgs
+    ) external returns (bytes[] memory results);
+
+    /// QUERIES
+
+    /// @dev getGrants returns the grants of the granter to the grantee.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the authorized message, empty to
+    /// return the grants for all the messages
+    /// @param pagination The pagination options
+    /// @return grants The grants of the granter to the grantee
+    /// @return pageResponse The pagination information
+    function getGrants(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (GrantData[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev getGranterGrants returns the grants of the granter.
+    /// @param granter The address of the granter
+    /// @param pagination The pagination options
+    /// @return grants The grants of the granter
+    /// @return pageResponse The pagination information
+    function getGranterGrants(
+        address granter,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (GrantData[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev getGranteeGrants returns the grants to the grantee.
+    /// @param grantee The address of the grantee
+    /// @param pagination The pagination options
+    /// @return grants The grants to the grantee
+    /// @return pageResponse The pagination information
+    function getGranteeGrants(
+        address grantee,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (GrantData[] memory grants, PageResponse memory pageResponse);
+}
@@ -0,0 +1,125 @@
+package authz_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v20/app"
+	"github.com/evmos/evmos/v20/precompiles/authz"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (s *PrecompileTestSuite) TestIsTransaction() {
+	testCases := []struct {
+		name   string
+		method abi.Method
+		isTx   bool
+	}{
+		{
+			authz.GrantMethod,
+			s.precompile.Methods[authz.GrantMethod],
+			true,
+		},
+		{
+			authz.RevokeMethod,
+			s.precompile.Methods[authz.RevokeMethod],
+			true,
+		},
+		{
+			authz.ExecMethod,
+			s.precompile.Methods[authz.ExecMethod],
+			true,
+		},
+		{
+			authz.GetGrantsMethod,
+			s.precompile.Methods[authz.GetGrantsMethod],
+			false,
+		},
+		{
+			authz.GetGranterGrantsMethod,
+			s.precompile.Methods[authz.GetGranterGrantsMethod],
+			false,
+		},
+		{
+			authz.GetGranteeGrantsMethod,
+			s.precompile.Methods[authz.GetGranteeGrantsMethod],
+			false,
+		},
+		{
+			"invalid",
+			abi.Method{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Require().Equal(tc.isTx, s.precompile.IsTransaction(&tc.method))
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRunExecMirrorsBalances() {
+	s.SetupTest()
+	s.grant(0, 1, sendMsgTypeURL)
+
+	ctx := s.network.GetContext()
+	granter, grantee, recipient := s.keyring.GetKey(0), s.keyring.GetKey(1), s.keyring.GetKey(2)
+	amount := sdk.NewCoins(sdk.NewCoin(s.network.GetDenom(), math.NewInt(1e18)))
+
+	msg := banktypes.NewMsgSend(granter.AccAddr, recipient.AccAddr, amount)
+	input, err := s.precompile.Pack(authz.ExecMethod, grantee.Addr, s.encodeMsgs(msg))
+	s.Require().NoError(err)
+
+	contract := vm.NewPrecompile(vm.AccountRef(grantee.Addr), s.precompile, big.NewInt(0), 1_000_000)
+	contract.Input = input
+	contractAddr := contract.Address()
+
+	// Build the Ethereum message sent by the grantee
+	txArgs := evmtypes.EvmTxArgs{
+		ChainID:   evmtypes.GetEthChainConfig().ChainID,
+		Nonce:     0,
+		To:        &contractAddr,
+		GasLimit:  1_000_000,
+		GasPrice:  app.MainnetMinGasPrices.BigInt(),
+		GasFeeCap: s.network.App.EvmKeeper.GetBaseFee(ctx),
+		GasTipCap: big.NewInt(1),
+		Accesses:  &ethtypes.AccessList{},
+	}
+	coreMsg, err := s.factory.GenerateGethCoreMsg(grantee.Priv, txArgs)
+	s.Require().NoError(err)
+
+	cfg, err := s.network.App.EvmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+	s.Require().NoError(err)
+
+	stDB := statedb.New(ctx, s.network.App.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	evm := s.network.App.EvmKeeper.NewEVM(ctx, coreMsg, cfg, nil, stDB)
+
+	// Make the recipient account dirty on the stateDB, so that its balance is
+	// written to the bank keeper when committing the EVM state
+	stDB.SetNonce(recipient.Addr, stDB.GetNonce(recipient.Addr)+1)
+
+	_, err = s.precompile.Run(evm, contract, false)
+	s.Require().NoError(err)
+
+	expAmount := evmtypes.ConvertAmountTo18DecimalsBigInt(amount[0].Amount.BigInt())
+	initialBalance := evmtypes.ConvertAmountTo18DecimalsBigInt(network.PrefundedAccountInitialBalance.BigInt())
+	s.Require().Equal(new(big.Int).Add(initialBalance, expAmount), stDB.GetBalance(recipient.Addr))
+	s.Require().Equal(new(big.Int).Sub(initialBalance, expAmount), stDB.GetBalance(granter.Addr))
+
+	// Check the executed transfer is not overwritten when committing the EVM state
+	s.Require().NoError(stDB.Commit())
+	balance := s.network.App.BankKeeper.GetBalance(ctx, recipient.AccAddr, s.network.GetDenom())
+	s.Require().Equal(network.PrefundedAccountInitialBalance.Add(amount[0].Amount), balance.Amount)
+	balance = s.network.App.BankKeeper.GetBalance(ctx, granter.AccAddr, s.network.GetDenom())
+	s.Require().Equal(network.PrefundedAccountInitialBalance.Sub(amount[0].Amount), balance.Amount)
+}
@@ -0,0 +1,23 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+const (
+	// ErrDifferentCallerFromGranter is raised when the caller address is not the same as the granter address.
+	ErrDifferentCallerFromGranter = "caller address %s is not the same as granter address %s"
+	// ErrDifferentCallerFromGrantee is raised when the caller address is not the same as the grantee address.
+	ErrDifferentCallerFromGrantee = "caller address %s is not the same as grantee address %s"
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidMsgTypeURL is raised when the message type URL is not valid.
+	ErrInvalidMsgTypeURL = "invalid message type URL: %v"
+	// ErrInvalidExpiration is raised when the expiration is not valid.
+	ErrInvalidExpiration = "invalid expiration: %v"
+	// ErrInvalidMsgs is raised when the messages to execute are not valid.
+	ErrInvalidMsgs = "invalid messages: %v"
+	// ErrExecWithoutGranter is raised when a message to execute is signed by the grantee itself.
+	ErrExecWithoutGranter = "message %s is signed by the grantee %s: only messages of granters can be executed"
+)
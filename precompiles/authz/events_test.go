@@ -0,0 +1,90 @@
+package authz_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v20/precompiles/authz"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+func (s *PrecompileTestSuite) TestGrantEvent() {
+	s.SetupTest()
+
+	stDB := s.network.GetStateDB()
+	ctx := s.network.GetContext()
+	method := s.precompile.Methods[authz.GrantMethod]
+	granter, grantee := s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+
+	contract := vm.NewContract(vm.AccountRef(granter), s.precompile, big.NewInt(0), 200_000)
+	_, err := s.precompile.Grant(ctx, granter, contract, stDB, &method, []interface{}{
+		granter, grantee, sendMsgTypeURL, int64(0),
+	})
+	s.Require().NoError(err)
+
+	s.Require().Len(stDB.Logs(), 1)
+	log := stDB.Logs()[0]
+	s.Require().Equal(log.Address, s.precompile.Address())
+
+	// Check event signature matches the one emitted
+	event := s.precompile.ABI.Events[authz.EventTypeGrant]
+	s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+	s.Require().Equal(log.BlockNumber, uint64(ctx.BlockHeight())) //nolint:gosec // G115
+
+	// Check the granter and grantee are the indexed topics
+	granterTopic, err := cmn.MakeTopic(granter)
+	s.Require().NoError(err)
+	s.Require().Equal(granterTopic, log.Topics[1])
+	granteeTopic, err := cmn.MakeTopic(grantee)
+	s.Require().NoError(err)
+	s.Require().Equal(granteeTopic, log.Topics[2])
+
+	// Check the fully unpacked event matches the one emitted
+	var grantEvent authz.EventGrant
+	err = cmn.UnpackLog(s.precompile.ABI, &grantEvent, authz.EventTypeGrant, *log)
+	s.Require().NoError(err)
+	s.Require().Equal(granter, grantEvent.Granter)
+	s.Require().Equal(grantee, grantEvent.Grantee)
+	s.Require().Equal(sendMsgTypeURL, grantEvent.MsgTypeURL)
+}
+
+func (s *PrecompileTestSuite) TestExecEvent() {
+	s.SetupTest()
+	s.grant(0, 1, sendMsgTypeURL)
+
+	stDB := s.network.GetStateDB()
+	ctx := s.network.GetContext()
+	method := s.precompile.Methods[authz.ExecMethod]
+	grantee := s.keyring.GetAddr(1)
+
+	msg := banktypes.NewMsgSend(
+		s.keyring.GetAccAddr(0),
+		s.keyring.GetAccAddr(2),
+		sdk.NewCoins(sdk.NewCoin(s.network.GetDenom(), math.NewInt(1e18))),
+	)
+
+	contract := vm.NewContract(vm.AccountRef(grantee), s.precompile, big.NewInt(0), 200_000)
+	_, err := s.precompile.Exec(ctx, grantee, contract, stDB, &method, []interface{}{grantee, s.encodeMsgs(msg)})
+	s.Require().NoError(err)
+
+	s.Require().Len(stDB.Logs(), 1)
+	log := stDB.Logs()[0]
+	s.Require().Equal(log.Address, s.precompile.Address())
+
+	// Check event signature matches the one emitted
+	event := s.precompile.ABI.Events[authz.EventTypeExec]
+	s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+
+	// Check the fully unpacked event matches the one emitted
+	var execEvent authz.EventExec
+	err = cmn.UnpackLog(s.precompile.ABI, &execEvent, authz.EventTypeExec, *log)
+	s.Require().NoError(err)
+	s.Require().Equal(grantee, execEvent.Grantee)
+	s.Require().Equal([]string{sendMsgTypeURL}, execEvent.MsgTypeURLs)
+}
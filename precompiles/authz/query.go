@@ -0,0 +1,96 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// GetGrantsMethod defines the method name for the grants precompile request.
+	GetGrantsMethod = "getGrants"
+	// GetGranterGrantsMethod defines the method name for the granter grants precompile request.
+	GetGranterGrantsMethod = "getGranterGrants"
+	// GetGranteeGrantsMethod defines the method name for the grantee grants precompile request.
+	GetGranteeGrantsMethod = "getGranteeGrants"
+)
+
+// GetGrants implements the query logic for getting the grants of a granter to a
+// grantee, optionally filtered by message type URL.
+func (p Precompile) GetGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGrantsArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.AuthzKeeper.Grants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(GrantsOutput).FromGrants(p.cdc, req.Granter, req.Grantee, res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.Grants, output.PageResponse)
+}
+
+// GetGranterGrants implements the query logic for getting all the grants of a granter.
+func (p Precompile) GetGranterGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGranterGrantsArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.AuthzKeeper.GranterGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(GrantsOutput).FromGrantAuthorizations(p.cdc, res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.Grants, output.PageResponse)
+}
+
+// GetGranteeGrants implements the query logic for getting all the grants of a grantee.
+func (p Precompile) GetGranteeGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGranteeGrantsArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.AuthzKeeper.GranteeGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(GrantsOutput).FromGrantAuthorizations(p.cdc, res.Grants, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.Grants, output.PageResponse)
+}
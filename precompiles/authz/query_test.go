@@ -0,0 +1,257 @@
+package authz_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/precompiles/authz"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+func (s *PrecompileTestSuite) TestGetGrants() {
+	method := s.precompile.Methods[authz.GetGrantsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expGrants   []string
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			nil,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), common.Address{}, "", query.PageRequest{}}
+			},
+			nil,
+			true,
+			"invalid grantee address",
+		},
+		{
+			"success - get all the grants of the granter to the grantee",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), "", query.PageRequest{}}
+			},
+			[]string{grantMsgTypeURL, sendMsgTypeURL},
+			false,
+			"",
+		},
+		{
+			"success - get the grant of a message type",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL, query.PageRequest{}}
+			},
+			[]string{sendMsgTypeURL},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.grant(0, 1, sendMsgTypeURL)
+			s.grant(0, 1, grantMsgTypeURL)
+			s.grant(0, 2, sendMsgTypeURL)
+
+			ctx := s.network.GetContext()
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), 200_000)
+
+			bz, err := s.precompile.GetGrants(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				var out authz.GrantsOutput
+				s.Require().NoError(s.precompile.UnpackIntoInterface(&out, authz.GetGrantsMethod, bz))
+				s.requireGrants(out.Grants, tc.expGrants)
+				for _, grant := range out.Grants {
+					s.Require().Equal(s.keyring.GetAddr(0), grant.Granter)
+					s.Require().Equal(s.keyring.GetAddr(1), grant.Grantee)
+				}
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGetGranterGrants() {
+	method := s.precompile.Methods[authz.GetGranterGrantsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expLen      int
+		expNextKey  bool
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			0, false,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{}}
+			},
+			0, false,
+			true,
+			"invalid granter address",
+		},
+		{
+			"success - get all the grants of the granter",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{}}
+			},
+			2, false,
+			false,
+			"",
+		},
+		{
+			"success - get the grants of the granter with pagination",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{Limit: 1}}
+			},
+			1, true,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.grant(0, 1, sendMsgTypeURL)
+			s.grant(0, 2, sendMsgTypeURL)
+			s.grant(1, 2, sendMsgTypeURL)
+
+			ctx := s.network.GetContext()
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), 200_000)
+
+			bz, err := s.precompile.GetGranterGrants(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				var out authz.GrantsOutput
+				s.Require().NoError(s.precompile.UnpackIntoInterface(&out, authz.GetGranterGrantsMethod, bz))
+				s.Require().Len(out.Grants, tc.expLen)
+				s.Require().Equal(tc.expNextKey, len(out.PageResponse.NextKey) > 0)
+				for _, grant := range out.Grants {
+					s.Require().Equal(s.keyring.GetAddr(0), grant.Granter)
+					s.Require().Equal(sendMsgTypeURL, grant.MsgTypeURL)
+				}
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGetGranteeGrants() {
+	method := s.precompile.Methods[authz.GetGranteeGrantsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expLen      int
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			0,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{}}
+			},
+			0,
+			true,
+			"invalid grantee address",
+		},
+		{
+			"success - get all the grants to the grantee",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(2), query.PageRequest{}}
+			},
+			2,
+			false,
+			"",
+		},
+		{
+			"success - no grants to the grantee",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{}}
+			},
+			0,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.grant(0, 2, sendMsgTypeURL)
+			s.grant(1, 2, sendMsgTypeURL)
+
+			ctx := s.network.GetContext()
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), 200_000)
+
+			args := tc.malleate()
+			bz, err := s.precompile.GetGranteeGrants(ctx, &method, contract, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				var out authz.GrantsOutput
+				s.Require().NoError(s.precompile.UnpackIntoInterface(&out, authz.GetGranteeGrantsMethod, bz))
+				s.Require().Len(out.Grants, tc.expLen)
+				for _, grant := range out.Grants {
+					s.Require().Equal(args[0].(common.Address), grant.Grantee)
+					s.Require().Equal("/cosmos.authz.v1beta1.GenericAuthorization", grant.Authorization)
+					s.Require().Zero(grant.Expiration)
+				}
+			}
+		})
+	}
+}
+
+// requireGrants checks that the message types of the given grants match the
+// expected ones, regardless of their order.
+func (s *PrecompileTestSuite) requireGrants(grants []authz.GrantData, expMsgTypeURLs []string) {
+	msgTypeURLs := make([]string, len(grants))
+	for i, grant := range grants {
+		msgTypeURLs[i] = grant.MsgTypeURL
+	}
+	s.Require().ElementsMatch(expMsgTypeURLs, msgTypeURLs)
+}
@@ -0,0 +1,170 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// GrantMethod defines the ABI method name for the authz Grant transaction.
+	GrantMethod = "grant"
+	// RevokeMethod defines the ABI method name for the authz Revoke transaction.
+	RevokeMethod = "revoke"
+	// ExecMethod defines the ABI method name for the authz Exec transaction.
+	ExecMethod = "exec"
+)
+
+// Grant defines a method to grant a generic authorization to the grantee. The
+// message types disabled within authz cannot be granted.
+func (p Precompile) Grant(
+	ctx sdk.Context,
+	_ common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, granteeHexAddr, err := NewMsgGrant(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the direct caller can act as the granter, the origin is not
+	// accepted when the precompile is called by another contract
+	if contract.CallerAddress != granterHexAddr {
+		return nil, fmt.Errorf(ErrDifferentCallerFromGranter, contract.CallerAddress.String(), granterHexAddr.String())
+	}
+
+	if err := p.authzLimiter.CheckDisabledMsgs([]sdk.Msg{msg}); err != nil {
+		return nil, err
+	}
+
+	if _, err = p.AuthzKeeper.Grant(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	authorization, err := msg.GetAuthorization()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitGrantEvent(ctx, stateDB, granterHexAddr, granteeHexAddr, authorization.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke defines a method to revoke the authorization granted to the grantee
+// for the given message type.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	_ common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, granteeHexAddr, err := NewMsgRevoke(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the direct caller can act as the granter, the origin is not
+	// accepted when the precompile is called by another contract
+	if contract.CallerAddress != granterHexAddr {
+		return nil, fmt.Errorf(ErrDifferentCallerFromGranter, contract.CallerAddress.String(), granterHexAddr.String())
+	}
+
+	if _, err = p.AuthzKeeper.Revoke(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeEvent(ctx, stateDB, granterHexAddr, granteeHexAddr, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Exec defines a method to execute messages on behalf of their granters, using
+// the authorizations granted to the grantee. The messages signed by the grantee
+// itself are rejected, so that the precompile cannot be used to dispatch
+// arbitrary messages, as well as the message types disabled within authz.
+func (p *Precompile) Exec(
+	ctx sdk.Context,
+	_ common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granteeHexAddr, err := NewMsgExec(p.cdc, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the direct caller can act as the grantee, the origin is not
+	// accepted when the precompile is called by another contract
+	if contract.CallerAddress != granteeHexAddr {
+		return nil, fmt.Errorf(ErrDifferentCallerFromGrantee, contract.CallerAddress.String(), granteeHexAddr.String())
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	msgTypeURLs := make([]string, len(msgs))
+	for i, m := range msgs {
+		msgTypeURLs[i] = sdk.MsgTypeURL(m)
+
+		signers, _, err := p.cdc.GetMsgV1Signers(m)
+		if err != nil {
+			return nil, err
+		}
+		for _, signer := range signers {
+			if bytes.Equal(signer, granteeHexAddr.Bytes()) {
+				return nil, fmt.Errorf(ErrExecWithoutGranter, msgTypeURLs[i], granteeHexAddr.String())
+			}
+		}
+	}
+
+	if err := p.authzLimiter.CheckDisabledMsgs([]sdk.Msg{msg}); err != nil {
+		return nil, err
+	}
+
+	// NOTE: the balance changes of the executed messages are not known in
+	// advance, so they are taken from the bank events emitted by the execution
+	// to mirror them to the EVM stateDB. This prevents the stateDB from
+	// overwriting the changed balances in the bank keeper when committing the
+	// EVM state.
+	eventsBefore := len(ctx.EventManager().Events())
+
+	res, err := p.AuthzKeeper.Exec(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := cmn.NewBalanceChangeEntriesFromEvents(ctx.EventManager().Events()[eventsBefore:])
+	if err != nil {
+		return nil, err
+	}
+	p.SetBalanceChangeEntries(entries...)
+
+	if err = p.EmitExecEvent(ctx, stateDB, granteeHexAddr, msgTypeURLs); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Results)
+}
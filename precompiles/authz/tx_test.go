@@ -0,0 +1,414 @@
+package authz_test
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/precompiles/authz"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+var (
+	sendMsgTypeURL  = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	grantMsgTypeURL = sdk.MsgTypeURL(&sdkauthz.MsgGrant{})
+)
+
+func (s *PrecompileTestSuite) TestGrant() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.GrantMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() (origin, caller common.Address, args []interface{})
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{
+					common.Address{}, s.keyring.GetAddr(1), sendMsgTypeURL, int64(0),
+				}
+			},
+			func() {},
+			true,
+			"invalid granter address",
+		},
+		{
+			"fail - empty message type URL",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), "", int64(0),
+				}
+			},
+			func() {},
+			true,
+			"invalid message type URL",
+		},
+		{
+			"fail - caller is not the granter",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(1), s.keyring.GetAddr(1), []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL, int64(0),
+				}
+			},
+			func() {},
+			true,
+			"is not the same as granter address",
+		},
+		{
+			"fail - granter is the origin of a call made by a contract",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(2), []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(2), sendMsgTypeURL, int64(0),
+				}
+			},
+			func() {},
+			true,
+			"is not the same as granter address",
+		},
+		{
+			"fail - message type disabled within authz",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}), int64(0),
+				}
+			},
+			func() {},
+			true,
+			"found disabled msg type",
+		},
+		{
+			"success - the origin grants an authorization without expiration",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL, int64(0),
+				}
+			},
+			func() {
+				authorization, expiration := s.network.App.AuthzKeeper.GetAuthorization(
+					ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sendMsgTypeURL,
+				)
+				s.Require().NotNil(authorization)
+				s.Require().Nil(expiration)
+			},
+			false,
+			"",
+		},
+		{
+			"success - the calling contract grants an authorization with expiration",
+			func() (common.Address, common.Address, []interface{}) {
+				expiration := ctx.BlockTime().Add(time.Hour).Unix()
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(1), []interface{}{
+					s.keyring.GetAddr(1), s.keyring.GetAddr(2), sendMsgTypeURL, expiration,
+				}
+			},
+			func() {
+				authorization, expiration := s.network.App.AuthzKeeper.GetAuthorization(
+					ctx, s.keyring.GetAccAddr(2), s.keyring.GetAccAddr(1), sendMsgTypeURL,
+				)
+				s.Require().NotNil(authorization)
+				s.Require().NotNil(expiration)
+				s.Require().Equal(ctx.BlockTime().Add(time.Hour).Unix(), expiration.Unix())
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			stDB := s.network.GetStateDB()
+
+			origin, caller, args := tc.malleate()
+			contract := vm.NewContract(vm.AccountRef(caller), s.precompile, big.NewInt(0), 200_000)
+
+			bz, err := s.precompile.Grant(ctx, origin, contract, stDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				success, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				s.Require().Equal(true, success[0])
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.RevokeMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() (origin, caller common.Address, args []interface{})
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - caller is not the granter",
+			func() (common.Address, common.Address, []interface{}) {
+				s.grant(0, 1, sendMsgTypeURL)
+				return s.keyring.GetAddr(1), s.keyring.GetAddr(1), []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL,
+				}
+			},
+			func() {},
+			true,
+			"is not the same as granter address",
+		},
+		{
+			"fail - granter is the origin of a call made by a contract",
+			func() (common.Address, common.Address, []interface{}) {
+				s.grant(0, 1, sendMsgTypeURL)
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(2), []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL,
+				}
+			},
+			func() {},
+			true,
+			"is not the same as granter address",
+		},
+		{
+			"fail - authorization not found",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL,
+				}
+			},
+			func() {},
+			true,
+			"authorization not found",
+		},
+		{
+			"success - the origin revokes an authorization",
+			func() (common.Address, common.Address, []interface{}) {
+				s.grant(0, 1, sendMsgTypeURL)
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), sendMsgTypeURL,
+				}
+			},
+			func() {
+				authorization, _ := s.network.App.AuthzKeeper.GetAuthorization(
+					ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sendMsgTypeURL,
+				)
+				s.Require().Nil(authorization)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			stDB := s.network.GetStateDB()
+
+			origin, caller, args := tc.malleate()
+			contract := vm.NewContract(vm.AccountRef(caller), s.precompile, big.NewInt(0), 200_000)
+
+			bz, err := s.precompile.Revoke(ctx, origin, contract, stDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				success, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				s.Require().Equal(true, success[0])
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestExec() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[authz.ExecMethod]
+	amount := sdk.NewCoins(sdk.NewCoin(s.network.GetDenom(), math.NewInt(1e18)))
+
+	testCases := []struct {
+		name        string
+		malleate    func() (origin, caller common.Address, args []interface{})
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(1), s.keyring.GetAddr(1), []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - empty messages",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(1), s.keyring.GetAddr(1), []interface{}{s.keyring.GetAddr(1), [][]byte{}}
+			},
+			func() {},
+			true,
+			"messages cannot be empty",
+		},
+		{
+			"fail - caller is not the grantee",
+			func() (common.Address, common.Address, []interface{}) {
+				msg := banktypes.NewMsgSend(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), amount)
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{s.keyring.GetAddr(1), s.encodeMsgs(msg)}
+			},
+			func() {},
+			true,
+			"is not the same as grantee address",
+		},
+		{
+			"fail - grantee is the origin of a call made by a contract",
+			func() (common.Address, common.Address, []interface{}) {
+				s.grant(0, 1, sendMsgTypeURL)
+				msg := banktypes.NewMsgSend(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(2), amount)
+				return s.keyring.GetAddr(1), s.keyring.GetAddr(2), []interface{}{s.keyring.GetAddr(1), s.encodeMsgs(msg)}
+			},
+			func() {},
+			true,
+			"is not the same as grantee address",
+		},
+		{
+			"fail - message signed by the grantee",
+			func() (common.Address, common.Address, []interface{}) {
+				msg := banktypes.NewMsgSend(s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(2), amount)
+				return s.keyring.GetAddr(1), s.keyring.GetAddr(1), []interface{}{s.keyring.GetAddr(1), s.encodeMsgs(msg)}
+			},
+			func() {},
+			true,
+			"only messages of granters can be executed",
+		},
+		{
+			"fail - authorization not found",
+			func() (common.Address, common.Address, []interface{}) {
+				msg := banktypes.NewMsgSend(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), amount)
+				return s.keyring.GetAddr(1), s.keyring.GetAddr(1), []interface{}{s.keyring.GetAddr(1), s.encodeMsgs(msg)}
+			},
+			func() {},
+			true,
+			"authorization not found",
+		},
+		{
+			"fail - grant of a message type disabled within authz",
+			func() (common.Address, common.Address, []interface{}) {
+				s.grant(0, 1, grantMsgTypeURL)
+				msg, err := sdkauthz.NewMsgGrant(
+					s.keyring.GetAccAddr(0),
+					s.keyring.GetAccAddr(1),
+					sdkauthz.NewGenericAuthorization(sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})),
+					nil,
+				)
+				s.Require().NoError(err)
+				return s.keyring.GetAddr(1), s.keyring.GetAddr(1), []interface{}{s.keyring.GetAddr(1), s.encodeMsgs(msg)}
+			},
+			func() {},
+			true,
+			"found disabled msg type",
+		},
+		{
+			"success - the origin executes a message of the granter",
+			func() (common.Address, common.Address, []interface{}) {
+				s.grant(0, 1, sendMsgTypeURL)
+				msg := banktypes.NewMsgSend(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(2), amount)
+				return s.keyring.GetAddr(1), s.keyring.GetAddr(1), []interface{}{s.keyring.GetAddr(1), s.encodeMsgs(msg)}
+			},
+			func() {
+				balance := s.network.App.BankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(2), s.network.GetDenom())
+				s.Require().Equal(network.PrefundedAccountInitialBalance.Add(amount[0].Amount), balance.Amount)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			stDB := s.network.GetStateDB()
+
+			origin, caller, args := tc.malleate()
+			contract := vm.NewContract(vm.AccountRef(caller), s.precompile, big.NewInt(0), 200_000)
+
+			bz, err := s.precompile.Exec(ctx, origin, contract, stDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				results, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				s.Require().Len(results[0], 1)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+// grant grants a generic authorization for the given message type from the
+// keyring account with the granter index to the one with the grantee index.
+func (s *PrecompileTestSuite) grant(granter, grantee int, msgTypeURL string) {
+	err := s.network.App.AuthzKeeper.SaveGrant(
+		s.network.GetContext(),
+		s.keyring.GetAccAddr(grantee),
+		s.keyring.GetAccAddr(granter),
+		sdkauthz.NewGenericAuthorization(msgTypeURL),
+		nil,
+	)
+	s.Require().NoError(err)
+}
+
+// encodeMsgs encodes the given messages as the JSON arguments of the exec method.
+func (s *PrecompileTestSuite) encodeMsgs(msgs ...sdk.Msg) [][]byte {
+	encoded := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(msg)
+		s.Require().NoError(err)
+		encoded[i] = bz
+	}
+	return encoded
+}
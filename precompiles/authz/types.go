@@ -0,0 +1,334 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package authz
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+)
+
+// EventGrant defines the event data for the Grant transaction.
+type EventGrant struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeURL string `abi:"msgTypeUrl"`
+}
+
+// EventRevoke defines the event data for the Revoke transaction.
+type EventRevoke struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeURL string `abi:"msgTypeUrl"`
+}
+
+// EventExec defines the event data for the Exec transaction.
+type EventExec struct {
+	Grantee     common.Address
+	MsgTypeURLs []string `abi:"msgTypeUrls"`
+}
+
+// GrantData defines an authorization granted by a granter to a grantee, with
+// the expiration expressed as a unix timestamp in seconds.
+type GrantData struct {
+	Granter       common.Address `abi:"granter"`
+	Grantee       common.Address `abi:"grantee"`
+	Authorization string         `abi:"authorization"`
+	MsgTypeURL    string         `abi:"msgTypeUrl"`
+	Expiration    int64          `abi:"expiration"`
+}
+
+// GrantsInput defines the input for the Grants query.
+type GrantsInput struct {
+	Granter    common.Address    `abi:"granter"`
+	Grantee    common.Address    `abi:"grantee"`
+	MsgTypeURL string            `abi:"msgTypeUrl"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// GranterGrantsInput defines the input for the GranterGrants query.
+type GranterGrantsInput struct {
+	Granter    common.Address    `abi:"granter"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// GranteeGrantsInput defines the input for the GranteeGrants query.
+type GranteeGrantsInput struct {
+	Grantee    common.Address    `abi:"grantee"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// GrantsOutput defines the output for the grants queries.
+type GrantsOutput struct {
+	Grants       []GrantData
+	PageResponse query.PageResponse
+}
+
+// NewMsgGrant creates a new MsgGrant instance granting a generic authorization
+// and returns the granter and grantee addresses.
+func NewMsgGrant(args []interface{}) (*authztypes.MsgGrant, common.Address, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msgTypeURL, ok := args[2].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidMsgTypeURL, args[2])
+	}
+
+	expiration, ok := args[3].(int64)
+	if !ok || expiration < 0 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidExpiration, args[3])
+	}
+
+	var expirationTime *time.Time
+	if expiration != 0 {
+		t := time.Unix(expiration, 0).UTC()
+		expirationTime = &t
+	}
+
+	msg, err := authztypes.NewMsgGrant(
+		granter.Bytes(),
+		grantee.Bytes(),
+		authztypes.NewGenericAuthorization(msgTypeURL),
+		expirationTime,
+	)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgRevoke creates a new MsgRevoke instance and returns the granter and
+// grantee addresses.
+func NewMsgRevoke(args []interface{}) (*authztypes.MsgRevoke, common.Address, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msgTypeURL, ok := args[2].(string)
+	if !ok || msgTypeURL == "" {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidMsgTypeURL, args[2])
+	}
+
+	msg := authztypes.NewMsgRevoke(granter.Bytes(), grantee.Bytes(), msgTypeURL)
+	return &msg, granter, grantee, nil
+}
+
+// NewMsgExec creates a new MsgExec instance from the JSON-encoded messages and
+// returns the grantee address.
+func NewMsgExec(cdc codec.Codec, args []interface{}) (*authztypes.MsgExec, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[0])
+	}
+
+	jsonMsgs, ok := args[1].([][]byte)
+	if !ok || len(jsonMsgs) == 0 {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMsgs, "messages cannot be empty")
+	}
+
+	msgs := make([]sdk.Msg, len(jsonMsgs))
+	for i, bz := range jsonMsgs {
+		if err := cdc.UnmarshalInterfaceJSON(bz, &msgs[i]); err != nil {
+			return nil, common.Address{}, fmt.Errorf(ErrInvalidMsgs, err)
+		}
+	}
+
+	msg := authztypes.NewMsgExec(grantee.Bytes(), msgs)
+	return &msg, grantee, nil
+}
+
+// ParseGrantsArgs parses the arguments for the Grants query.
+func ParseGrantsArgs(method *abi.Method, args []interface{}) (*authztypes.QueryGrantsRequest, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GrantsInput: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	return &authztypes.QueryGrantsRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		MsgTypeUrl: input.MsgTypeURL,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// ParseGranterGrantsArgs parses the arguments for the GranterGrants query.
+func ParseGranterGrantsArgs(method *abi.Method, args []interface{}) (*authztypes.QueryGranterGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranterGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranterGrantsInput: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+
+	return &authztypes.QueryGranterGrantsRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// ParseGranteeGrantsArgs parses the arguments for the GranteeGrants query.
+func ParseGranteeGrantsArgs(method *abi.Method, args []interface{}) (*authztypes.QueryGranteeGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranteeGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranteeGrantsInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	return &authztypes.QueryGranteeGrantsRequest{
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromGrants populates the GrantsOutput from the grants of the given granter
+// to the given grantee.
+func (o *GrantsOutput) FromGrants(
+	cdc codec.Codec,
+	granter, grantee string,
+	grants []*authztypes.Grant,
+	pageRes *query.PageResponse,
+) (*GrantsOutput, error) {
+	o.Grants = make([]GrantData, len(grants))
+	for i, grant := range grants {
+		grantData, err := NewGrantData(cdc, granter, grantee, grant.Authorization, grant.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		o.Grants[i] = grantData
+	}
+
+	o.setPageResponse(pageRes)
+	return o, nil
+}
+
+// FromGrantAuthorizations populates the GrantsOutput from the given grant authorizations.
+func (o *GrantsOutput) FromGrantAuthorizations(
+	cdc codec.Codec,
+	grants []*authztypes.GrantAuthorization,
+	pageRes *query.PageResponse,
+) (*GrantsOutput, error) {
+	o.Grants = make([]GrantData, len(grants))
+	for i, grant := range grants {
+		grantData, err := NewGrantData(cdc, grant.Granter, grant.Grantee, grant.Authorization, grant.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		o.Grants[i] = grantData
+	}
+
+	o.setPageResponse(pageRes)
+	return o, nil
+}
+
+// setPageResponse sets the page response of the output, if any.
+func (o *GrantsOutput) setPageResponse(pageRes *query.PageResponse) {
+	if pageRes != nil {
+		o.PageResponse = query.PageResponse{
+			NextKey: pageRes.NextKey,
+			Total:   pageRes.Total,
+		}
+	}
+}
+
+// NewGrantData converts a grant to its ABI representation.
+func NewGrantData(
+	cdc codec.Codec,
+	granter, grantee string,
+	authorizationAny *codectypes.Any,
+	expiration *time.Time,
+) (GrantData, error) {
+	granterAddr, err := sdk.AccAddressFromBech32(granter)
+	if err != nil {
+		return GrantData{}, fmt.Errorf(ErrInvalidGranter, granter)
+	}
+
+	granteeAddr, err := sdk.AccAddressFromBech32(grantee)
+	if err != nil {
+		return GrantData{}, fmt.Errorf(ErrInvalidGrantee, grantee)
+	}
+
+	var authorization authztypes.Authorization
+	if err := cdc.UnpackAny(authorizationAny, &authorization); err != nil {
+		return GrantData{}, err
+	}
+
+	grantData := GrantData{
+		Granter:       common.BytesToAddress(granterAddr),
+		Grantee:       common.BytesToAddress(granteeAddr),
+		Authorization: authorizationAny.TypeUrl,
+		MsgTypeURL:    authorization.MsgTypeURL(),
+	}
+	if expiration != nil {
+		grantData.Expiration = expiration.Unix()
+	}
+
+	return grantData, nil
+}
+
+// parseGranterGrantee parses the granter and grantee addresses, which are the
+// first two arguments of the Grant and Revoke transactions.
+func parseGranterGrantee(args []interface{}) (granter, grantee common.Address, err error) {
+	granter, ok := args[0].(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGranter, args[0])
+	}
+
+	grantee, ok = args[1].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[1])
+	}
+
+	return granter, grantee, nil
+}
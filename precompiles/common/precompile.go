@@ -4,16 +4,19 @@ package common
 
 import (
 	"errors"
+	"fmt"
 	"math/big"
 	"time"
 
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	"github.com/evmos/evmos/v20/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
 // Precompile is a common struct for all precompiles that holds the common data each
@@ -25,7 +28,7 @@ type Precompile struct {
 	KvGasConfig          storetypes.GasConfig
 	TransientKVGasConfig storetypes.GasConfig
 	address              common.Address
-	journalEntries       []balanceChangeEntry
+	journalEntries       []BalanceChangeEntry
 }
 
 // Operation is a type that defines if the precompile call
@@ -37,14 +40,65 @@ const (
 	Add
 )
 
-type balanceChangeEntry struct {
+// BalanceChangeEntry is a balance change of an account made by a precompile
+// call, which is mirrored to the EVM stateDB.
+type BalanceChangeEntry struct {
 	Account common.Address
 	Amount  *big.Int
 	Op      Operation
 }
 
-func NewBalanceChangeEntry(acc common.Address, amt *big.Int, op Operation) balanceChangeEntry { //nolint:revive
-	return balanceChangeEntry{acc, amt, op}
+func NewBalanceChangeEntry(acc common.Address, amt *big.Int, op Operation) BalanceChangeEntry {
+	return BalanceChangeEntry{acc, amt, op}
+}
+
+// NewBalanceChangeEntriesFromEvents creates the balanceChange entries of the EVM
+// denomination from the coin_spent and coin_received events emitted by the bank
+// module. It is used when the balance changes of a precompile call are not known
+// in advance, e.g. when executing arbitrary Cosmos messages.
+func NewBalanceChangeEntriesFromEvents(events sdk.Events) ([]BalanceChangeEntry, error) {
+	var entries []BalanceChangeEntry
+	for _, event := range events {
+		var (
+			op      Operation
+			addrKey string
+		)
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			op, addrKey = Sub, banktypes.AttributeKeySpender
+		case banktypes.EventTypeCoinReceived:
+			op, addrKey = Add, banktypes.AttributeKeyReceiver
+		default:
+			continue
+		}
+
+		addrAttr, found := event.GetAttribute(addrKey)
+		if !found {
+			return nil, fmt.Errorf("missing attribute %s in event %s", addrKey, event.Type)
+		}
+		addr, err := sdk.AccAddressFromBech32(addrAttr.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		amountAttr, found := event.GetAttribute(sdk.AttributeKeyAmount)
+		if !found {
+			return nil, fmt.Errorf("missing attribute %s in event %s", sdk.AttributeKeyAmount, event.Type)
+		}
+		coins, err := sdk.ParseCoinsNormalized(amountAttr.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		amount := evmtypes.ConvertAmountTo18DecimalsBigInt(coins.AmountOf(evmtypes.GetEVMCoinDenom()).BigInt())
+		if amount.Sign() == 0 {
+			continue
+		}
+
+		entries = append(entries, NewBalanceChangeEntry(common.BytesToAddress(addr), amount, op))
+	}
+
+	return entries, nil
 }
 
 // snapshot contains all state and events previous to the precompile call
@@ -196,11 +250,57 @@ func (p Precompile) AddJournalEntries(stateDB *statedb.StateDB, s snapshot) erro
 	return nil
 }
 
+// CapturePrecompile reports the precompile call to the EVM tracer if it implements
+// the vm.PrecompileLogger interface. The reported events are the ones emitted on the
+// cache context since the snapshot taken on RunSetup.
+func (p Precompile) CapturePrecompile(
+	evm *vm.EVM,
+	ctx sdk.Context,
+	s snapshot,
+	method *abi.Method,
+	args []interface{},
+) {
+	if evm.Config.Tracer == nil {
+		return
+	}
+	tracer, ok := evm.Config.Tracer.(vm.PrecompileLogger)
+	if !ok {
+		return
+	}
+
+	namedArgs := make(map[string]interface{}, len(args))
+	for i, arg := range args {
+		name := method.Inputs[i].Name
+		if name == "" {
+			name = fmt.Sprintf("arg%d", i)
+		}
+		namedArgs[name] = arg
+	}
+
+	var events []vm.PrecompileEvent
+	emitted := ctx.EventManager().Events()
+	if len(emitted) > len(s.Events) {
+		emitted = emitted[len(s.Events):]
+		events = make([]vm.PrecompileEvent, len(emitted))
+		for i, event := range emitted {
+			events[i] = vm.PrecompileEvent{Type: event.Type}
+			for _, attr := range event.Attributes {
+				events[i].Attributes = append(events[i].Attributes, vm.PrecompileEventAttribute{
+					Key:   attr.Key,
+					Value: attr.Value,
+				})
+			}
+		}
+	}
+
+	tracer.CapturePrecompile(p.Address(), method.Name, namedArgs, events)
+}
+
 // SetBalanceChangeEntries sets the balanceChange entries
 // as the journalEntries field of the precompile.
 // These entries will be added to the stateDB's journal
 // when calling the AddJournalEntries function
-func (p *Precompile) SetBalanceChangeEntries(entries ...balanceChangeEntry) {
+func (p *Precompile) SetBalanceChangeEntries(entries ...BalanceChangeEntry) {
 	p.journalEntries = entries
 }
 
@@ -4,12 +4,15 @@
 package common
 
 import (
+	"errors"
+	"fmt"
 	"math/big"
 	"strings"
 	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	evmosutils "github.com/evmos/evmos/v20/utils"
 )
@@ -73,6 +76,37 @@ func NewCoinsResponse(amount sdk.Coins) []Coin {
 	return outputs
 }
 
+// UnpackCoins unpacks the Coin array argument at the given index of the method
+// inputs into a valid, sorted set of coins. An empty array is returned as nil.
+func UnpackCoins(method *abi.Method, index int, arg interface{}) (sdk.Coins, error) {
+	var input []Coin
+	arguments := abi.Arguments{method.Inputs[index]}
+	if err := arguments.Copy(&input, []interface{}{arg}); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to Coin array: %s", err)
+	}
+
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	coins := make(sdk.Coins, len(input))
+	for i, coin := range input {
+		if coin.Amount == nil {
+			return nil, errors.New("nil amount")
+		}
+		// NOTE: the coin is not built with sdk.NewCoin, which panics on an
+		// invalid denomination, since the coins are validated below
+		coins[i] = sdk.Coin{Denom: coin.Denom, Amount: math.NewIntFromBigInt(coin.Amount)}
+	}
+
+	coins = coins.Sort()
+	if err := coins.Validate(); err != nil {
+		return nil, err
+	}
+
+	return coins, nil
+}
+
 // NewDecCoinsResponse converts a response to an array of DecCoin.
 func NewDecCoinsResponse(amount sdk.DecCoins) []DecCoin {
 	// Create a new output for each coin and add it to the output array.
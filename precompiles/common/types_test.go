@@ -1,10 +1,13 @@
 package common_test
 
 import (
+	"math/big"
+	"strings"
 	"testing"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/evmos/evmos/v20/precompiles/common"
 	evmostypes "github.com/evmos/evmos/v20/types"
 	"github.com/stretchr/testify/require"
@@ -45,3 +48,58 @@ func TestNewDecCoinsResponse(t *testing.T) {
 		require.Equal(t, tc.amount.BigInt(), res[0].Amount)
 	}
 }
+
+func TestUnpackCoins(t *testing.T) {
+	coinsABI, err := abi.JSON(strings.NewReader(`[{"type":"function","name":"coins","inputs":[{"name":"coins","type":"tuple[]","components":[{"name":"denom","type":"string"},{"name":"amount","type":"uint256"}]}]}]`))
+	require.NoError(t, err)
+	method := coinsABI.Methods["coins"]
+
+	testCases := []struct {
+		name     string
+		input    []common.Coin
+		expCoins sdk.Coins
+		errMsg   string
+	}{
+		{
+			name:     "empty coins",
+			input:    []common.Coin{},
+			expCoins: nil,
+		},
+		{
+			name: "coins are sorted",
+			input: []common.Coin{
+				{Denom: "uatom", Amount: big.NewInt(2)},
+				{Denom: evmostypes.BaseDenom, Amount: big.NewInt(1)},
+			},
+			expCoins: sdk.NewCoins(sdk.NewInt64Coin(evmostypes.BaseDenom, 1), sdk.NewInt64Coin("uatom", 2)),
+		},
+		{
+			name:   "invalid denomination",
+			input:  []common.Coin{{Denom: "", Amount: big.NewInt(1)}},
+			errMsg: "invalid denom",
+		},
+		{
+			name:   "zero amount",
+			input:  []common.Coin{{Denom: evmostypes.BaseDenom, Amount: big.NewInt(0)}},
+			errMsg: "coin 0aevmos amount is not positive",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// the argument is unpacked from the call data, as by the precompiles
+			bz, err := method.Inputs.Pack(tc.input)
+			require.NoError(t, err)
+			args, err := method.Inputs.Unpack(bz)
+			require.NoError(t, err)
+
+			coins, err := common.UnpackCoins(&method, 0, args[0])
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expCoins, coins)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The IDispatch contract's address.
+address constant DISPATCH_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000809;
+
+/// @dev The IDispatch contract's instance.
+IDispatch constant DISPATCH_CONTRACT = IDispatch(DISPATCH_PRECOMPILE_ADDRESS);
+
+/// @dev EventAttribute defines an attribute of a Cosmos event.
+struct EventAttribute {
+    /// @dev The key of the attribute
+    string key;
+    /// @dev The value of the attribute
+    string value;
+}
+
+/// @author The Evmos Core Team
+/// @title Cosmos Dispatch Precompile Contract
+/// @dev The interface through which solidity contracts will dispatch Cosmos
+/// messages to the modules of the chain. Only the message types allowed by
+/// governance in the EVM module parameters can be dispatched.
+interface IDispatch {
+    /// @dev Dispatch defines an Event emitted when a Cosmos message is dispatched.
+    /// @param caller the address of the caller of the precompile
+    /// @param typeUrl the type URL of the dispatched message
+    event Dispatch(address indexed caller, string typeUrl);
+
+    /// @dev CosmosEvent defines an Event emitted for each Cosmos event emitted
+    /// by the execution of a dispatched message.
+    /// @param eventType the type of the Cosmos event
+    /// @param attributes the attributes of the Cosmos event
+    event CosmosEvent(string eventType, EventAttribute[] attributes);
+
+    /// TRANSACTIONS
+
+    /// @dev dispatch defines a method to execute a protobuf Any-encoded Cosmos
+    /// message. Its signers must be the caller of the precompile, and the
+    /// messages nested within an authz MsgExec must be allowed as well.
+    /// @param typeUrl The type URL of the message, e.g. "/cosmos.bank.v1beta1.MsgSend"
+    /// @param value The protobuf-encoded message
+    /// @return response The protobuf-encoded response of the message
+    function dispatch(
+        string calldata typeUrl,
+        bytes calldata value
+    ) external returns (bytes memory response);
+
+    /// QUERIES
+
+    /// @dev getAllowedMsgs returns the type URLs of the messages that can be dispatched.
+    /// @return typeUrls The type URLs of the allowed messages
+    function getAllowedMsgs() external view returns (string[] memory typeUrls);
+}
@@ -0,0 +1,19 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package dispatch
+
+const (
+	// ErrInvalidMsgTypeURL is raised when the message type URL is not valid.
+	ErrInvalidMsgTypeURL = "invalid message type URL: %v"
+	// ErrInvalidMsg is raised when the message cannot be decoded.
+	ErrInvalidMsg = "invalid message: %v"
+	// ErrMsgNotAllowed is raised when the message type is not allowed to be dispatched.
+	ErrMsgNotAllowed = "message type %s is not allowed to be dispatched"
+	// ErrNestedMsgsNotSupported is raised when the message wraps messages executed later on.
+	ErrNestedMsgsNotSupported = "message type %s wraps messages, which is not supported"
+	// ErrDifferentSigner is raised when a signer of the message is not the caller.
+	ErrDifferentSigner = "signer address %s is not the caller address %s"
+	// ErrNoHandler is raised when no handler is registered for the message type.
+	ErrNoHandler = "no message handler found for %s"
+)
@@ -0,0 +1,77 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package dispatch
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// EventTypeDispatch defines the event type for the dispatch DispatchMethod transaction.
+	EventTypeDispatch = "Dispatch"
+	// EventTypeCosmosEvent defines the event type for the Cosmos events emitted
+	// by the dispatched messages.
+	EventTypeCosmosEvent = "CosmosEvent"
+)
+
+// EmitDispatchEvent creates a new event emitted on a Dispatch transaction.
+func (p Precompile) EmitDispatchEvent(ctx sdk.Context, stateDB vm.StateDB, caller common.Address, typeURL string) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeDispatch]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(caller)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[1]}
+	packed, err := arguments.Pack(typeURL)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitCosmosEvent creates a new event reflecting a Cosmos event emitted by the
+// execution of a dispatched message.
+func (p Precompile) EmitCosmosEvent(ctx sdk.Context, stateDB vm.StateDB, cosmosEvent sdk.Event) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeCosmosEvent]
+	topics := []common.Hash{event.ID}
+
+	// Prepare the event data
+	packed, err := event.Inputs.Pack(cosmosEvent.Type, NewEventAttributes(cosmosEvent))
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
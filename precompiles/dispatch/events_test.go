@@ -0,0 +1,71 @@
+package dispatch_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/dispatch"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+func (s *PrecompileTestSuite) TestDispatchEvent() {
+	s.SetupTest()
+
+	stDB := s.network.GetStateDB()
+	ctx := s.network.GetContext()
+	method := s.precompile.Methods[dispatch.DispatchMethod]
+	sender, recipient := s.keyring.GetKey(0), s.keyring.GetKey(1)
+	amount := sdk.NewCoins(sdk.NewCoin(s.network.GetDenom(), math.NewInt(1e18)))
+
+	eventsBefore := len(ctx.EventManager().Events())
+
+	msg := banktypes.NewMsgSend(sender.AccAddr, recipient.AccAddr, amount)
+	contract := vm.NewContract(vm.AccountRef(sender.Addr), s.precompile, big.NewInt(0), 200_000)
+	_, err := s.precompile.Dispatch(ctx, sender.Addr, contract, stDB, &method, s.dispatchArgs(msg))
+	s.Require().NoError(err)
+
+	// Check the Cosmos events are emitted on the context and reflected as EVM logs
+	cosmosEvents := ctx.EventManager().Events()[eventsBefore:]
+	s.Require().NotEmpty(cosmosEvents)
+	s.Require().Len(stDB.Logs(), 1+len(cosmosEvents))
+
+	log := stDB.Logs()[0]
+	s.Require().Equal(log.Address, s.precompile.Address())
+
+	// Check event signature matches the one emitted
+	event := s.precompile.ABI.Events[dispatch.EventTypeDispatch]
+	s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+	s.Require().Equal(log.BlockNumber, uint64(ctx.BlockHeight())) //nolint:gosec // G115
+
+	// Check the caller is the indexed topic
+	callerTopic, err := cmn.MakeTopic(sender.Addr)
+	s.Require().NoError(err)
+	s.Require().Equal(callerTopic, log.Topics[1])
+
+	// Check the fully unpacked event matches the one emitted
+	var dispatchEvent dispatch.EventDispatch
+	err = cmn.UnpackLog(s.precompile.ABI, &dispatchEvent, dispatch.EventTypeDispatch, *log)
+	s.Require().NoError(err)
+	s.Require().Equal(sender.Addr, dispatchEvent.Caller)
+	s.Require().Equal(sendMsgTypeURL, dispatchEvent.TypeURL)
+
+	// Check the Cosmos events are reflected in order with their attributes
+	event = s.precompile.ABI.Events[dispatch.EventTypeCosmosEvent]
+	for i, cosmosEvent := range cosmosEvents {
+		log := stDB.Logs()[i+1]
+		s.Require().Equal(log.Address, s.precompile.Address())
+		s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+
+		var evmEvent dispatch.EventCosmosEvent
+		err = cmn.UnpackLog(s.precompile.ABI, &evmEvent, dispatch.EventTypeCosmosEvent, *log)
+		s.Require().NoError(err)
+		s.Require().Equal(cosmosEvent.Type, evmEvent.EventType)
+		s.Require().Equal(dispatch.NewEventAttributes(cosmosEvent), evmEvent.Attributes)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package dispatch
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// GetAllowedMsgsMethod defines the ABI method name for the query of the
+	// message types allowed to be dispatched.
+	GetAllowedMsgsMethod = "getAllowedMsgs"
+)
+
+// GetAllowedMsgs returns the type URLs of the messages that can be dispatched,
+// as defined in the EVM module parameters.
+func (p Precompile) GetAllowedMsgs(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	allowedMsgs := p.evmKeeper.GetParams(ctx).AllowedDispatchMsgs
+	if allowedMsgs == nil {
+		allowedMsgs = []string{}
+	}
+
+	return method.Outputs.Pack(allowedMsgs)
+}
@@ -0,0 +1,79 @@
+package dispatch_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/dispatch"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+func (s *PrecompileTestSuite) TestGetAllowedMsgs() {
+	method := s.precompile.Methods[dispatch.GetAllowedMsgsMethod]
+	delegateMsgTypeURL := sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expAllowed  []string
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{sendMsgTypeURL}
+			},
+			nil,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 0, 1),
+		},
+		{
+			"success - no allowed messages",
+			func() []interface{} {
+				s.setAllowedMsgs()
+				return []interface{}{}
+			},
+			[]string{},
+			false,
+			"",
+		},
+		{
+			"success - allowed messages",
+			func() []interface{} {
+				s.setAllowedMsgs(sendMsgTypeURL, delegateMsgTypeURL)
+				return []interface{}{}
+			},
+			[]string{sendMsgTypeURL, delegateMsgTypeURL},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+
+			args := tc.malleate()
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), 200_000)
+
+			bz, err := s.precompile.GetAllowedMsgs(ctx, &method, contract, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expAllowed, out[0])
+		})
+	}
+}
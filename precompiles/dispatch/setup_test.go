@@ -0,0 +1,71 @@
+package dispatch_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	cosmosante "github.com/evmos/evmos/v20/app/ante/cosmos"
+	"github.com/evmos/evmos/v20/precompiles/dispatch"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/factory"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/grpc"
+	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+
+	"github.com/stretchr/testify/suite"
+)
+
+var sendMsgTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network     *network.UnitTestNetwork
+	factory     factory.TxFactory
+	grpcHandler grpc.Handler
+	keyring     testkeyring.Keyring
+
+	precompile *dispatch.Precompile
+}
+
+func TestPrecompileUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(3)
+
+	nw := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(nw)
+	txFactory := factory.New(nw, grpcHandler)
+
+	s.factory = txFactory
+	s.grpcHandler = grpcHandler
+	s.keyring = keyring
+	s.network = nw
+
+	var err error
+	if s.precompile, err = dispatch.NewPrecompile(
+		s.network.App.EvmKeeper,
+		s.network.App.MsgServiceRouter(),
+		s.network.App.AuthzKeeper,
+		cosmosante.NewAuthzLimiterDecorator(cosmosante.DisabledAuthzMsgs...),
+		s.network.App.AppCodec(),
+	); err != nil {
+		panic(err)
+	}
+
+	s.setAllowedMsgs(sendMsgTypeURL)
+}
+
+// setAllowedMsgs sets the message types allowed to be dispatched in the EVM
+// module parameters.
+func (s *PrecompileTestSuite) setAllowedMsgs(typeURLs ...string) {
+	ctx := s.network.GetContext()
+	params := s.network.App.EvmKeeper.GetParams(ctx)
+	params.AllowedDispatchMsgs = typeURLs
+	s.Require().NoError(s.network.App.EvmKeeper.SetParams(ctx, params))
+}
@@ -0,0 +1,147 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package dispatch
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// DispatchMethod defines the ABI method name for the dispatch transaction.
+	DispatchMethod = "dispatch"
+)
+
+// msgsWrapper is implemented by the messages wrapping other messages to be
+// executed later on, e.g. the gov and group proposals.
+type msgsWrapper interface {
+	GetMsgs() ([]sdk.Msg, error)
+}
+
+// Dispatch defines a method to execute a Cosmos message through the message
+// service router of the application. Only the message types allowed in the
+// EVM module parameters can be dispatched, including the messages nested
+// within an authz MsgExec, and all the signers of the message must be the
+// caller of the precompile. The origin of the transaction can only sign the
+// message when calling the precompile directly.
+func (p *Precompile) Dispatch(
+	ctx sdk.Context,
+	_ common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, typeURL, err := NewMsgFromArgs(p.cdc, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkAllowedMsg(p.evmKeeper.GetParams(ctx).AllowedDispatchMsgs, msg); err != nil {
+		return nil, err
+	}
+
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+
+	// NOTE: the origin is only allowed to sign the message when it's the
+	// caller, so that a contract can't act on behalf of the origin.
+	signers, _, err := p.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return nil, err
+	}
+	for _, signer := range signers {
+		if !bytes.Equal(signer, contract.CallerAddress.Bytes()) {
+			return nil, fmt.Errorf(ErrDifferentSigner, common.BytesToAddress(signer).String(), contract.CallerAddress.String())
+		}
+	}
+
+	// the messages disabled within authz are rejected as done by the AnteHandler
+	if err := p.authzLimiter.CheckDisabledMsgs([]sdk.Msg{msg}); err != nil {
+		return nil, err
+	}
+
+	handler := p.msgRouter.Handler(msg)
+	if handler == nil {
+		return nil, fmt.Errorf(ErrNoHandler, typeURL)
+	}
+
+	res, err := handler(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// The message handler emits the events on a new event manager, so they are
+	// emitted on the precompile context as done by the baseapp.
+	events := make(sdk.Events, len(res.Events))
+	for i, event := range res.Events {
+		events[i] = sdk.Event(event)
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	// NOTE: the balance changes of the dispatched message are not known in
+	// advance, so they are taken from the bank events to mirror them to the
+	// EVM stateDB.
+	entries, err := cmn.NewBalanceChangeEntriesFromEvents(events)
+	if err != nil {
+		return nil, err
+	}
+	p.SetBalanceChangeEntries(entries...)
+
+	if err = p.EmitDispatchEvent(ctx, stateDB, contract.CallerAddress, typeURL); err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		if err = p.EmitCosmosEvent(ctx, stateDB, event); err != nil {
+			return nil, err
+		}
+	}
+
+	var response []byte
+	if len(res.MsgResponses) > 0 {
+		response = res.MsgResponses[0].Value
+	}
+
+	return method.Outputs.Pack(response)
+}
+
+// checkAllowedMsg returns an error if the message, or any message nested within
+// an authz MsgExec, is not allowed to be dispatched. The other messages wrapping
+// messages are rejected, since their nested messages are executed later on.
+func checkAllowedMsg(allowed []string, msg sdk.Msg) error {
+	typeURL := sdk.MsgTypeURL(msg)
+	if !slices.Contains(allowed, typeURL) {
+		return fmt.Errorf(ErrMsgNotAllowed, typeURL)
+	}
+
+	switch m := msg.(type) {
+	case *authz.MsgExec:
+		msgs, err := m.GetMessages()
+		if err != nil {
+			return err
+		}
+		for _, nested := range msgs {
+			if err := checkAllowedMsg(allowed, nested); err != nil {
+				return err
+			}
+		}
+	case msgsWrapper:
+		return fmt.Errorf(ErrNestedMsgsNotSupported, typeURL)
+	}
+
+	return nil
+}
@@ -0,0 +1,258 @@
+package dispatch_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/ethereum/go-ethereum/common"
+
+	cosmosante "github.com/evmos/evmos/v20/app/ante/cosmos"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/dispatch"
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	erc20types "github.com/evmos/evmos/v20/x/erc20/types"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+)
+
+func (s *PrecompileTestSuite) TestDispatch() {
+	var (
+		ctx    sdk.Context
+		stDB   *statedb.StateDB
+		amount sdk.Coins
+	)
+	method := s.precompile.Methods[dispatch.DispatchMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() (origin, caller common.Address, args []interface{})
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid message type URL",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{
+					"cosmos.bank.v1beta1.MsgSend", []byte{},
+				}
+			},
+			func() {},
+			true,
+			"invalid message type URL",
+		},
+		{
+			"fail - invalid message bytes",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{
+					sendMsgTypeURL, []byte{0xff},
+				}
+			},
+			func() {},
+			true,
+			"invalid message",
+		},
+		{
+			"fail - message type not allowed",
+			func() (common.Address, common.Address, []interface{}) {
+				s.setAllowedMsgs()
+				msg := banktypes.NewMsgSend(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), amount)
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), s.dispatchArgs(msg)
+			},
+			func() {},
+			true,
+			fmt.Sprintf(dispatch.ErrMsgNotAllowed, sendMsgTypeURL),
+		},
+		{
+			"fail - signer is neither the caller nor the origin",
+			func() (common.Address, common.Address, []interface{}) {
+				msg := banktypes.NewMsgSend(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), amount)
+				return s.keyring.GetAddr(1), s.keyring.GetAddr(2), s.dispatchArgs(msg)
+			},
+			func() {},
+			true,
+			"is not the caller address",
+		},
+		{
+			"fail - signer is the origin of a call made by a contract",
+			func() (common.Address, common.Address, []interface{}) {
+				msg := banktypes.NewMsgSend(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), amount)
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(1), s.dispatchArgs(msg)
+			},
+			func() {},
+			true,
+			"is not the caller address",
+		},
+		{
+			"fail - invalid message",
+			func() (common.Address, common.Address, []interface{}) {
+				s.setAllowedMsgs(sdk.MsgTypeURL(&erc20types.MsgConvertERC20{}))
+				msg := &erc20types.MsgConvertERC20{
+					ContractAddress: "invalid",
+					Amount:          math.OneInt(),
+					Receiver:        s.keyring.GetAccAddr(0).String(),
+					Sender:          s.keyring.GetAddr(0).Hex(),
+				}
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), s.dispatchArgs(msg)
+			},
+			func() {},
+			true,
+			"invalid contract hex address",
+		},
+		{
+			"fail - message type nested within MsgExec not allowed",
+			func() (common.Address, common.Address, []interface{}) {
+				s.setAllowedMsgs(sdk.MsgTypeURL(&sdkauthz.MsgExec{}))
+				send := banktypes.NewMsgSend(s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), amount)
+				msg := sdkauthz.NewMsgExec(s.keyring.GetAccAddr(0), []sdk.Msg{send})
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), s.dispatchArgs(&msg)
+			},
+			func() {},
+			true,
+			fmt.Sprintf(dispatch.ErrMsgNotAllowed, sendMsgTypeURL),
+		},
+		{
+			"fail - allowed message nested within MsgExec without authorization",
+			func() (common.Address, common.Address, []interface{}) {
+				s.setAllowedMsgs(sdk.MsgTypeURL(&sdkauthz.MsgExec{}), sendMsgTypeURL)
+				send := banktypes.NewMsgSend(s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), amount)
+				msg := sdkauthz.NewMsgExec(s.keyring.GetAccAddr(0), []sdk.Msg{send})
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), s.dispatchArgs(&msg)
+			},
+			func() {},
+			true,
+			"authorization not found",
+		},
+		{
+			"fail - message wrapping messages",
+			func() (common.Address, common.Address, []interface{}) {
+				s.setAllowedMsgs(sdk.MsgTypeURL(&govv1.MsgSubmitProposal{}), sendMsgTypeURL)
+				send := banktypes.NewMsgSend(s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), amount)
+				msg, err := govv1.NewMsgSubmitProposal(
+					[]sdk.Msg{send}, amount, s.keyring.GetAccAddr(0).String(), "", "title", "summary", false,
+				)
+				s.Require().NoError(err)
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), s.dispatchArgs(msg)
+			},
+			func() {},
+			true,
+			fmt.Sprintf(dispatch.ErrNestedMsgsNotSupported, sdk.MsgTypeURL(&govv1.MsgSubmitProposal{})),
+		},
+		{
+			"fail - message type disabled within authz",
+			func() (common.Address, common.Address, []interface{}) {
+				// the message types disabled within authz in the app can't be
+				// allowed, so the MsgSend is disabled instead
+				var err error
+				s.precompile, err = dispatch.NewPrecompile(
+					s.network.App.EvmKeeper,
+					s.network.App.MsgServiceRouter(),
+					s.network.App.AuthzKeeper,
+					cosmosante.NewAuthzLimiterDecorator(sendMsgTypeURL),
+					s.network.App.AppCodec(),
+				)
+				s.Require().NoError(err)
+				s.setAllowedMsgs(sdk.MsgTypeURL(&sdkauthz.MsgExec{}), sendMsgTypeURL)
+				send := banktypes.NewMsgSend(s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), amount)
+				msg := sdkauthz.NewMsgExec(s.keyring.GetAccAddr(0), []sdk.Msg{send})
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), s.dispatchArgs(&msg)
+			},
+			func() {},
+			true,
+			"found disabled msg type",
+		},
+		{
+			"fail - insufficient funds",
+			func() (common.Address, common.Address, []interface{}) {
+				amount = amount.MulInt(network.PrefundedAccountInitialBalance)
+				msg := banktypes.NewMsgSend(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), amount)
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), s.dispatchArgs(msg)
+			},
+			func() {},
+			true,
+			"insufficient funds",
+		},
+		{
+			"success - the origin is the signer",
+			func() (common.Address, common.Address, []interface{}) {
+				msg := banktypes.NewMsgSend(s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1), amount)
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), s.dispatchArgs(msg)
+			},
+			func() {
+				s.requireSent(ctx, 0, 1, amount)
+			},
+			false,
+			"",
+		},
+		{
+			"success - the calling contract is the signer",
+			func() (common.Address, common.Address, []interface{}) {
+				msg := banktypes.NewMsgSend(s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(2), amount)
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(1), s.dispatchArgs(msg)
+			},
+			func() {
+				s.requireSent(ctx, 1, 2, amount)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			stDB = s.network.GetStateDB()
+			amount = sdk.NewCoins(sdk.NewCoin(s.network.GetDenom(), math.NewInt(1e18)))
+
+			origin, caller, args := tc.malleate()
+			contract := vm.NewContract(vm.AccountRef(caller), s.precompile, big.NewInt(0), 200_000)
+
+			bz, err := s.precompile.Dispatch(ctx, origin, contract, stDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				out, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				// MsgSendResponse has no fields, so it is encoded as empty bytes
+				s.Require().Empty(out[0])
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+// dispatchArgs returns the arguments of the dispatch method for the given message.
+func (s *PrecompileTestSuite) dispatchArgs(msg sdk.Msg) []interface{} {
+	value, err := s.network.App.AppCodec().Marshal(msg)
+	s.Require().NoError(err)
+	return []interface{}{sdk.MsgTypeURL(msg), value}
+}
+
+// requireSent checks that the given amount was transferred between the
+// accounts of the keyring in the bank keeper.
+func (s *PrecompileTestSuite) requireSent(ctx sdk.Context, from, to int, amount sdk.Coins) {
+	sent := amount.AmountOf(s.network.GetDenom())
+
+	balance := s.network.App.BankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(from), s.network.GetDenom())
+	s.Require().Equal(network.PrefundedAccountInitialBalance.Sub(sent), balance.Amount)
+	balance = s.network.App.BankKeeper.GetBalance(ctx, s.keyring.GetAccAddr(to), s.network.GetDenom())
+	s.Require().Equal(network.PrefundedAccountInitialBalance.Add(sent), balance.Amount)
+}
@@ -0,0 +1,71 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package dispatch
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+)
+
+// EventDispatch defines the event data for the Dispatch transaction.
+type EventDispatch struct {
+	Caller  common.Address
+	TypeURL string `abi:"typeUrl"`
+}
+
+// EventCosmosEvent defines the event data reflecting a Cosmos event emitted
+// by a dispatched message.
+type EventCosmosEvent struct {
+	EventType  string
+	Attributes []EventAttribute
+}
+
+// EventAttribute defines an attribute of a Cosmos event, as it is emitted in
+// the CosmosEvent EVM log.
+type EventAttribute struct {
+	Key   string `abi:"key"`
+	Value string `abi:"value"`
+}
+
+// NewMsgFromArgs decodes the protobuf Any-encoded Cosmos message from the
+// dispatch method arguments.
+func NewMsgFromArgs(cdc codec.Codec, args []interface{}) (sdk.Msg, string, error) {
+	if len(args) != 2 {
+		return nil, "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	typeURL, ok := args[0].(string)
+	if !ok || !strings.HasPrefix(typeURL, "/") {
+		return nil, "", fmt.Errorf(ErrInvalidMsgTypeURL, args[0])
+	}
+
+	value, ok := args[1].([]byte)
+	if !ok {
+		return nil, "", fmt.Errorf(ErrInvalidMsg, args[1])
+	}
+
+	var msg sdk.Msg
+	if err := cdc.UnpackAny(&codectypes.Any{TypeUrl: typeURL, Value: value}, &msg); err != nil {
+		return nil, "", fmt.Errorf(ErrInvalidMsg, err)
+	}
+
+	return msg, typeURL, nil
+}
+
+// NewEventAttributes converts the attributes of a Cosmos event to the
+// EventAttribute ABI structs.
+func NewEventAttributes(event sdk.Event) []EventAttribute {
+	attributes := make([]EventAttribute, len(event.Attributes))
+	for i, attr := range event.Attributes {
+		attributes[i] = EventAttribute{Key: attr.Key, Value: attr.Value}
+	}
+	return attributes
+}
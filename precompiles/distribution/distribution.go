@@ -137,6 +137,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		return nil, err
 	}
 
+	p.CapturePrecompile(evm, ctx, snapshot, method, args)
+
 	return bz, nil
 }
 
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IFeegrant contract's address.
+address constant FEEGRANT_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000808;
+
+/// @dev The IFeegrant contract's instance.
+IFeegrant constant FEEGRANT_CONTRACT = IFeegrant(FEEGRANT_PRECOMPILE_ADDRESS);
+
+/// @dev AllowanceData represents a fee allowance granted by a granter to a grantee.
+struct AllowanceData {
+    /// @dev The address of the granter
+    address granter;
+    /// @dev The address of the grantee
+    address grantee;
+    /// @dev The type URL of the allowance
+    string allowance;
+    /// @dev The maximum amount of coins that can be spent, empty if unlimited
+    Coin[] spendLimit;
+    /// @dev The expiration of the allowance as a unix timestamp in seconds, zero if it doesn't expire
+    int64 expiration;
+    /// @dev The duration of a period in seconds, zero for a non-periodic allowance
+    int64 period;
+    /// @dev The maximum amount of coins that can be spent in a period
+    Coin[] periodSpendLimit;
+    /// @dev The amount of coins that can still be spent in the current period
+    Coin[] periodCanSpend;
+    /// @dev The end of the current period as a unix timestamp in seconds
+    int64 periodReset;
+    /// @dev The type URLs of the messages the allowance can pay the fees of, empty if unrestricted
+    string[] allowedMessages;
+}
+
+/// @author The Evmos Core Team
+/// @title Feegrant Precompile Contract
+/// @dev The interface through which solidity contracts will interact with the feegrant module
+interface IFeegrant {
+    /// @dev GrantAllowance defines an Event emitted when a fee allowance is granted.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    event GrantAllowance(address indexed granter, address indexed grantee);
+
+    /// @dev RevokeAllowance defines an Event emitted when a fee allowance is revoked.
+    /// @param granter the address of the granter
+    /// @param grantee the address of the grantee
+    event RevokeAllowance(address indexed granter, address indexed grantee);
+
+    /// TRANSACTIONS
+
+    /// @dev grantAllowance defines a method to grant a basic fee allowance to the grantee.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param spendLimit The maximum amount of coins that can be spent, empty if unlimited
+    /// @param expiration The expiration of the allowance as a unix timestamp in
+    /// seconds, zero for an allowance that doesn't expire
+    /// @param allowedMessages The type URLs of the messages the allowance can
+    /// pay the fees of, empty if unrestricted
+    /// @return success Whether the transaction was successful or not
+    function grantAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration,
+        string[] calldata allowedMessages
+    ) external returns (bool success);
+
+    /// @dev grantPeriodicAllowance defines a method to grant a periodic fee
+    /// allowance to the grantee, whose period spend limit is reset every period.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param spendLimit The maximum amount of coins that can be spent, empty if unlimited
+    /// @param expiration The expiration of the allowance as a unix timestamp in
+    /// seconds, zero for an allowance that doesn't expire
+    /// @param period The duration of a period in seconds
+    /// @param periodSpendLimit The maximum amount of coins that can be spent in a period
+    /// @param allowedMessages The type URLs of the messages the allowance can
+    /// pay the fees of, empty if unrestricted
+    /// @return success Whether the transaction was successful or not
+    function grantPeriodicAllowance(
+        address granter,
+        address grantee,
+        Coin[] calldata spendLimit,
+        int64 expiration,
+        int64 period,
+        Coin[] calldata periodSpendLimit,
+        string[] calldata allowedMessages
+    ) external returns (bool success);
+
+    /// @dev revokeAllowance defines a method to revoke the fee allowance granted to the grantee.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @return success Whether the transaction was successful or not
+    function revokeAllowance(
+        address granter,
+        address grantee
+    ) external returns (bool success);
+
+    /// QUERIES
+
+    /// @dev getAllowance returns the fee allowance granted by the granter to the grantee.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @return allowance The fee allowance
+    function getAllowance(
+        address granter,
+        address grantee
+    ) external view returns (AllowanceData memory allowance);
+
+    /// @dev getAllowances returns the fee allowances granted to the grantee.
+    /// @param grantee The address of the grantee
+    /// @param pagination The pagination options
+    /// @return allowances The fee allowances granted to the grantee
+    /// @return pageResponse The pagination information
+    function getAllowances(
+        address grantee,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (AllowanceData[] memory allowances, PageResponse memory pageResponse);
+
+    /// @dev getAllowancesByGranter returns the fee allowances granted by the granter.
+    /// @param granter The address of the granter
+    /// @param pagination The pagination options
+    /// @return allowances The fee allowances granted by the granter
+    /// @return pageResponse The pagination information
+    function getAllowancesByGranter(
+        address granter,
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (AllowanceData[] memory allowances, PageResponse memory pageResponse);
+}
@@ -0,0 +1,23 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package feegrant
+
+const (
+	// ErrDifferentCallerFromGranter is raised when the caller address is not the same as the granter address.
+	ErrDifferentCallerFromGranter = "caller address %s is not the same as granter address %s"
+	// ErrInvalidGranter is raised when the granter address is not valid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidGrantee is raised when the grantee address is not valid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidSpendLimit is raised when a spend limit is not valid.
+	ErrInvalidSpendLimit = "invalid spend limit: %v"
+	// ErrInvalidExpiration is raised when the expiration is not valid.
+	ErrInvalidExpiration = "invalid expiration: %v"
+	// ErrInvalidPeriod is raised when the period is not valid.
+	ErrInvalidPeriod = "invalid period: %v"
+	// ErrInvalidAllowedMessages is raised when the allowed messages are not valid.
+	ErrInvalidAllowedMessages = "invalid allowed messages: %v"
+	// ErrUnknownAllowance is raised when the type of a fee allowance is not supported.
+	ErrUnknownAllowance = "unknown fee allowance type: %T"
+)
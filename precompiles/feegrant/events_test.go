@@ -0,0 +1,65 @@
+package feegrant_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/feegrant"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+func (s *PrecompileTestSuite) TestGrantAllowanceEvent() {
+	s.SetupTest()
+
+	stDB := s.network.GetStateDB()
+	ctx := s.network.GetContext()
+	method := s.precompile.Methods[feegrant.GrantAllowanceMethod]
+	granter, grantee := s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+
+	contract := vm.NewContract(vm.AccountRef(granter), s.precompile, big.NewInt(0), 200_000)
+	_, err := s.precompile.GrantAllowance(ctx, granter, contract, stDB, &method, []interface{}{
+		granter, grantee, []cmn.Coin{}, int64(0), []string{},
+	})
+	s.Require().NoError(err)
+
+	s.Require().Len(stDB.Logs(), 1)
+	log := stDB.Logs()[0]
+	s.Require().Equal(log.Address, s.precompile.Address())
+
+	// Check event signature matches the one emitted
+	event := s.precompile.ABI.Events[feegrant.EventTypeGrantAllowance]
+	s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+	s.Require().Equal(log.BlockNumber, uint64(ctx.BlockHeight())) //nolint:gosec // G115
+
+	// Check the granter and grantee are the indexed topics
+	granterTopic, err := cmn.MakeTopic(granter)
+	s.Require().NoError(err)
+	s.Require().Equal(granterTopic, log.Topics[1])
+	granteeTopic, err := cmn.MakeTopic(grantee)
+	s.Require().NoError(err)
+	s.Require().Equal(granteeTopic, log.Topics[2])
+}
+
+func (s *PrecompileTestSuite) TestRevokeAllowanceEvent() {
+	s.SetupTest()
+	s.grantAllowance(0, 1)
+
+	stDB := s.network.GetStateDB()
+	ctx := s.network.GetContext()
+	method := s.precompile.Methods[feegrant.RevokeAllowanceMethod]
+	granter, grantee := s.keyring.GetAddr(0), s.keyring.GetAddr(1)
+
+	contract := vm.NewContract(vm.AccountRef(granter), s.precompile, big.NewInt(0), 200_000)
+	_, err := s.precompile.RevokeAllowance(ctx, granter, contract, stDB, &method, []interface{}{granter, grantee})
+	s.Require().NoError(err)
+
+	s.Require().Len(stDB.Logs(), 1)
+	log := stDB.Logs()[0]
+
+	// Check event signature matches the one emitted
+	event := s.precompile.ABI.Events[feegrant.EventTypeRevokeAllowance]
+	s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+}
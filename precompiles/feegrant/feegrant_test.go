@@ -0,0 +1,57 @@
+package feegrant_test
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/evmos/evmos/v20/precompiles/feegrant"
+)
+
+func (s *PrecompileTestSuite) TestIsTransaction() {
+	testCases := []struct {
+		name   string
+		method abi.Method
+		isTx   bool
+	}{
+		{
+			feegrant.GrantAllowanceMethod,
+			s.precompile.Methods[feegrant.GrantAllowanceMethod],
+			true,
+		},
+		{
+			feegrant.GrantPeriodicAllowanceMethod,
+			s.precompile.Methods[feegrant.GrantPeriodicAllowanceMethod],
+			true,
+		},
+		{
+			feegrant.RevokeAllowanceMethod,
+			s.precompile.Methods[feegrant.RevokeAllowanceMethod],
+			true,
+		},
+		{
+			feegrant.GetAllowanceMethod,
+			s.precompile.Methods[feegrant.GetAllowanceMethod],
+			false,
+		},
+		{
+			feegrant.GetAllowancesMethod,
+			s.precompile.Methods[feegrant.GetAllowancesMethod],
+			false,
+		},
+		{
+			feegrant.GetAllowancesByGranterMethod,
+			s.precompile.Methods[feegrant.GetAllowancesByGranterMethod],
+			false,
+		},
+		{
+			"invalid",
+			abi.Method{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Require().Equal(tc.isTx, s.precompile.IsTransaction(&tc.method))
+		})
+	}
+}
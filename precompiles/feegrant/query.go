@@ -0,0 +1,98 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package feegrant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// GetAllowanceMethod defines the method name for the allowance precompile request.
+	GetAllowanceMethod = "getAllowance"
+	// GetAllowancesMethod defines the method name for the allowances precompile request.
+	GetAllowancesMethod = "getAllowances"
+	// GetAllowancesByGranterMethod defines the method name for the allowances by granter precompile request.
+	GetAllowancesByGranterMethod = "getAllowancesByGranter"
+)
+
+// GetAllowance implements the query logic for getting the fee allowance granted
+// by a granter to a grantee.
+func (p Precompile) GetAllowance(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseAllowanceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.Allowance(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance, err := NewAllowanceData(p.cdc, res.Allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(allowance)
+}
+
+// GetAllowances implements the query logic for getting the fee allowances
+// granted to a grantee.
+func (p Precompile) GetAllowances(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseAllowancesArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.Allowances(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(AllowancesOutput).FromGrants(p.cdc, res.Allowances, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.Allowances, output.PageResponse)
+}
+
+// GetAllowancesByGranter implements the query logic for getting the fee
+// allowances granted by a granter.
+func (p Precompile) GetAllowancesByGranter(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseAllowancesByGranterArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.feegrantKeeper.AllowancesByGranter(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(AllowancesOutput).FromGrants(p.cdc, res.Allowances, res.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.Allowances, output.PageResponse)
+}
@@ -0,0 +1,296 @@
+package feegrant_test
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/feegrant"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+func (s *PrecompileTestSuite) TestGetAllowance() {
+	method := s.precompile.Methods[feegrant.GetAllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(allowance feegrant.AllowanceData)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(feegrant.AllowanceData) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, s.keyring.GetAddr(1)}
+			},
+			func(feegrant.AllowanceData) {},
+			true,
+			"invalid granter address",
+		},
+		{
+			"fail - allowance not found",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(0)}
+			},
+			func(feegrant.AllowanceData) {},
+			true,
+			"fee-grant not found",
+		},
+		{
+			"success - get a basic allowance",
+			func() []interface{} {
+				s.grantAllowance(0, 1)
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func(allowance feegrant.AllowanceData) {
+				s.Require().Equal(s.keyring.GetAddr(0), allowance.Granter)
+				s.Require().Equal(s.keyring.GetAddr(1), allowance.Grantee)
+				s.Require().Equal("/cosmos.feegrant.v1beta1.BasicAllowance", allowance.Allowance)
+				s.Require().Empty(allowance.SpendLimit)
+				s.Require().Zero(allowance.Expiration)
+				s.Require().Zero(allowance.Period)
+				s.Require().Empty(allowance.AllowedMessages)
+			},
+			false,
+			"",
+		},
+		{
+			"success - get a periodic allowance restricted to some messages",
+			func() []interface{} {
+				s.grantPeriodicAllowance(0, 2)
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(2)}
+			},
+			func(allowance feegrant.AllowanceData) {
+				ctx := s.network.GetContext()
+				s.Require().Equal("/cosmos.feegrant.v1beta1.AllowedMsgAllowance", allowance.Allowance)
+				s.Require().Equal([]cmn.Coin{{Denom: s.network.GetDenom(), Amount: big.NewInt(1000)}}, allowance.SpendLimit)
+				s.Require().Equal(ctx.BlockTime().Add(24*time.Hour).Unix(), allowance.Expiration)
+				s.Require().Equal(int64(3600), allowance.Period)
+				s.Require().Equal([]cmn.Coin{{Denom: s.network.GetDenom(), Amount: big.NewInt(100)}}, allowance.PeriodSpendLimit)
+				s.Require().Equal(allowance.PeriodSpendLimit, allowance.PeriodCanSpend)
+				s.Require().Equal(ctx.BlockTime().Add(time.Hour).Unix(), allowance.PeriodReset)
+				s.Require().Equal([]string{sendMsgTypeURL}, allowance.AllowedMessages)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), 200_000)
+
+			bz, err := s.precompile.GetAllowance(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				var out struct{ Allowance feegrant.AllowanceData }
+				s.Require().NoError(s.precompile.UnpackIntoInterface(&out, feegrant.GetAllowanceMethod, bz))
+				tc.postCheck(out.Allowance)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGetAllowances() {
+	method := s.precompile.Methods[feegrant.GetAllowancesMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expLen      int
+		expNextKey  bool
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			0, false,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{}}
+			},
+			0, false,
+			true,
+			"invalid grantee address",
+		},
+		{
+			"success - get all the allowances of the grantee",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(2), query.PageRequest{}}
+			},
+			2, false,
+			false,
+			"",
+		},
+		{
+			"success - get the allowances of the grantee with pagination",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(2), query.PageRequest{Limit: 1}}
+			},
+			1, true,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.grantAllowance(0, 2)
+			s.grantPeriodicAllowance(1, 2)
+
+			ctx := s.network.GetContext()
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), 200_000)
+
+			bz, err := s.precompile.GetAllowances(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				var out feegrant.AllowancesOutput
+				s.Require().NoError(s.precompile.UnpackIntoInterface(&out, feegrant.GetAllowancesMethod, bz))
+				s.Require().Len(out.Allowances, tc.expLen)
+				s.Require().Equal(tc.expNextKey, len(out.PageResponse.NextKey) > 0)
+				for _, allowance := range out.Allowances {
+					s.Require().Equal(s.keyring.GetAddr(2), allowance.Grantee)
+				}
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGetAllowancesByGranter() {
+	method := s.precompile.Methods[feegrant.GetAllowancesByGranterMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expLen      int
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			0,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid granter address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, query.PageRequest{}}
+			},
+			0,
+			true,
+			"invalid granter address",
+		},
+		{
+			"success - get all the allowances of the granter",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{}}
+			},
+			2,
+			false,
+			"",
+		},
+		{
+			"success - no allowances of the granter",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(2), query.PageRequest{}}
+			},
+			0,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.grantAllowance(0, 1)
+			s.grantPeriodicAllowance(0, 2)
+
+			ctx := s.network.GetContext()
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), 200_000)
+
+			args := tc.malleate()
+			bz, err := s.precompile.GetAllowancesByGranter(ctx, &method, contract, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				var out feegrant.AllowancesOutput
+				s.Require().NoError(s.precompile.UnpackIntoInterface(&out, feegrant.GetAllowancesByGranterMethod, bz))
+				s.Require().Len(out.Allowances, tc.expLen)
+				for _, allowance := range out.Allowances {
+					s.Require().Equal(args[0].(common.Address), allowance.Granter)
+				}
+			}
+		})
+	}
+}
+
+// grantPeriodicAllowance grants a periodic fee allowance restricted to the bank
+// send messages from the keyring account with the granter index to the one
+// with the grantee index.
+func (s *PrecompileTestSuite) grantPeriodicAllowance(granter, grantee int) {
+	ctx := s.network.GetContext()
+	expiration := ctx.BlockTime().Add(24 * time.Hour)
+	periodSpendLimit := sdk.NewCoins(sdk.NewInt64Coin(s.network.GetDenom(), 100))
+
+	allowance, err := sdkfeegrant.NewAllowedMsgAllowance(&sdkfeegrant.PeriodicAllowance{
+		Basic: sdkfeegrant.BasicAllowance{
+			SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(s.network.GetDenom(), 1000)),
+			Expiration: &expiration,
+		},
+		Period:           time.Hour,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		PeriodReset:      ctx.BlockTime().Add(time.Hour),
+	}, []string{sendMsgTypeURL})
+	s.Require().NoError(err)
+
+	err = s.network.App.FeeGrantKeeper.GrantAllowance(
+		ctx,
+		s.keyring.GetAccAddr(granter),
+		s.keyring.GetAccAddr(grantee),
+		allowance,
+	)
+	s.Require().NoError(err)
+}
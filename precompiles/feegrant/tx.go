@@ -0,0 +1,121 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package feegrant
+
+import (
+	"fmt"
+
+	"cosmossdk.io/x/feegrant"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// GrantAllowanceMethod defines the ABI method name for the feegrant GrantAllowance
+	// transaction with a basic allowance.
+	GrantAllowanceMethod = "grantAllowance"
+	// GrantPeriodicAllowanceMethod defines the ABI method name for the feegrant
+	// GrantAllowance transaction with a periodic allowance.
+	GrantPeriodicAllowanceMethod = "grantPeriodicAllowance"
+	// RevokeAllowanceMethod defines the ABI method name for the feegrant RevokeAllowance transaction.
+	RevokeAllowanceMethod = "revokeAllowance"
+)
+
+// GrantAllowance defines a method to grant a basic fee allowance to the grantee.
+func (p Precompile) GrantAllowance(
+	ctx sdk.Context,
+	_ common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, granteeHexAddr, err := NewMsgGrantAllowance(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grantAllowance(ctx, contract, stateDB, method, msg, granterHexAddr, granteeHexAddr)
+}
+
+// GrantPeriodicAllowance defines a method to grant a periodic fee allowance to
+// the grantee. The first period starts at the current block time.
+func (p Precompile) GrantPeriodicAllowance(
+	ctx sdk.Context,
+	_ common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, granteeHexAddr, err := NewMsgGrantPeriodicAllowance(method, ctx.BlockTime(), args)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.grantAllowance(ctx, contract, stateDB, method, msg, granterHexAddr, granteeHexAddr)
+}
+
+// grantAllowance checks the caller and grants the fee allowance of the given message.
+func (p Precompile) grantAllowance(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	msg *feegrant.MsgGrantAllowance,
+	granterHexAddr, granteeHexAddr common.Address,
+) ([]byte, error) {
+	// Only the direct caller can act as the granter, the origin is not
+	// accepted when the precompile is called by another contract
+	if contract.CallerAddress != granterHexAddr {
+		return nil, fmt.Errorf(ErrDifferentCallerFromGranter, contract.CallerAddress.String(), granterHexAddr.String())
+	}
+
+	msgSrv := feegrantkeeper.NewMsgServerImpl(p.feegrantKeeper)
+	if _, err := msgSrv.GrantAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitGrantAllowanceEvent(ctx, stateDB, granterHexAddr, granteeHexAddr); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// RevokeAllowance defines a method to revoke the fee allowance granted to the grantee.
+func (p Precompile) RevokeAllowance(
+	ctx sdk.Context,
+	_ common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, granterHexAddr, granteeHexAddr, err := NewMsgRevokeAllowance(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the direct caller can act as the granter, the origin is not
+	// accepted when the precompile is called by another contract
+	if contract.CallerAddress != granterHexAddr {
+		return nil, fmt.Errorf(ErrDifferentCallerFromGranter, contract.CallerAddress.String(), granterHexAddr.String())
+	}
+
+	msgSrv := feegrantkeeper.NewMsgServerImpl(p.feegrantKeeper)
+	if _, err = msgSrv.RevokeAllowance(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeAllowanceEvent(ctx, stateDB, granterHexAddr, granteeHexAddr); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
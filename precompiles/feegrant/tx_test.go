@@ -0,0 +1,376 @@
+package feegrant_test
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdkfeegrant "cosmossdk.io/x/feegrant"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/feegrant"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+var sendMsgTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+func (s *PrecompileTestSuite) TestGrantAllowance() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[feegrant.GrantAllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() (origin, caller common.Address, args []interface{})
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{
+					s.keyring.GetAddr(0), common.Address{}, []cmn.Coin{}, int64(0), []string{},
+				}
+			},
+			func() {},
+			true,
+			"invalid grantee address",
+		},
+		{
+			"fail - invalid spend limit",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{{Denom: "", Amount: big.NewInt(100)}}, int64(0), []string{},
+				}
+			},
+			func() {},
+			true,
+			"invalid spend limit",
+		},
+		{
+			"fail - caller is not the granter",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(1), s.keyring.GetAddr(1), []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, int64(0), []string{},
+				}
+			},
+			func() {},
+			true,
+			"is not the same as granter address",
+		},
+		{
+			"fail - granter is the origin of a call made by a contract",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(2), []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(2), []cmn.Coin{}, int64(0), []string{},
+				}
+			},
+			func() {},
+			true,
+			"is not the same as granter address",
+		},
+		{
+			"fail - self grant",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(0), []cmn.Coin{}, int64(0), []string{},
+				}
+			},
+			func() {},
+			true,
+			"cannot self-grant fee authorization",
+		},
+		{
+			"fail - allowance already exists",
+			func() (common.Address, common.Address, []interface{}) {
+				s.grantAllowance(0, 1)
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, int64(0), []string{},
+				}
+			},
+			func() {},
+			true,
+			"fee allowance already exists",
+		},
+		{
+			"success - the origin grants an unlimited allowance",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, int64(0), []string{},
+				}
+			},
+			func() {
+				allowance := s.getAllowance(ctx, 0, 1)
+				basic, ok := allowance.(*sdkfeegrant.BasicAllowance)
+				s.Require().True(ok)
+				s.Require().Nil(basic.SpendLimit)
+				s.Require().Nil(basic.Expiration)
+			},
+			false,
+			"",
+		},
+		{
+			"success - the calling contract grants an allowance restricted to some messages",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(1), []interface{}{
+					s.keyring.GetAddr(1),
+					s.keyring.GetAddr(2),
+					[]cmn.Coin{{Denom: s.network.GetDenom(), Amount: big.NewInt(1000)}},
+					ctx.BlockTime().Add(time.Hour).Unix(),
+					[]string{sendMsgTypeURL},
+				}
+			},
+			func() {
+				allowance := s.getAllowance(ctx, 1, 2)
+				allowedMsgAllowance, ok := allowance.(*sdkfeegrant.AllowedMsgAllowance)
+				s.Require().True(ok)
+				s.Require().Equal([]string{sendMsgTypeURL}, allowedMsgAllowance.AllowedMessages)
+
+				inner, err := allowedMsgAllowance.GetAllowance()
+				s.Require().NoError(err)
+				basic, ok := inner.(*sdkfeegrant.BasicAllowance)
+				s.Require().True(ok)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.network.GetDenom(), 1000)), basic.SpendLimit)
+				s.Require().Equal(ctx.BlockTime().Add(time.Hour).Unix(), basic.Expiration.Unix())
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			stDB := s.network.GetStateDB()
+
+			origin, caller, args := tc.malleate()
+			contract := vm.NewContract(vm.AccountRef(caller), s.precompile, big.NewInt(0), 200_000)
+
+			bz, err := s.precompile.GrantAllowance(ctx, origin, contract, stDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				success, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				s.Require().Equal(true, success[0])
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGrantPeriodicAllowance() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[feegrant.GrantPeriodicAllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 7, 0),
+		},
+		{
+			"fail - invalid period",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, int64(0),
+					int64(0), []cmn.Coin{{Denom: s.network.GetDenom(), Amount: big.NewInt(100)}}, []string{},
+				}
+			},
+			func() {},
+			true,
+			"invalid period",
+		},
+		{
+			"fail - empty period spend limit",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, int64(0),
+					int64(3600), []cmn.Coin{}, []string{},
+				}
+			},
+			func() {},
+			true,
+			"spend limit must be positive",
+		},
+		{
+			"success - grant a periodic allowance",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.keyring.GetAddr(1), []cmn.Coin{}, int64(0),
+					int64(3600), []cmn.Coin{{Denom: s.network.GetDenom(), Amount: big.NewInt(100)}}, []string{},
+				}
+			},
+			func() {
+				allowance := s.getAllowance(ctx, 0, 1)
+				periodic, ok := allowance.(*sdkfeegrant.PeriodicAllowance)
+				s.Require().True(ok)
+				s.Require().Equal(time.Hour, periodic.Period)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.network.GetDenom(), 100)), periodic.PeriodSpendLimit)
+				s.Require().Equal(periodic.PeriodSpendLimit, periodic.PeriodCanSpend)
+				s.Require().Equal(ctx.BlockTime().Add(time.Hour).Unix(), periodic.PeriodReset.Unix())
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			stDB := s.network.GetStateDB()
+			origin := s.keyring.GetAddr(0)
+
+			contract := vm.NewContract(vm.AccountRef(origin), s.precompile, big.NewInt(0), 200_000)
+
+			bz, err := s.precompile.GrantPeriodicAllowance(ctx, origin, contract, stDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				success, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				s.Require().Equal(true, success[0])
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevokeAllowance() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[feegrant.RevokeAllowanceMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() (origin, caller common.Address, args []interface{})
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - caller is not the granter",
+			func() (common.Address, common.Address, []interface{}) {
+				s.grantAllowance(0, 1)
+				return s.keyring.GetAddr(1), s.keyring.GetAddr(1), []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func() {},
+			true,
+			"is not the same as granter address",
+		},
+		{
+			"fail - granter is the origin of a call made by a contract",
+			func() (common.Address, common.Address, []interface{}) {
+				s.grantAllowance(0, 1)
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(2), []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func() {},
+			true,
+			"is not the same as granter address",
+		},
+		{
+			"fail - allowance not found",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func() {},
+			true,
+			"fee-grant not found",
+		},
+		{
+			"success - the origin revokes an allowance",
+			func() (common.Address, common.Address, []interface{}) {
+				s.grantAllowance(0, 1)
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1)}
+			},
+			func() {
+				_, err := s.network.App.FeeGrantKeeper.GetAllowance(ctx, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1))
+				s.Require().ErrorContains(err, "fee-grant not found")
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			stDB := s.network.GetStateDB()
+
+			origin, caller, args := tc.malleate()
+			contract := vm.NewContract(vm.AccountRef(caller), s.precompile, big.NewInt(0), 200_000)
+
+			bz, err := s.precompile.RevokeAllowance(ctx, origin, contract, stDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				success, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				s.Require().Equal(true, success[0])
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+// grantAllowance grants an unlimited basic fee allowance from the keyring
+// account with the granter index to the one with the grantee index.
+func (s *PrecompileTestSuite) grantAllowance(granter, grantee int) {
+	err := s.network.App.FeeGrantKeeper.GrantAllowance(
+		s.network.GetContext(),
+		s.keyring.GetAccAddr(granter),
+		s.keyring.GetAccAddr(grantee),
+		&sdkfeegrant.BasicAllowance{},
+	)
+	s.Require().NoError(err)
+}
+
+// getAllowance returns the fee allowance granted by the keyring account with the
+// granter index to the one with the grantee index.
+func (s *PrecompileTestSuite) getAllowance(ctx sdk.Context, granter, grantee int) sdkfeegrant.FeeAllowanceI {
+	allowance, err := s.network.App.FeeGrantKeeper.GetAllowance(ctx, s.keyring.GetAccAddr(granter), s.keyring.GetAccAddr(grantee))
+	s.Require().NoError(err)
+	return allowance
+}
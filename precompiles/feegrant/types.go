@@ -0,0 +1,357 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package feegrant
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+)
+
+// EventGrantAllowance defines the event data for the GrantAllowance transaction.
+type EventGrantAllowance struct {
+	Granter common.Address
+	Grantee common.Address
+}
+
+// EventRevokeAllowance defines the event data for the RevokeAllowance transaction.
+type EventRevokeAllowance struct {
+	Granter common.Address
+	Grantee common.Address
+}
+
+// AllowanceData defines a fee allowance granted by a granter to a grantee, with
+// the times expressed as unix timestamps in seconds and the period in seconds.
+type AllowanceData struct {
+	Granter          common.Address `abi:"granter"`
+	Grantee          common.Address `abi:"grantee"`
+	Allowance        string         `abi:"allowance"`
+	SpendLimit       []cmn.Coin     `abi:"spendLimit"`
+	Expiration       int64          `abi:"expiration"`
+	Period           int64          `abi:"period"`
+	PeriodSpendLimit []cmn.Coin     `abi:"periodSpendLimit"`
+	PeriodCanSpend   []cmn.Coin     `abi:"periodCanSpend"`
+	PeriodReset      int64          `abi:"periodReset"`
+	AllowedMessages  []string       `abi:"allowedMessages"`
+}
+
+// AllowancesInput defines the input for the Allowances query.
+type AllowancesInput struct {
+	Grantee    common.Address    `abi:"grantee"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// AllowancesByGranterInput defines the input for the AllowancesByGranter query.
+type AllowancesByGranterInput struct {
+	Granter    common.Address    `abi:"granter"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// AllowancesOutput defines the output for the allowances queries.
+type AllowancesOutput struct {
+	Allowances   []AllowanceData
+	PageResponse query.PageResponse
+}
+
+// NewMsgGrantAllowance creates a new MsgGrantAllowance instance granting a basic
+// fee allowance and returns the granter and grantee addresses.
+func NewMsgGrantAllowance(method *abi.Method, args []interface{}) (*feegrant.MsgGrantAllowance, common.Address, common.Address, error) {
+	if len(args) != 5 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	basic, err := newBasicAllowance(method, args[2], args[3])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg, err := newMsgGrantAllowance(basic, args[4], granter, grantee)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgGrantPeriodicAllowance creates a new MsgGrantAllowance instance granting
+// a periodic fee allowance, whose first period starts at the given block time,
+// and returns the granter and grantee addresses.
+func NewMsgGrantPeriodicAllowance(
+	method *abi.Method,
+	blockTime time.Time,
+	args []interface{},
+) (*feegrant.MsgGrantAllowance, common.Address, common.Address, error) {
+	if len(args) != 7 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 7, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	basic, err := newBasicAllowance(method, args[2], args[3])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	period, ok := args[4].(int64)
+	if !ok || period <= 0 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidPeriod, args[4])
+	}
+
+	periodSpendLimit, err := cmn.UnpackCoins(method, 5, args[5])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidSpendLimit, err)
+	}
+
+	periodDuration := time.Duration(period) * time.Second
+	periodic := &feegrant.PeriodicAllowance{
+		Basic:            *basic,
+		Period:           periodDuration,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		PeriodReset:      blockTime.Add(periodDuration),
+	}
+
+	msg, err := newMsgGrantAllowance(periodic, args[6], granter, grantee)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgRevokeAllowance creates a new MsgRevokeAllowance instance and returns
+// the granter and grantee addresses.
+func NewMsgRevokeAllowance(args []interface{}) (*feegrant.MsgRevokeAllowance, common.Address, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg := feegrant.NewMsgRevokeAllowance(granter.Bytes(), grantee.Bytes())
+	return &msg, granter, grantee, nil
+}
+
+// ParseAllowanceArgs parses the arguments for the Allowance query.
+func ParseAllowanceArgs(args []interface{}) (*feegrant.QueryAllowanceRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	granter, grantee, err := parseGranterGrantee(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feegrant.QueryAllowanceRequest{
+		Granter: sdk.AccAddress(granter.Bytes()).String(),
+		Grantee: sdk.AccAddress(grantee.Bytes()).String(),
+	}, nil
+}
+
+// ParseAllowancesArgs parses the arguments for the Allowances query.
+func ParseAllowancesArgs(method *abi.Method, args []interface{}) (*feegrant.QueryAllowancesRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesInput: %s", err)
+	}
+
+	if input.Grantee == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGrantee, input.Grantee)
+	}
+
+	return &feegrant.QueryAllowancesRequest{
+		Grantee:    sdk.AccAddress(input.Grantee.Bytes()).String(),
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// ParseAllowancesByGranterArgs parses the arguments for the AllowancesByGranter query.
+func ParseAllowancesByGranterArgs(method *abi.Method, args []interface{}) (*feegrant.QueryAllowancesByGranterRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input AllowancesByGranterInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllowancesByGranterInput: %s", err)
+	}
+
+	if input.Granter == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidGranter, input.Granter)
+	}
+
+	return &feegrant.QueryAllowancesByGranterRequest{
+		Granter:    sdk.AccAddress(input.Granter.Bytes()).String(),
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromGrants populates the AllowancesOutput from the given fee allowance grants.
+func (o *AllowancesOutput) FromGrants(
+	cdc codec.Codec,
+	grants []*feegrant.Grant,
+	pageRes *query.PageResponse,
+) (*AllowancesOutput, error) {
+	o.Allowances = make([]AllowanceData, len(grants))
+	for i, grant := range grants {
+		allowance, err := NewAllowanceData(cdc, grant)
+		if err != nil {
+			return nil, err
+		}
+		o.Allowances[i] = allowance
+	}
+
+	if pageRes != nil {
+		o.PageResponse = query.PageResponse{
+			NextKey: pageRes.NextKey,
+			Total:   pageRes.Total,
+		}
+	}
+
+	return o, nil
+}
+
+// NewAllowanceData converts a fee allowance grant to its ABI representation.
+// The basic, periodic and allowed messages allowances are supported.
+func NewAllowanceData(cdc codec.Codec, grant *feegrant.Grant) (AllowanceData, error) {
+	granterAddr, err := sdk.AccAddressFromBech32(grant.Granter)
+	if err != nil {
+		return AllowanceData{}, fmt.Errorf(ErrInvalidGranter, grant.Granter)
+	}
+
+	granteeAddr, err := sdk.AccAddressFromBech32(grant.Grantee)
+	if err != nil {
+		return AllowanceData{}, fmt.Errorf(ErrInvalidGrantee, grant.Grantee)
+	}
+
+	var allowance feegrant.FeeAllowanceI
+	if err := cdc.UnpackAny(grant.Allowance, &allowance); err != nil {
+		return AllowanceData{}, err
+	}
+
+	data := AllowanceData{
+		Granter:         common.BytesToAddress(granterAddr),
+		Grantee:         common.BytesToAddress(granteeAddr),
+		Allowance:       grant.Allowance.TypeUrl,
+		AllowedMessages: []string{},
+	}
+
+	// NOTE: the allowed messages allowance wraps a basic or periodic allowance
+	if allowedMsgAllowance, ok := allowance.(*feegrant.AllowedMsgAllowance); ok {
+		data.AllowedMessages = allowedMsgAllowance.AllowedMessages
+		if allowance, err = allowedMsgAllowance.GetAllowance(); err != nil {
+			return AllowanceData{}, err
+		}
+	}
+
+	var basic feegrant.BasicAllowance
+	switch a := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		basic = *a
+	case *feegrant.PeriodicAllowance:
+		basic = a.Basic
+		data.Period = int64(a.Period / time.Second)
+		data.PeriodSpendLimit = cmn.NewCoinsResponse(a.PeriodSpendLimit)
+		data.PeriodCanSpend = cmn.NewCoinsResponse(a.PeriodCanSpend)
+		data.PeriodReset = a.PeriodReset.Unix()
+	default:
+		return AllowanceData{}, fmt.Errorf(ErrUnknownAllowance, allowance)
+	}
+
+	data.SpendLimit = cmn.NewCoinsResponse(basic.SpendLimit)
+	if basic.Expiration != nil {
+		data.Expiration = basic.Expiration.Unix()
+	}
+	if data.PeriodSpendLimit == nil {
+		data.PeriodSpendLimit = []cmn.Coin{}
+		data.PeriodCanSpend = []cmn.Coin{}
+	}
+
+	return data, nil
+}
+
+// newBasicAllowance creates a new basic fee allowance from the spend limit and
+// expiration arguments. An expiration of zero means the allowance doesn't expire.
+func newBasicAllowance(method *abi.Method, spendLimitArg, expirationArg interface{}) (*feegrant.BasicAllowance, error) {
+	spendLimit, err := cmn.UnpackCoins(method, 2, spendLimitArg)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidSpendLimit, err)
+	}
+
+	expiration, ok := expirationArg.(int64)
+	if !ok || expiration < 0 {
+		return nil, fmt.Errorf(ErrInvalidExpiration, expirationArg)
+	}
+
+	basic := &feegrant.BasicAllowance{SpendLimit: spendLimit}
+	if expiration != 0 {
+		expirationTime := time.Unix(expiration, 0).UTC()
+		basic.Expiration = &expirationTime
+	}
+
+	return basic, nil
+}
+
+// newMsgGrantAllowance creates a new MsgGrantAllowance instance with the given
+// allowance, restricted to the allowed messages if any.
+func newMsgGrantAllowance(
+	allowance feegrant.FeeAllowanceI,
+	allowedMessagesArg interface{},
+	granter, grantee common.Address,
+) (*feegrant.MsgGrantAllowance, error) {
+	allowedMessages, ok := allowedMessagesArg.([]string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidAllowedMessages, allowedMessagesArg)
+	}
+
+	if len(allowedMessages) > 0 {
+		var err error
+		allowance, err = feegrant.NewAllowedMsgAllowance(allowance, allowedMessages)
+		if err != nil {
+			return nil, fmt.Errorf(ErrInvalidAllowedMessages, err)
+		}
+	}
+
+	return feegrant.NewMsgGrantAllowance(allowance, granter.Bytes(), grantee.Bytes())
+}
+
+// parseGranterGrantee parses the granter and grantee addresses, which are the
+// first two arguments of the feegrant transactions and of the Allowance query.
+func parseGranterGrantee(args []interface{}) (granter, grantee common.Address, err error) {
+	granter, ok := args[0].(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGranter, args[0])
+	}
+
+	grantee, ok = args[1].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGrantee, args[1])
+	}
+
+	return granter, grantee, nil
+}
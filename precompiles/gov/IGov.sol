@@ -87,8 +87,60 @@ interface IGov {
     /// @param options the options for voter
     event VoteWeighted(address indexed voter, uint64 proposalId, WeightedVoteOption[] options);
 
+    /// @dev SubmitProposal defines an Event emitted when a proposal is submitted.
+    /// @param proposer the address of the proposer
+    /// @param proposalId the id of the proposal
+    event SubmitProposal(address indexed proposer, uint64 proposalId);
+
+    /// @dev Deposit defines an Event emitted when a deposit is made to a proposal.
+    /// @param depositor the address of the depositor
+    /// @param proposalId the id of the proposal
+    /// @param amount the amount of the deposit
+    event Deposit(address indexed depositor, uint64 proposalId, Coin[] amount);
+
+    /// @dev CancelProposal defines an Event emitted when a proposal is canceled.
+    /// @param proposer the address of the proposer
+    /// @param proposalId the id of the proposal
+    event CancelProposal(address indexed proposer, uint64 proposalId);
+
     /// TRANSACTIONS
 
+    /// @dev submitProposal defines a method to submit a new proposal.
+    /// @param proposer The address of the proposer
+    /// @param jsonProposal The JSON-encoded proposal, with the same format used by
+    /// the gov CLI: the messages are encoded as their JSON representation
+    /// including the type URL, along with the metadata, title, summary and
+    /// expedited fields
+    /// @param deposit The initial deposit of the proposal
+    /// @return proposalId The id of the submitted proposal
+    function submitProposal(
+        address proposer,
+        bytes calldata jsonProposal,
+        Coin[] calldata deposit
+    ) external returns (uint64 proposalId);
+
+    /// @dev deposit defines a method to add a deposit to a proposal.
+    /// @param depositor The address of the depositor
+    /// @param proposalId The id of the proposal
+    /// @param amount The amount of the deposit
+    /// @return success Whether the transaction was successful or not
+    function deposit(
+        address depositor,
+        uint64 proposalId,
+        Coin[] calldata amount
+    ) external returns (bool success);
+
+    /// @dev cancelProposal defines a method to cancel a proposal. Only the
+    /// proposer can cancel a proposal, and a part of its deposit is charged
+    /// according to the proposal cancel ratio of the gov params.
+    /// @param proposer The address of the proposer
+    /// @param proposalId The id of the proposal
+    /// @return success Whether the transaction was successful or not
+    function cancelProposal(
+        address proposer,
+        uint64 proposalId
+    ) external returns (bool success);
+
     /// @dev vote defines a method to add a vote on a specific proposal.
     /// @param voter The address of the voter
     /// @param proposalId the proposal of id
@@ -14,12 +14,93 @@ import (
 )
 
 const (
+	// EventTypeSubmitProposal defines the event type for the gov SubmitProposalMethod transaction.
+	EventTypeSubmitProposal = "SubmitProposal"
+	// EventTypeDeposit defines the event type for the gov DepositMethod transaction.
+	EventTypeDeposit = "Deposit"
+	// EventTypeCancelProposal defines the event type for the gov CancelProposalMethod transaction.
+	EventTypeCancelProposal = "CancelProposal"
 	// EventTypeVote defines the event type for the gov VoteMethod transaction.
 	EventTypeVote = "Vote"
 	// EventTypeVoteWeighted defines the event type for the gov VoteWeightedMethod transaction.
 	EventTypeVoteWeighted = "VoteWeighted"
 )
 
+// EmitSubmitProposalEvent creates a new event emitted on a SubmitProposal transaction.
+func (p Precompile) EmitSubmitProposalEvent(ctx sdk.Context, stateDB vm.StateDB, proposerAddress common.Address, proposalID uint64) error {
+	return p.emitProposalEvent(ctx, stateDB, EventTypeSubmitProposal, proposerAddress, proposalID)
+}
+
+// EmitCancelProposalEvent creates a new event emitted on a CancelProposal transaction.
+func (p Precompile) EmitCancelProposalEvent(ctx sdk.Context, stateDB vm.StateDB, proposerAddress common.Address, proposalID uint64) error {
+	return p.emitProposalEvent(ctx, stateDB, EventTypeCancelProposal, proposerAddress, proposalID)
+}
+
+// emitProposalEvent creates a new event with the proposer address as indexed
+// topic and the proposal id as data.
+func (p Precompile) emitProposalEvent(ctx sdk.Context, stateDB vm.StateDB, eventType string, proposerAddress common.Address, proposalID uint64) error {
+	// Prepare the event topics
+	event := p.ABI.Events[eventType]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(proposerAddress)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[1]}
+	packed, err := arguments.Pack(proposalID)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitDepositEvent creates a new event emitted on a Deposit transaction.
+func (p Precompile) EmitDepositEvent(ctx sdk.Context, stateDB vm.StateDB, depositorAddress common.Address, proposalID uint64, amount sdk.Coins) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeDeposit]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(depositorAddress)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
+	packed, err := arguments.Pack(proposalID, cmn.NewCoinsResponse(amount))
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
 // EmitVoteEvent creates a new event emitted on a Vote transaction.
 func (p Precompile) EmitVoteEvent(ctx sdk.Context, stateDB vm.StateDB, voterAddress common.Address, proposalID uint64, option int32) error {
 	// Prepare the event topics
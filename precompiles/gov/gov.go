@@ -9,6 +9,7 @@ import (
 
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
@@ -32,6 +33,7 @@ var f embed.FS
 type Precompile struct {
 	cmn.Precompile
 	govKeeper govkeeper.Keeper
+	cdc       codec.Codec
 }
 
 // LoadABI loads the gov ABI from the embedded abi.json file
@@ -45,6 +47,7 @@ func LoadABI() (abi.ABI, error) {
 func NewPrecompile(
 	govKeeper govkeeper.Keeper,
 	authzKeeper authzkeeper.Keeper,
+	cdc codec.Codec,
 ) (*Precompile, error) {
 	abi, err := LoadABI()
 	if err != nil {
@@ -60,6 +63,7 @@ func NewPrecompile(
 			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
 		},
 		govKeeper: govKeeper,
+		cdc:       cdc,
 	}
 
 	// SetAddress defines the address of the gov precompiled contract.
@@ -102,6 +106,12 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 
 	switch method.Name {
 	// gov transactions
+	case SubmitProposalMethod:
+		bz, err = p.SubmitProposal(ctx, evm.Origin, contract, stateDB, method, args)
+	case DepositMethod:
+		bz, err = p.Deposit(ctx, evm.Origin, contract, stateDB, method, args)
+	case CancelProposalMethod:
+		bz, err = p.CancelProposal(ctx, evm.Origin, contract, stateDB, method, args)
 	case VoteMethod:
 		bz, err = p.Vote(ctx, evm.Origin, contract, stateDB, method, args)
 	case VoteWeightedMethod:
@@ -139,17 +149,22 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		return nil, err
 	}
 
+	p.CapturePrecompile(evm, ctx, snapshot, method, args)
+
 	return bz, nil
 }
 
 // IsTransaction checks if the given method name corresponds to a transaction or query.
 //
 // Available gov transactions are:
+//   - SubmitProposal
+//   - Deposit
+//   - CancelProposal
 //   - Vote
 //   - VoteWeighted
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
-	case VoteMethod, VoteWeightedMethod:
+	case SubmitProposalMethod, DepositMethod, CancelProposalMethod, VoteMethod, VoteWeightedMethod:
 		return true
 	default:
 		return false
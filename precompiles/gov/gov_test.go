@@ -3,12 +3,15 @@ package gov_test
 import (
 	"math/big"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/evmos/evmos/v20/app"
 	"github.com/evmos/evmos/v20/precompiles/gov"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	"github.com/evmos/evmos/v20/x/evm/statedb"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
@@ -20,6 +23,21 @@ func (s *PrecompileTestSuite) TestIsTransaction() {
 		method abi.Method
 		isTx   bool
 	}{
+		{
+			gov.SubmitProposalMethod,
+			s.precompile.Methods[gov.SubmitProposalMethod],
+			true,
+		},
+		{
+			gov.DepositMethod,
+			s.precompile.Methods[gov.DepositMethod],
+			true,
+		},
+		{
+			gov.CancelProposalMethod,
+			s.precompile.Methods[gov.CancelProposalMethod],
+			true,
+		},
 		{
 			gov.VoteMethod,
 			s.precompile.Methods[gov.VoteMethod],
@@ -138,3 +156,68 @@ func (s *PrecompileTestSuite) TestRun() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestRunCancelProposalMirrorsBalances() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	const proposalID uint64 = 1
+
+	proposer, depositor := s.keyring.GetKey(0), s.keyring.GetKey(1)
+	cancelDest := utiltx.GenerateAddress()
+
+	// add a second depositor to the proposal and send the cancellation charges
+	// to an account instead of burning them
+	deposit := sdk.NewCoins(sdk.NewCoin(s.network.GetDenom(), math.NewInt(300)))
+	_, err := s.network.App.GovKeeper.AddDeposit(ctx, proposalID, depositor.AccAddr, deposit)
+	s.Require().NoError(err)
+
+	params, err := s.network.App.GovKeeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	params.ProposalCancelRatio = "0.5"
+	params.ProposalCancelDest = sdk.AccAddress(cancelDest.Bytes()).String()
+	s.Require().NoError(s.network.App.GovKeeper.Params.Set(ctx, params))
+
+	input, err := s.precompile.Pack(gov.CancelProposalMethod, proposer.Addr, proposalID)
+	s.Require().NoError(err)
+
+	// the proposer is a contract called by the depositor
+	contract := vm.NewPrecompile(vm.AccountRef(proposer.Addr), s.precompile, big.NewInt(0), 1_000_000)
+	contract.Input = input
+	contractAddr := contract.Address()
+
+	txArgs := evmtypes.EvmTxArgs{
+		ChainID:   evmtypes.GetEthChainConfig().ChainID,
+		Nonce:     0,
+		To:        &contractAddr,
+		GasLimit:  1_000_000,
+		GasPrice:  app.MainnetMinGasPrices.BigInt(),
+		GasFeeCap: s.network.App.EvmKeeper.GetBaseFee(ctx),
+		GasTipCap: big.NewInt(1),
+		Accesses:  &ethtypes.AccessList{},
+	}
+	msg, err := s.factory.GenerateGethCoreMsg(depositor.Priv, txArgs)
+	s.Require().NoError(err)
+
+	cfg, err := s.network.App.EvmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+	s.Require().NoError(err)
+
+	stDB := statedb.New(ctx, s.network.App.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	evm := s.network.App.EvmKeeper.NewEVM(ctx, msg, cfg, nil, stDB)
+
+	// load the accounts on the stateDB before the call
+	proposerBalance := stDB.GetBalance(proposer.Addr)
+	depositorBalance := stDB.GetBalance(depositor.Addr)
+	s.Require().Zero(stDB.GetBalance(cancelDest).Sign())
+
+	_, err = s.precompile.Run(evm, contract, false)
+	s.Require().NoError(err)
+
+	// both refunds and the charges of the two deposits are mirrored
+	s.Require().Equal(new(big.Int).Add(proposerBalance, big.NewInt(50)), stDB.GetBalance(proposer.Addr))
+	s.Require().Equal(new(big.Int).Add(depositorBalance, big.NewInt(150)), stDB.GetBalance(depositor.Addr))
+	s.Require().Equal(big.NewInt(200), stDB.GetBalance(cancelDest))
+
+	s.Require().NoError(stDB.Commit())
+	ctx = s.network.GetContext()
+	s.Require().Equal(int64(200), s.network.App.BankKeeper.GetBalance(ctx, cancelDest.Bytes(), s.network.GetDenom()).Amount.Int64())
+}
@@ -6,22 +6,224 @@ package gov
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v20/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/utils"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
 const (
+	// SubmitProposalMethod defines the ABI method name for the gov SubmitProposal transaction.
+	SubmitProposalMethod = "submitProposal"
+	// DepositMethod defines the ABI method name for the gov Deposit transaction.
+	DepositMethod = "deposit"
+	// CancelProposalMethod defines the ABI method name for the gov CancelProposal transaction.
+	CancelProposalMethod = "cancelProposal"
 	// VoteMethod defines the ABI method name for the gov Vote transaction.
 	VoteMethod = "vote"
 	// VoteWeightedMethod defines the ABI method name for the gov VoteWeighted transaction.
 	VoteWeightedMethod = "voteWeighted"
 )
 
+// SubmitProposal defines a method to submit a proposal with an initial deposit.
+func (p *Precompile) SubmitProposal(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, proposerHexAddr, err := NewMsgSubmitProposal(method, p.cdc, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the contract is the proposer, we don't need an origin check
+	// Otherwise check if the origin matches the proposer address and
+	// has authorized the calling contract to submit proposals on its behalf
+	if contract.CallerAddress != proposerHexAddr {
+		if origin != proposerHexAddr {
+			return nil, fmt.Errorf(ErrDifferentOriginProposer, origin.String(), proposerHexAddr.String())
+		}
+		if err := p.acceptGrant(ctx, contract.CallerAddress, origin, msg); err != nil {
+			return nil, err
+		}
+	}
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	res, err := msgSrv.SubmitProposal(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitSubmitProposalEvent(ctx, stateDB, proposerHexAddr, res.ProposalId); err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB
+	// when calling the precompile from a smart contract
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	if contract.CallerAddress != origin {
+		p.setDepositBalanceChangeEntry(proposerHexAddr, msg.InitialDeposit)
+	}
+
+	return method.Outputs.Pack(res.ProposalId)
+}
+
+// Deposit defines a method to add a deposit on a specific proposal.
+func (p *Precompile) Deposit(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, depositorHexAddr, err := NewMsgDeposit(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the contract is the depositor, we don't need an origin check
+	// Otherwise check if the origin matches the depositor address and
+	// has authorized the calling contract to deposit on its behalf
+	if contract.CallerAddress != depositorHexAddr {
+		if origin != depositorHexAddr {
+			return nil, fmt.Errorf(ErrDifferentOriginDepositor, origin.String(), depositorHexAddr.String())
+		}
+		if err := p.acceptGrant(ctx, contract.CallerAddress, origin, msg); err != nil {
+			return nil, err
+		}
+	}
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	if _, err = msgSrv.Deposit(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitDepositEvent(ctx, stateDB, depositorHexAddr, msg.ProposalId, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB
+	// when calling the precompile from a smart contract
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	if contract.CallerAddress != origin {
+		p.setDepositBalanceChangeEntry(depositorHexAddr, msg.Amount)
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// CancelProposal defines a method to cancel a proposal. The deposits are
+// refunded to the depositors, minus the cancellation charges defined by the
+// ProposalCancelRatio param.
+func (p *Precompile) CancelProposal(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, proposerHexAddr, err := NewMsgCancelProposal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the contract is the proposer, we don't need an origin check
+	// Otherwise check if the origin matches the proposer address and
+	// has authorized the calling contract to cancel its proposals
+	if contract.CallerAddress != proposerHexAddr {
+		if origin != proposerHexAddr {
+			return nil, fmt.Errorf(ErrDifferentOriginProposer, origin.String(), proposerHexAddr.String())
+		}
+		if err := p.acceptGrant(ctx, contract.CallerAddress, origin, msg); err != nil {
+			return nil, err
+		}
+	}
+
+	// the deposits are removed on cancellation, so they need to be
+	// retrieved beforehand to compute the refunds
+	deposits, err := p.govKeeper.GetDeposits(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := p.govKeeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSrv := govkeeper.NewMsgServerImpl(&p.govKeeper)
+	if _, err = msgSrv.CancelProposal(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitCancelProposalEvent(ctx, stateDB, proposerHexAddr, msg.ProposalId); err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the refunds of the deposits and the cancellation charges are correctly
+	// mirrored to the EVM stateDB when calling the precompile from a smart contract
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	if contract.CallerAddress != origin {
+		cancelRatio, err := math.LegacyNewDecFromStr(params.ProposalCancelRatio)
+		if err != nil {
+			return nil, err
+		}
+
+		evmDenom := evmtypes.GetEVMCoinDenom()
+		charges := math.ZeroInt()
+		entries := make([]cmn.BalanceChangeEntry, 0, len(deposits)+1)
+		for _, deposit := range deposits {
+			amount := sdk.Coins(deposit.Amount).AmountOf(evmDenom)
+			// same computation as the cancellation charges of the gov keeper
+			charge := math.LegacyNewDecFromInt(amount).Mul(cancelRatio).TruncateInt()
+			charges = charges.Add(charge)
+			refund := amount.Sub(charge)
+			if !refund.IsPositive() {
+				continue
+			}
+
+			depositorHexAddr, err := utils.Bech32ToHexAddr(deposit.Depositor)
+			if err != nil {
+				return nil, err
+			}
+
+			// Need to scale the amount to 18 decimals for the EVM balance change entry
+			scaledAmt := evmtypes.ConvertAmountTo18DecimalsBigInt(refund.BigInt())
+			entries = append(entries, cmn.NewBalanceChangeEntry(depositorHexAddr, scaledAmt, cmn.Add))
+		}
+
+		// the charges are burned unless a destination address is set, in which case they're
+		// sent to it (or to the community pool, i.e. the distribution module account)
+		if charges.IsPositive() && params.ProposalCancelDest != "" {
+			destHexAddr, err := utils.Bech32ToHexAddr(params.ProposalCancelDest)
+			if err != nil {
+				return nil, err
+			}
+
+			scaledAmt := evmtypes.ConvertAmountTo18DecimalsBigInt(charges.BigInt())
+			entries = append(entries, cmn.NewBalanceChangeEntry(destHexAddr, scaledAmt, cmn.Add))
+		}
+
+		// the entries replace the previous ones, so they must be set at once
+		p.SetBalanceChangeEntries(entries...)
+	}
+
+	return method.Outputs.Pack(true)
+}
+
 // Vote defines a method to add a vote on a specific proposal.
 func (p Precompile) Vote(
 	ctx sdk.Context,
@@ -87,3 +289,44 @@ func (p Precompile) VoteWeighted(
 
 	return method.Outputs.Pack(true)
 }
+
+// acceptGrant checks that the grantee is authorized by the granter to execute
+// the given message on its behalf, and updates or removes the authorization
+// as requested by its acceptance.
+func (p Precompile) acceptGrant(ctx sdk.Context, grantee, granter common.Address, msg sdk.Msg) error {
+	msgURL := sdk.MsgTypeURL(msg)
+	msgAuthz, expiration, err := authorization.CheckAuthzExists(ctx, p.AuthzKeeper, grantee, granter, msgURL)
+	if err != nil {
+		return err
+	}
+
+	resp, err := msgAuthz.Accept(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	if !resp.Accept {
+		return fmt.Errorf(authorization.ErrAuthzNotAccepted, msgURL, grantee)
+	}
+
+	if resp.Delete {
+		return p.AuthzKeeper.DeleteGrant(ctx, grantee.Bytes(), granter.Bytes(), msgURL)
+	}
+	if resp.Updated != nil {
+		return p.AuthzKeeper.SaveGrant(ctx, grantee.Bytes(), granter.Bytes(), resp.Updated, expiration)
+	}
+	return nil
+}
+
+// setDepositBalanceChangeEntry sets the balance change entry for the amount of
+// the EVM denomination deposited by the given address.
+func (p *Precompile) setDepositBalanceChangeEntry(depositor common.Address, amount sdk.Coins) {
+	evmAmount := amount.AmountOf(evmtypes.GetEVMCoinDenom())
+	if !evmAmount.IsPositive() {
+		return
+	}
+
+	// Need to scale the amount to 18 decimals for the EVM balance change entry
+	scaledAmt := evmtypes.ConvertAmountTo18DecimalsBigInt(evmAmount.BigInt())
+	p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(depositor, scaledAmt, cmn.Sub))
+}
@@ -1,20 +1,361 @@
 package gov_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/big"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/ethereum/go-ethereum/common"
 
 	cmn "github.com/evmos/evmos/v20/precompiles/common"
 	"github.com/evmos/evmos/v20/precompiles/gov"
 	"github.com/evmos/evmos/v20/precompiles/testutil"
 	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	evmostypes "github.com/evmos/evmos/v20/types"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 )
 
+func (s *PrecompileTestSuite) TestSubmitProposal() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[gov.SubmitProposalMethod]
+	newProposerAddr := utiltx.GenerateAddress()
+	deposit := []cmn.Coin{{Denom: evmostypes.BaseDenom, Amount: big.NewInt(100)}}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - invalid proposer address",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{},
+					s.newProposalJSON(),
+					deposit,
+				}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			"invalid proposer",
+		},
+		{
+			"fail - invalid proposal json",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					[]byte("{invalid"),
+					deposit,
+				}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			"invalid proposal json",
+		},
+		{
+			"fail - invalid deposit",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					s.newProposalJSON(),
+					[]cmn.Coin{{Denom: "", Amount: big.NewInt(100)}},
+				}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			"invalid deposit",
+		},
+		{
+			"fail - using a different proposer address",
+			func() []interface{} {
+				return []interface{}{
+					newProposerAddr,
+					s.newProposalJSON(),
+					deposit,
+				}
+			},
+			func([]byte) {},
+			200000,
+			true,
+			"does not match the proposer address",
+		},
+		{
+			"success - submit proposal with an initial deposit",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					s.newProposalJSON(),
+					deposit,
+				}
+			},
+			func(bz []byte) {
+				out, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				proposalID, ok := out[0].(uint64)
+				s.Require().True(ok)
+
+				proposal, err := s.network.App.GovKeeper.Proposals.Get(ctx, proposalID)
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAccAddr(0).String(), proposal.Proposer)
+				s.Require().Equal("test prop", proposal.Title)
+				s.Require().Len(proposal.Messages, 1)
+				s.Require().Equal(sdk.NewCoins(sdk.NewCoin(evmostypes.BaseDenom, math.NewInt(100))), sdk.NewCoins(proposal.TotalDeposit...))
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			bz, err := s.precompile.SubmitProposal(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDeposit() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[gov.DepositMethod]
+	newDepositorAddr := utiltx.GenerateAddress()
+	const proposalID uint64 = 1
+	amount := []cmn.Coin{{Denom: evmostypes.BaseDenom, Amount: big.NewInt(50)}}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - invalid depositor address",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{},
+					proposalID,
+					amount,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid depositor",
+		},
+		{
+			"fail - using a different depositor address",
+			func() []interface{} {
+				return []interface{}{
+					newDepositorAddr,
+					proposalID,
+					amount,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the depositor address",
+		},
+		{
+			"fail - proposal not found",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					uint64(10),
+					amount,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"not found",
+		},
+		{
+			"success - deposit on proposal",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					proposalID,
+					amount,
+				}
+			},
+			func() {
+				deposit, err := s.network.App.GovKeeper.Deposits.Get(ctx, collections.Join(proposalID, s.keyring.GetAccAddr(0)))
+				s.Require().NoError(err)
+				s.Require().Equal(sdk.NewCoins(sdk.NewCoin(evmostypes.BaseDenom, math.NewInt(150))), sdk.NewCoins(deposit.Amount...))
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			_, err := s.precompile.Deposit(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCancelProposal() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[gov.CancelProposalMethod]
+	const proposalID uint64 = 1
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid proposer address",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{},
+					proposalID,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid proposer",
+		},
+		{
+			"fail - using a different proposer address",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(1),
+					proposalID,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the proposer address",
+		},
+		{
+			"fail - not the proposer of the proposal",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					uint64(2),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid proposer",
+		},
+		{
+			"success - cancel proposal",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					proposalID,
+				}
+			},
+			func() {
+				_, err := s.network.App.GovKeeper.Proposals.Get(ctx, proposalID)
+				s.Require().Error(err)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile, tc.gas)
+
+			_, err := s.precompile.CancelProposal(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
 func (s *PrecompileTestSuite) TestVote() {
 	var ctx sdk.Context
 	method := s.precompile.Methods[gov.VoteMethod]
@@ -276,3 +617,168 @@ func (s *PrecompileTestSuite) TestVoteWeighted() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestTxsFromContractCaller() {
+	var ctx sdk.Context
+	const proposalID uint64 = 1
+	amount := []cmn.Coin{{Denom: evmostypes.BaseDenom, Amount: big.NewInt(50)}}
+	// the contract calling the precompile within a transaction sent by the origin
+	contractAddr := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		method      string
+		msgURL      string
+		args        func() []interface{}
+		grant       bool
+		postCheck   func(bz []byte)
+		errContains string
+	}{
+		{
+			"fail - submit proposal for the origin without authorization",
+			gov.SubmitProposalMethod,
+			sdk.MsgTypeURL(&govv1.MsgSubmitProposal{}),
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.newProposalJSON(), amount}
+			},
+			false,
+			func([]byte) {},
+			"does not exist or is expired",
+		},
+		{
+			"success - submit proposal for the origin with authorization",
+			gov.SubmitProposalMethod,
+			sdk.MsgTypeURL(&govv1.MsgSubmitProposal{}),
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.newProposalJSON(), amount}
+			},
+			true,
+			func(bz []byte) {
+				out, err := s.precompile.Methods[gov.SubmitProposalMethod].Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				proposalID, ok := out[0].(uint64)
+				s.Require().True(ok)
+
+				proposal, err := s.network.App.GovKeeper.Proposals.Get(ctx, proposalID)
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAccAddr(0).String(), proposal.Proposer)
+			},
+			"",
+		},
+		{
+			"fail - deposit for the origin without authorization",
+			gov.DepositMethod,
+			sdk.MsgTypeURL(&govv1.MsgDeposit{}),
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), proposalID, amount}
+			},
+			false,
+			func([]byte) {},
+			"does not exist or is expired",
+		},
+		{
+			"success - deposit for the origin with authorization",
+			gov.DepositMethod,
+			sdk.MsgTypeURL(&govv1.MsgDeposit{}),
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), proposalID, amount}
+			},
+			true,
+			func([]byte) {
+				deposit, err := s.network.App.GovKeeper.Deposits.Get(ctx, collections.Join(proposalID, s.keyring.GetAccAddr(0)))
+				s.Require().NoError(err)
+				s.Require().Equal(sdk.NewCoins(sdk.NewCoin(evmostypes.BaseDenom, math.NewInt(150))), sdk.NewCoins(deposit.Amount...))
+			},
+			"",
+		},
+		{
+			"fail - cancel proposal of the origin without authorization",
+			gov.CancelProposalMethod,
+			sdk.MsgTypeURL(&govv1.MsgCancelProposal{}),
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), proposalID}
+			},
+			false,
+			func([]byte) {},
+			"does not exist or is expired",
+		},
+		{
+			"success - cancel proposal of the origin with authorization",
+			gov.CancelProposalMethod,
+			sdk.MsgTypeURL(&govv1.MsgCancelProposal{}),
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), proposalID}
+			},
+			true,
+			func([]byte) {
+				_, err := s.network.App.GovKeeper.Proposals.Get(ctx, proposalID)
+				s.Require().Error(err)
+			},
+			"",
+		},
+		{
+			"fail - cancel proposal of another account than the origin",
+			gov.CancelProposalMethod,
+			sdk.MsgTypeURL(&govv1.MsgCancelProposal{}),
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), uint64(2)}
+			},
+			true,
+			func([]byte) {},
+			"does not match the proposer address",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			if tc.grant {
+				err := s.network.App.AuthzKeeper.SaveGrant(
+					ctx, contractAddr.Bytes(), s.keyring.GetAccAddr(0), sdkauthz.NewGenericAuthorization(tc.msgURL), nil,
+				)
+				s.Require().NoError(err)
+			}
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, contractAddr, s.precompile, 200000)
+
+			method := s.precompile.Methods[tc.method]
+			var (
+				bz  []byte
+				err error
+			)
+			switch tc.method {
+			case gov.SubmitProposalMethod:
+				bz, err = s.precompile.SubmitProposal(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.args())
+			case gov.DepositMethod:
+				bz, err = s.precompile.Deposit(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.args())
+			case gov.CancelProposalMethod:
+				bz, err = s.precompile.CancelProposal(ctx, s.keyring.GetAddr(0), contract, s.network.GetStateDB(), &method, tc.args())
+			}
+
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+// newProposalJSON returns the JSON-encoded proposal with the test proposal messages.
+func (s *PrecompileTestSuite) newProposalJSON() []byte {
+	msgJSON, err := s.network.App.AppCodec().MarshalInterfaceJSON(TestProposalMsgs[0])
+	s.Require().NoError(err)
+
+	bz, err := json.Marshal(gov.ProposalJSON{
+		Messages: []json.RawMessage{msgJSON},
+		Metadata: "ipfs://CID",
+		Title:    "test prop",
+		Summary:  "test prop",
+	})
+	s.Require().NoError(err)
+	return bz
+}
@@ -4,6 +4,7 @@
 package gov
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -11,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/evmos/v20/utils"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 
@@ -31,6 +33,37 @@ type EventVoteWeighted struct {
 	Options    WeightedVoteOptions
 }
 
+// EventSubmitProposal defines the event data for the SubmitProposal transaction.
+type EventSubmitProposal struct {
+	Proposer   common.Address
+	ProposalId uint64 //nolint:revive,stylecheck
+}
+
+// EventDeposit defines the event data for the Deposit transaction.
+type EventDeposit struct {
+	Depositor  common.Address
+	ProposalId uint64 //nolint:revive,stylecheck
+	Amount     []cmn.Coin
+}
+
+// EventCancelProposal defines the event data for the CancelProposal transaction.
+type EventCancelProposal struct {
+	Proposer   common.Address
+	ProposalId uint64 //nolint:revive,stylecheck
+}
+
+// ProposalJSON defines the JSON representation of a proposal submitted through
+// the SubmitProposal transaction. It matches the format used by the gov CLI,
+// where each message is the JSON representation of the message including its
+// type URL.
+type ProposalJSON struct {
+	Messages  []json.RawMessage `json:"messages,omitempty"`
+	Metadata  string            `json:"metadata"`
+	Title     string            `json:"title"`
+	Summary   string            `json:"summary"`
+	Expedited bool              `json:"expedited"`
+}
+
 // VotesInput defines the input for the Votes query.
 type VotesInput struct {
 	ProposalId uint64 //nolint:revive,stylecheck
@@ -108,6 +141,112 @@ type TallyResultData struct {
 	NoWithVeto string
 }
 
+// NewMsgSubmitProposal creates a new MsgSubmitProposal instance from the
+// JSON-encoded proposal and the initial deposit.
+func NewMsgSubmitProposal(method *abi.Method, cdc codec.Codec, args []interface{}) (*govv1.MsgSubmitProposal, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	proposerAddress, ok := args[0].(common.Address)
+	if !ok || proposerAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposer, args[0])
+	}
+
+	jsonProposal, ok := args[1].([]byte)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalJSON, args[1])
+	}
+
+	var proposal ProposalJSON
+	if err := json.Unmarshal(jsonProposal, &proposal); err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalJSON, err)
+	}
+
+	msgs := make([]sdk.Msg, len(proposal.Messages))
+	for i, anyJSON := range proposal.Messages {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON(anyJSON, &msg); err != nil {
+			return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalJSON, err)
+		}
+		msgs[i] = msg
+	}
+
+	deposit, err := cmn.UnpackCoins(method, 2, args[2])
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidDeposit, err)
+	}
+
+	msg, err := govv1.NewMsgSubmitProposal(
+		msgs,
+		deposit,
+		sdk.AccAddress(proposerAddress.Bytes()).String(),
+		proposal.Metadata,
+		proposal.Title,
+		proposal.Summary,
+		proposal.Expedited,
+	)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalJSON, err)
+	}
+
+	return msg, proposerAddress, nil
+}
+
+// NewMsgDeposit creates a new MsgDeposit instance.
+func NewMsgDeposit(method *abi.Method, args []interface{}) (*govv1.MsgDeposit, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	depositorAddress, ok := args[0].(common.Address)
+	if !ok || depositorAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidDepositor, args[0])
+	}
+
+	proposalID, ok := args[1].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalID, args[1])
+	}
+
+	amount, err := cmn.UnpackCoins(method, 2, args[2])
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidDeposit, err)
+	}
+
+	msg := &govv1.MsgDeposit{
+		ProposalId: proposalID,
+		Depositor:  sdk.AccAddress(depositorAddress.Bytes()).String(),
+		Amount:     amount,
+	}
+
+	return msg, depositorAddress, nil
+}
+
+// NewMsgCancelProposal creates a new MsgCancelProposal instance.
+func NewMsgCancelProposal(args []interface{}) (*govv1.MsgCancelProposal, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	proposerAddress, ok := args[0].(common.Address)
+	if !ok || proposerAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposer, args[0])
+	}
+
+	proposalID, ok := args[1].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidProposalID, args[1])
+	}
+
+	msg := &govv1.MsgCancelProposal{
+		ProposalId: proposalID,
+		Proposer:   sdk.AccAddress(proposerAddress.Bytes()).String(),
+	}
+
+	return msg, proposerAddress, nil
+}
+
 // NewMsgVote creates a new MsgVote instance.
 func NewMsgVote(args []interface{}) (*govv1.MsgVote, common.Address, error) {
 	if len(args) != 4 {
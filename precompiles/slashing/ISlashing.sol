@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The ISlashing contract's address.
+address constant SLASHING_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000806;
+
+/// @dev The ISlashing contract's instance.
+ISlashing constant SLASHING_CONTRACT = ISlashing(SLASHING_PRECOMPILE_ADDRESS);
+
+/// @dev SigningInfo defines a validator's signing info for monitoring their
+/// liveness activity.
+struct SigningInfo {
+    /// @dev Address of the validator, derived from its consensus public key
+    address validatorAddress;
+    /// @dev Height at which validator was first a candidate OR was unjailed
+    int64 startHeight;
+    /// @dev Index offset into signed block bit array
+    int64 indexOffset;
+    /// @dev Timestamp (unix seconds) until which validator is jailed due to liveness downtime
+    int64 jailedUntil;
+    /// @dev Whether or not a validator has been tombstoned (killed out of validator set)
+    bool tombstoned;
+    /// @dev Missed blocks counter (to avoid scanning the array every time)
+    int64 missedBlocksCounter;
+}
+
+/// @dev Params defines the parameters for the slashing module.
+struct Params {
+    /// @dev Number of blocks over which missed blocks are tallied for downtime
+    int64 signedBlocksWindow;
+    /// @dev Minimum ratio of blocks signed per window to avoid downtime slashing
+    Dec minSignedPerWindow;
+    /// @dev Duration (in seconds) of jail time for downtime
+    int64 downtimeJailDuration;
+    /// @dev Fraction of stake slashed for double signing
+    Dec slashFractionDoubleSign;
+    /// @dev Fraction of stake slashed for downtime
+    Dec slashFractionDowntime;
+}
+
+/// @author The Evmos Core Team
+/// @title Slashing Precompile Contract
+/// @dev The interface through which solidity contracts will interact with Slashing
+interface ISlashing {
+    /// @dev ValidatorUnjailed defines an Event emitted when a validator is unjailed
+    /// @param validator the address of the validator
+    event ValidatorUnjailed(address indexed validator);
+
+    /// TRANSACTIONS
+
+    /// @dev unjail defines a method to unjail a jailed validator.
+    /// @param validatorAddress The address of the validator operator
+    /// @return success Whether the transaction was successful or not
+    function unjail(address validatorAddress) external returns (bool success);
+
+    /// QUERIES
+
+    /// @dev getSigningInfo returns the signing info of a specific validator.
+    /// @param consAddress The consensus address of the validator
+    /// @return signingInfo The signing info of the validator
+    function getSigningInfo(
+        address consAddress
+    ) external view returns (SigningInfo memory signingInfo);
+
+    /// @dev getSigningInfos returns the signing infos of all validators.
+    /// @param pagination The pagination options
+    /// @return signingInfos The signing infos of the validators
+    /// @return pageResponse The pagination information
+    function getSigningInfos(
+        PageRequest calldata pagination
+    )
+        external
+        view
+        returns (
+            SigningInfo[] memory signingInfos,
+            PageResponse memory pageResponse
+        );
+
+    /// @dev getParams returns the parameters of the slashing module.
+    /// @return params The parameters of the slashing module
+    function getParams() external view returns (Params memory params);
+}
@@ -0,0 +1,40 @@
+package slashing_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/slashing"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+func (s *PrecompileTestSuite) TestValidatorUnjailedEvent() {
+	s.SetupTest()
+	s.jailValidator(0)
+
+	stDB := s.network.GetStateDB()
+	ctx := s.network.GetContext()
+	method := s.precompile.Methods[slashing.UnjailMethod]
+	validator := s.keyring.GetAddr(0)
+
+	contract := vm.NewContract(vm.AccountRef(validator), s.precompile, big.NewInt(0), 200_000)
+	_, err := s.precompile.Unjail(ctx, validator, contract, stDB, &method, []interface{}{validator})
+	s.Require().NoError(err)
+
+	s.Require().Len(stDB.Logs(), 1)
+	log := stDB.Logs()[0]
+	s.Require().Equal(log.Address, s.precompile.Address())
+
+	// Check event signature matches the one emitted
+	event := s.precompile.ABI.Events[slashing.EventTypeValidatorUnjailed]
+	s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+	s.Require().Equal(log.BlockNumber, uint64(ctx.BlockHeight())) //nolint:gosec // G115
+
+	// Check the validator is the indexed topic
+	validatorTopic, err := cmn.MakeTopic(validator)
+	s.Require().NoError(err)
+	s.Require().Equal(validatorTopic, log.Topics[1])
+}
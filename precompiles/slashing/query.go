@@ -0,0 +1,98 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package slashing
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// GetSigningInfoMethod defines the method name for the signing info precompile request.
+	GetSigningInfoMethod = "getSigningInfo"
+	// GetSigningInfosMethod defines the method name for the signing infos precompile request.
+	GetSigningInfosMethod = "getSigningInfos"
+	// GetParamsMethod defines the method name for the params precompile request.
+	GetParamsMethod = "getParams"
+)
+
+// GetSigningInfo implements the query logic for getting the signing info of a validator.
+func (p Precompile) GetSigningInfo(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseSigningInfoArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	querier := slashingkeeper.NewQuerier(p.slashingKeeper)
+	res, err := querier.SigningInfo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(SigningInfoOutput).FromResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.SigningInfo)
+}
+
+// GetSigningInfos implements the query logic for getting the signing infos of all validators.
+func (p Precompile) GetSigningInfos(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseSigningInfosArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	querier := slashingkeeper.NewQuerier(p.slashingKeeper)
+	res, err := querier.SigningInfos(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := new(SigningInfosOutput).FromResponse(res)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output.SigningInfos, output.PageResponse)
+}
+
+// GetParams implements the query logic for getting the slashing module params.
+func (p Precompile) GetParams(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	querier := slashingkeeper.NewQuerier(p.slashingKeeper)
+	res, err := querier.Params(ctx, &slashingtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	output := new(ParamsOutput).FromResponse(res)
+	return method.Outputs.Pack(output.Params)
+}
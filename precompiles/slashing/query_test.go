@@ -0,0 +1,195 @@
+package slashing_test
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/slashing"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+func (s *PrecompileTestSuite) TestGetSigningInfo() {
+	method := s.precompile.Methods[slashing.GetSigningInfoMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(signingInfo slashing.SigningInfo, consAddr common.Address)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(slashing.SigningInfo, common.Address) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid consensus address",
+			func() []interface{} {
+				return []interface{}{common.Address{}}
+			},
+			func(slashing.SigningInfo, common.Address) {},
+			true,
+			"invalid consensus address",
+		},
+		{
+			"fail - signing info not found",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0)}
+			},
+			func(slashing.SigningInfo, common.Address) {},
+			true,
+			"SigningInfo not found",
+		},
+		{
+			"success - get the signing info of a validator",
+			func() []interface{} {
+				return []interface{}{s.getConsAddr(0)}
+			},
+			func(signingInfo slashing.SigningInfo, consAddr common.Address) {
+				s.Require().Equal(consAddr, signingInfo.ValidatorAddress)
+				s.Require().False(signingInfo.Tombstoned)
+				s.Require().Zero(signingInfo.MissedBlocksCounter)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), 200_000)
+
+			args := tc.malleate()
+			bz, err := s.precompile.GetSigningInfo(ctx, &method, contract, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				var out slashing.SigningInfoOutput
+				s.Require().NoError(s.precompile.UnpackIntoInterface(&out, slashing.GetSigningInfoMethod, bz))
+				tc.postCheck(out.SigningInfo, args[0].(common.Address))
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGetSigningInfos() {
+	method := s.precompile.Methods[slashing.GetSigningInfosMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expLen      int
+		expTotal    uint64
+		expNextKey  bool
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			0, 0, false,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"success - get all the signing infos",
+			func() []interface{} {
+				return []interface{}{query.PageRequest{CountTotal: true}}
+			},
+			3, 3, false,
+			false,
+			"",
+		},
+		{
+			"success - get a page of the signing infos",
+			func() []interface{} {
+				return []interface{}{query.PageRequest{Limit: 2}}
+			},
+			2, 0, true,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+			contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), 200_000)
+
+			bz, err := s.precompile.GetSigningInfos(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				var out slashing.SigningInfosOutput
+				s.Require().NoError(s.precompile.UnpackIntoInterface(&out, slashing.GetSigningInfosMethod, bz))
+				s.Require().Len(out.SigningInfos, tc.expLen)
+				s.Require().Equal(tc.expTotal, out.PageResponse.Total)
+				s.Require().Equal(tc.expNextKey, len(out.PageResponse.NextKey) > 0)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGetParams() {
+	method := s.precompile.Methods[slashing.GetParamsMethod]
+
+	s.Run("fail - invalid number of args", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), 200_000)
+
+		_, err := s.precompile.GetParams(ctx, &method, contract, []interface{}{uint64(1)})
+		s.Require().ErrorContains(err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 0, 1))
+	})
+
+	s.Run("success - get the slashing params", func() {
+		s.SetupTest()
+		ctx := s.network.GetContext()
+		contract := vm.NewContract(vm.AccountRef(s.keyring.GetAddr(0)), s.precompile, big.NewInt(0), 200_000)
+
+		params, err := s.network.App.SlashingKeeper.GetParams(ctx)
+		s.Require().NoError(err)
+
+		bz, err := s.precompile.GetParams(ctx, &method, contract, []interface{}{})
+		s.Require().NoError(err)
+
+		var out slashing.ParamsOutput
+		s.Require().NoError(s.precompile.UnpackIntoInterface(&out, slashing.GetParamsMethod, bz))
+		s.Require().Equal(params.SignedBlocksWindow, out.Params.SignedBlocksWindow)
+		s.Require().Equal(int64(params.DowntimeJailDuration/time.Second), out.Params.DowntimeJailDuration)
+		s.Require().Equal(params.MinSignedPerWindow.BigInt(), out.Params.MinSignedPerWindow.Value)
+		s.Require().Equal(params.SlashFractionDoubleSign.BigInt(), out.Params.SlashFractionDoubleSign.Value)
+		s.Require().Equal(params.SlashFractionDowntime.BigInt(), out.Params.SlashFractionDowntime.Value)
+		s.Require().Equal(uint8(math.LegacyPrecision), out.Params.SlashFractionDowntime.Precision)
+	})
+}
+
+// getConsAddr returns the consensus address of the validator operated by the
+// keyring account with the given index.
+func (s *PrecompileTestSuite) getConsAddr(index int) common.Address {
+	consAddr, err := s.getValidator(index).GetConsAddr()
+	s.Require().NoError(err)
+	return common.BytesToAddress(consAddr)
+}
@@ -0,0 +1,47 @@
+package slashing_test
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/evmos/evmos/v20/precompiles/slashing"
+)
+
+func (s *PrecompileTestSuite) TestIsTransaction() {
+	testCases := []struct {
+		name   string
+		method abi.Method
+		isTx   bool
+	}{
+		{
+			slashing.UnjailMethod,
+			s.precompile.Methods[slashing.UnjailMethod],
+			true,
+		},
+		{
+			slashing.GetSigningInfoMethod,
+			s.precompile.Methods[slashing.GetSigningInfoMethod],
+			false,
+		},
+		{
+			slashing.GetSigningInfosMethod,
+			s.precompile.Methods[slashing.GetSigningInfosMethod],
+			false,
+		},
+		{
+			slashing.GetParamsMethod,
+			s.precompile.Methods[slashing.GetParamsMethod],
+			false,
+		},
+		{
+			"invalid",
+			abi.Method{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Require().Equal(tc.isTx, s.precompile.IsTransaction(&tc.method))
+		})
+	}
+}
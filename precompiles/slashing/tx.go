@@ -0,0 +1,54 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package slashing
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+const (
+	// UnjailMethod defines the ABI method name for the slashing Unjail transaction.
+	UnjailMethod = "unjail"
+)
+
+// Unjail defines a method to unjail a jailed validator. The validator operator
+// must be either the origin of the transaction or the calling contract.
+func (p Precompile) Unjail(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, validatorHexAddr, err := NewMsgUnjail(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the contract is the validator operator, we don't need an origin check
+	// Otherwise check if the origin matches the validator operator address
+	isContractValidator := contract.CallerAddress == validatorHexAddr && contract.CallerAddress != origin
+	if !isContractValidator && origin != validatorHexAddr {
+		return nil, fmt.Errorf(ErrDifferentOriginFromValidator, origin.String(), validatorHexAddr.String())
+	}
+
+	msgSrv := slashingkeeper.NewMsgServerImpl(p.slashingKeeper)
+	if _, err = msgSrv.Unjail(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitValidatorUnjailedEvent(ctx, stateDB, validatorHexAddr); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
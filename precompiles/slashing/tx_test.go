@@ -0,0 +1,140 @@
+package slashing_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+	"github.com/evmos/evmos/v20/precompiles/slashing"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+func (s *PrecompileTestSuite) TestUnjail() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[slashing.UnjailMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() (origin, caller common.Address, args []interface{})
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid validator address",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{common.Address{}}
+			},
+			func() {},
+			true,
+			"invalid validator address",
+		},
+		{
+			"fail - origin is not the validator operator",
+			func() (common.Address, common.Address, []interface{}) {
+				s.jailValidator(1)
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{s.keyring.GetAddr(1)}
+			},
+			func() {},
+			true,
+			"is not the same as validator operator address",
+		},
+		{
+			"fail - validator is not jailed",
+			func() (common.Address, common.Address, []interface{}) {
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{s.keyring.GetAddr(0)}
+			},
+			func() {},
+			true,
+			"validator not jailed",
+		},
+		{
+			"success - the origin unjails its validator",
+			func() (common.Address, common.Address, []interface{}) {
+				s.jailValidator(0)
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(0), []interface{}{s.keyring.GetAddr(0)}
+			},
+			func() {
+				s.Require().False(s.getValidator(0).IsJailed())
+			},
+			false,
+			"",
+		},
+		{
+			"success - the calling contract unjails its validator",
+			func() (common.Address, common.Address, []interface{}) {
+				s.jailValidator(1)
+				return s.keyring.GetAddr(0), s.keyring.GetAddr(1), []interface{}{s.keyring.GetAddr(1)}
+			},
+			func() {
+				s.Require().False(s.getValidator(1).IsJailed())
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			stDB := s.network.GetStateDB()
+
+			origin, caller, args := tc.malleate()
+			contract := vm.NewContract(vm.AccountRef(caller), s.precompile, big.NewInt(0), 200_000)
+
+			bz, err := s.precompile.Unjail(ctx, origin, contract, stDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				success, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				s.Require().Equal(true, success[0])
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+// getValidator returns the validator operated by the keyring account with the given index.
+func (s *PrecompileTestSuite) getValidator(index int) stakingtypes.Validator {
+	ctx := s.network.GetContext()
+	validator, err := s.network.App.StakingKeeper.GetValidator(ctx, sdk.ValAddress(s.keyring.GetAccAddr(index)))
+	s.Require().NoError(err)
+	return validator
+}
+
+// jailValidator jails the validator operated by the keyring account with the
+// given index, and adds the self-delegation required to unjail it.
+func (s *PrecompileTestSuite) jailValidator(index int) {
+	ctx := s.network.GetContext()
+	validator := s.getValidator(index)
+
+	consAddr, err := validator.GetConsAddr()
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.App.StakingKeeper.Jail(ctx, consAddr))
+
+	selfDelegation := stakingtypes.NewDelegation(
+		s.keyring.GetAccAddr(index).String(),
+		validator.OperatorAddress,
+		math.LegacyOneDec(),
+	)
+	s.Require().NoError(s.network.App.StakingKeeper.SetDelegation(ctx, selfDelegation))
+}
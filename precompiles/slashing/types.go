@@ -0,0 +1,180 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package slashing
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/evmos/evmos/v20/precompiles/common"
+)
+
+// SigningInfo defines the signing info of a validator, with the consensus
+// address of the validator encoded as an Ethereum hex address.
+type SigningInfo struct {
+	ValidatorAddress    common.Address `abi:"validatorAddress"`
+	StartHeight         int64          `abi:"startHeight"`
+	IndexOffset         int64          `abi:"indexOffset"`
+	JailedUntil         int64          `abi:"jailedUntil"`
+	Tombstoned          bool           `abi:"tombstoned"`
+	MissedBlocksCounter int64          `abi:"missedBlocksCounter"`
+}
+
+// SigningInfoOutput defines the output for the SigningInfo query.
+type SigningInfoOutput struct {
+	SigningInfo SigningInfo
+}
+
+// SigningInfosInput defines the input for the SigningInfos query.
+type SigningInfosInput struct {
+	Pagination query.PageRequest
+}
+
+// SigningInfosOutput defines the output for the SigningInfos query.
+type SigningInfosOutput struct {
+	SigningInfos []SigningInfo
+	PageResponse query.PageResponse
+}
+
+// Params defines the parameters of the slashing module, with the durations
+// expressed in seconds.
+type Params struct {
+	SignedBlocksWindow      int64   `abi:"signedBlocksWindow"`
+	MinSignedPerWindow      cmn.Dec `abi:"minSignedPerWindow"`
+	DowntimeJailDuration    int64   `abi:"downtimeJailDuration"`
+	SlashFractionDoubleSign cmn.Dec `abi:"slashFractionDoubleSign"`
+	SlashFractionDowntime   cmn.Dec `abi:"slashFractionDowntime"`
+}
+
+// ParamsOutput defines the output for the Params query.
+type ParamsOutput struct {
+	Params Params
+}
+
+// NewMsgUnjail creates a new MsgUnjail instance and returns the validator
+// operator address.
+func NewMsgUnjail(args []interface{}) (*slashingtypes.MsgUnjail, common.Address, error) {
+	if len(args) != 1 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	validatorAddress, ok := args[0].(common.Address)
+	if !ok || validatorAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidValidatorAddress, args[0])
+	}
+
+	msg := &slashingtypes.MsgUnjail{
+		ValidatorAddr: sdk.ValAddress(validatorAddress.Bytes()).String(),
+	}
+
+	return msg, validatorAddress, nil
+}
+
+// ParseSigningInfoArgs parses the arguments for the SigningInfo query.
+func ParseSigningInfoArgs(args []interface{}) (*slashingtypes.QuerySigningInfoRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	consAddress, ok := args[0].(common.Address)
+	if !ok || consAddress == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidConsAddress, args[0])
+	}
+
+	return &slashingtypes.QuerySigningInfoRequest{
+		ConsAddress: sdk.ConsAddress(consAddress.Bytes()).String(),
+	}, nil
+}
+
+// ParseSigningInfosArgs parses the arguments for the SigningInfos query.
+func ParseSigningInfosArgs(method *abi.Method, args []interface{}) (*slashingtypes.QuerySigningInfosRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input SigningInfosInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to SigningInfosInput: %s", err)
+	}
+
+	return &slashingtypes.QuerySigningInfosRequest{
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// FromResponse populates the SigningInfoOutput from a QuerySigningInfoResponse.
+func (sio *SigningInfoOutput) FromResponse(res *slashingtypes.QuerySigningInfoResponse) (*SigningInfoOutput, error) {
+	signingInfo, err := NewSigningInfo(res.ValSigningInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	sio.SigningInfo = signingInfo
+	return sio, nil
+}
+
+// FromResponse populates the SigningInfosOutput from a QuerySigningInfosResponse.
+func (sio *SigningInfosOutput) FromResponse(res *slashingtypes.QuerySigningInfosResponse) (*SigningInfosOutput, error) {
+	sio.SigningInfos = make([]SigningInfo, len(res.Info))
+	for i, info := range res.Info {
+		signingInfo, err := NewSigningInfo(info)
+		if err != nil {
+			return nil, err
+		}
+		sio.SigningInfos[i] = signingInfo
+	}
+
+	if res.Pagination != nil {
+		sio.PageResponse = query.PageResponse{
+			NextKey: res.Pagination.NextKey,
+			Total:   res.Pagination.Total,
+		}
+	}
+
+	return sio, nil
+}
+
+// FromResponse populates the ParamsOutput from a QueryParamsResponse.
+func (po *ParamsOutput) FromResponse(res *slashingtypes.QueryParamsResponse) *ParamsOutput {
+	po.Params = Params{
+		SignedBlocksWindow:      res.Params.SignedBlocksWindow,
+		MinSignedPerWindow:      newDec(res.Params.MinSignedPerWindow),
+		DowntimeJailDuration:    int64(res.Params.DowntimeJailDuration.Seconds()),
+		SlashFractionDoubleSign: newDec(res.Params.SlashFractionDoubleSign),
+		SlashFractionDowntime:   newDec(res.Params.SlashFractionDowntime),
+	}
+	return po
+}
+
+// NewSigningInfo converts the signing info of a validator to its ABI
+// representation.
+func NewSigningInfo(info slashingtypes.ValidatorSigningInfo) (SigningInfo, error) {
+	consAddr, err := sdk.ConsAddressFromBech32(info.Address)
+	if err != nil {
+		return SigningInfo{}, fmt.Errorf(ErrInvalidConsAddress, info.Address)
+	}
+
+	return SigningInfo{
+		ValidatorAddress:    common.BytesToAddress(consAddr.Bytes()),
+		StartHeight:         info.StartHeight,
+		IndexOffset:         info.IndexOffset,
+		JailedUntil:         info.JailedUntil.Unix(),
+		Tombstoned:          info.Tombstoned,
+		MissedBlocksCounter: info.MissedBlocksCounter,
+	}, nil
+}
+
+// newDec converts a legacy decimal to its ABI representation.
+func newDec(d math.LegacyDec) cmn.Dec {
+	return cmn.Dec{
+		Value:     d.BigInt(),
+		Precision: math.LegacyPrecision,
+	}
+}
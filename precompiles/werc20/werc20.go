@@ -145,6 +145,9 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	if err := p.AddJournalEntries(stateDB, snapshot); err != nil {
 		return nil, err
 	}
+
+	p.CapturePrecompile(evm, ctx, snapshot, method, args)
+
 	return bz, nil
 }
 
@@ -11,12 +11,14 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/evmos/evmos/v20/rpc/backend"
+	"github.com/evmos/evmos/v20/rpc/namespaces/cosmos"
 	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/debug"
 	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/eth"
 	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/eth/filters"
 	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/miner"
 	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/net"
 	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/personal"
+	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/trace"
 	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/txpool"
 	"github.com/evmos/evmos/v20/rpc/namespaces/ethereum/web3"
 	"github.com/evmos/evmos/v20/types"
@@ -39,6 +41,7 @@ const (
 	TxPoolNamespace   = "txpool"
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
+	TraceNamespace    = "trace"
 
 	apiVersion = "1.0"
 )
@@ -50,6 +53,7 @@ type APICreator = func(
 	tendermintWebsocketClient *rpcclient.WSClient,
 	allowUnprotectedTxs bool,
 	indexer types.EVMTxIndexer,
+	txPool types.EVMTxPool,
 ) []rpc.API
 
 // apiCreators defines the JSON-RPC API namespaces.
@@ -57,13 +61,31 @@ var apiCreators map[string]APICreator
 
 func init() {
 	apiCreators = map[string]APICreator{
+		CosmosNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+			txPool types.EVMTxPool,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, txPool)
+			return []rpc.API{
+				{
+					Namespace: CosmosNamespace,
+					Version:   apiVersion,
+					Service:   cosmos.NewAPI(ctx, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 		EthNamespace: func(ctx *server.Context,
 			clientCtx client.Context,
 			tmWSClient *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer types.EVMTxIndexer,
+			txPool types.EVMTxPool,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, txPool)
 			return []rpc.API{
 				{
 					Namespace: EthNamespace,
@@ -79,7 +101,7 @@ func init() {
 				},
 			}
 		},
-		Web3Namespace: func(*server.Context, client.Context, *rpcclient.WSClient, bool, types.EVMTxIndexer) []rpc.API {
+		Web3Namespace: func(*server.Context, client.Context, *rpcclient.WSClient, bool, types.EVMTxIndexer, types.EVMTxPool) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: Web3Namespace,
@@ -89,7 +111,7 @@ func init() {
 				},
 			}
 		},
-		NetNamespace: func(_ *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer) []rpc.API {
+		NetNamespace: func(_ *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer, _ types.EVMTxPool) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: NetNamespace,
@@ -104,8 +126,9 @@ func init() {
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer types.EVMTxIndexer,
+			txPool types.EVMTxPool,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, txPool)
 			return []rpc.API{
 				{
 					Namespace: PersonalNamespace,
@@ -115,12 +138,19 @@ func init() {
 				},
 			}
 		},
-		TxPoolNamespace: func(ctx *server.Context, _ client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer) []rpc.API {
+		TxPoolNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+			txPool types.EVMTxPool,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, txPool)
 			return []rpc.API{
 				{
 					Namespace: TxPoolNamespace,
 					Version:   apiVersion,
-					Service:   txpool.NewPublicAPI(ctx.Logger),
+					Service:   txpool.NewPublicAPI(ctx.Logger, evmBackend),
 					Public:    true,
 				},
 			}
@@ -130,8 +160,9 @@ func init() {
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer types.EVMTxIndexer,
+			txPool types.EVMTxPool,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, txPool)
 			return []rpc.API{
 				{
 					Namespace: DebugNamespace,
@@ -146,8 +177,9 @@ func init() {
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer types.EVMTxIndexer,
+			txPool types.EVMTxPool,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, txPool)
 			return []rpc.API{
 				{
 					Namespace: MinerNamespace,
@@ -157,6 +189,23 @@ func init() {
 				},
 			}
 		},
+		TraceNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+			txPool types.EVMTxPool,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, txPool)
+			return []rpc.API{
+				{
+					Namespace: TraceNamespace,
+					Version:   apiVersion,
+					Service:   trace.NewAPI(ctx, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 	}
 }
 
@@ -166,13 +215,14 @@ func GetRPCAPIs(ctx *server.Context,
 	tmWSClient *rpcclient.WSClient,
 	allowUnprotectedTxs bool,
 	indexer types.EVMTxIndexer,
+	txPool types.EVMTxPool,
 	selectedAPIs []string,
 ) []rpc.API {
 	var apis []rpc.API
 
 	for _, ns := range selectedAPIs {
 		if creator, ok := apiCreators[ns]; ok {
-			apis = append(apis, creator(ctx, clientCtx, tmWSClient, allowUnprotectedTxs, indexer)...)
+			apis = append(apis, creator(ctx, clientCtx, tmWSClient, allowUnprotectedTxs, indexer, txPool)...)
 		} else {
 			ctx.Logger.Error("invalid namespace value", "namespace", ns)
 		}
@@ -10,10 +10,10 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	sdkmath "cosmossdk.io/math"
-	"github.com/cometbft/cometbft/libs/bytes"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	rpctypes "github.com/evmos/evmos/v20/rpc/types"
@@ -77,15 +77,17 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 
 	for i, key := range storageKeys {
 		hexKey := common.HexToHash(key)
-		valueBz, proof, err := b.queryClient.GetProof(clientCtx, evmtypes.StoreKey, evmtypes.StateKey(address, hexKey.Bytes()))
+		stateKey := evmtypes.StateKey(address, hexKey.Bytes())
+		valueBz, proof, err := b.queryClient.GetProof(clientCtx, evmtypes.StoreKey, stateKey)
 		if err != nil {
 			return nil, err
 		}
 
 		storageProofs[i] = rpctypes.StorageResult{
-			Key:   key,
-			Value: (*hexutil.Big)(new(big.Int).SetBytes(valueBz)),
-			Proof: GetHexProofs(proof),
+			Key:         key,
+			Value:       (*hexutil.Big)(new(big.Int).SetBytes(valueBz)),
+			Proof:       GetHexProofs(proof),
+			CosmosProof: rpctypes.NewStoreProof(evmtypes.StoreKey, stateKey, valueBz, proof),
 		}
 	}
 
@@ -100,8 +102,25 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 	}
 
 	// query account proofs
-	accountKey := bytes.HexBytes(append(authtypes.AddressStoreKeyPrefix, address.Bytes()...))
-	_, proof, err := b.queryClient.GetProof(clientCtx, authtypes.StoreKey, accountKey)
+	accountKey := rpctypes.AuthAccountKey(address)
+	accountBz, proof, err := b.queryClient.GetProof(clientCtx, authtypes.StoreKey, accountKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// query the proofs of the balance and code hash, so that the account can be
+	// verified against the app hash
+	balanceKey, err := rpctypes.BankBalanceKey(address, evmtypes.GetEVMCoinDenom())
+	if err != nil {
+		return nil, err
+	}
+	balanceBz, balanceProof, err := b.queryClient.GetProof(clientCtx, banktypes.StoreKey, balanceKey)
+	if err != nil {
+		return nil, err
+	}
+
+	codeHashKey := evmtypes.CodeHashKey(address)
+	codeHashBz, codeHashProof, err := b.queryClient.GetProof(clientCtx, evmtypes.StoreKey, codeHashKey)
 	if err != nil {
 		return nil, err
 	}
@@ -119,6 +138,12 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 		Nonce:        hexutil.Uint64(res.Nonce),
 		StorageHash:  common.Hash{}, // NOTE: Evmos doesn't have a storage hash. TODO: implement?
 		StorageProof: storageProofs,
+		CosmosProof: &rpctypes.CosmosAccountProof{
+			Height:   hexutil.Uint64(height), //#nosec G115 -- height is positive
+			Account:  *rpctypes.NewStoreProof(authtypes.StoreKey, accountKey, accountBz, proof),
+			Balance:  *rpctypes.NewStoreProof(banktypes.StoreKey, balanceKey, balanceBz, balanceProof),
+			CodeHash: *rpctypes.NewStoreProof(evmtypes.StoreKey, codeHashKey, codeHashBz, codeHashProof),
+		},
 	}, nil
 }
 
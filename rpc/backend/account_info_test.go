@@ -7,6 +7,7 @@ import (
 	"github.com/cometbft/cometbft/libs/bytes"
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"google.golang.org/grpc/metadata"
@@ -80,6 +81,10 @@ func (suite *BackendTestSuite) TestGetProof() {
 	blockNrInvalid := rpctypes.NewBlockNumber(big.NewInt(1))
 	blockNr := rpctypes.NewBlockNumber(big.NewInt(4))
 	address1 := utiltx.GenerateAddress()
+	accountKey := append(authtypes.AddressStoreKeyPrefix.Bytes(), address1.Bytes()...)
+	stateKey := evmtypes.StateKey(address1, common.HexToHash("0x0").Bytes())
+	balanceKey, err := rpctypes.BankBalanceKey(address1, evmtypes.GetEVMCoinDenom())
+	suite.Require().NoError(err)
 
 	testCases := []struct {
 		name          string
@@ -137,7 +142,7 @@ func (suite *BackendTestSuite) TestGetProof() {
 					client,
 					bn.Int64(),
 					"store/evm/key",
-					evmtypes.StateKey(address1, common.HexToHash("0x0").Bytes()),
+					stateKey,
 					cmtrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
 				)
 				RegisterABCIQueryWithOptions(
@@ -147,6 +152,20 @@ func (suite *BackendTestSuite) TestGetProof() {
 					bytes.HexBytes(append(authtypes.AddressStoreKeyPrefix, address1.Bytes()...)),
 					cmtrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
 				)
+				RegisterABCIQueryWithOptions(
+					client,
+					bn.Int64(),
+					"store/bank/key",
+					balanceKey,
+					cmtrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
+				)
+				RegisterABCIQueryWithOptions(
+					client,
+					bn.Int64(),
+					"store/evm/key",
+					evmtypes.CodeHashKey(address1),
+					cmtrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
+				)
 			},
 			true,
 			&rpctypes.AccountResult{
@@ -158,11 +177,18 @@ func (suite *BackendTestSuite) TestGetProof() {
 				StorageHash:  common.Hash{},
 				StorageProof: []rpctypes.StorageResult{
 					{
-						Key:   "0x0",
-						Value: (*hexutil.Big)(big.NewInt(2)),
-						Proof: []string{""},
+						Key:         "0x0",
+						Value:       (*hexutil.Big)(big.NewInt(2)),
+						Proof:       []string{""},
+						CosmosProof: rpctypes.NewStoreProof(evmtypes.StoreKey, stateKey, []byte{2}, nil),
 					},
 				},
+				CosmosProof: &rpctypes.CosmosAccountProof{
+					Height:   4,
+					Account:  *rpctypes.NewStoreProof(authtypes.StoreKey, accountKey, []byte{2}, nil),
+					Balance:  *rpctypes.NewStoreProof(banktypes.StoreKey, balanceKey, []byte{2}, nil),
+					CodeHash: *rpctypes.NewStoreProof(evmtypes.StoreKey, evmtypes.CodeHashKey(address1), []byte{2}, nil),
+				},
 			},
 		},
 	}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -29,9 +30,20 @@ import (
 
 // BackendI implements the Cosmos and EVM backend.
 type BackendI interface { //nolint: revive
+	CosmosBackend
 	EVMBackend
 }
 
+// CosmosBackend implements the lookups between the Cosmos and Ethereum representations
+// of the chain used by the cosmos namespace.
+// Implemented by Backend.
+type CosmosBackend interface {
+	CosmosTxHashByEthHash(hash common.Hash) (string, error)
+	EthTxHashesByCosmosHash(hash string) ([]common.Hash, error)
+	CosmosTxEventsByEthHash(hash common.Hash) ([]abci.Event, error)
+	CosmosBalances(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (sdk.Coins, error)
+}
+
 // EVMBackend implements the functionality shared within ethereum namespaces
 // as defined by EIP-1474: https://github.com/ethereum/EIPs/blob/master/EIPS/eip-1474.md
 // Implemented by Backend.
@@ -97,26 +109,37 @@ type EVMBackend interface {
 	GetTxByTxIndex(height int64, txIndex uint) (*evmostypes.TxResult, error)
 	GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
+	GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error)
 	GetTransactionLogs(hash common.Hash) ([]*ethtypes.Log, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 
+	// Tx Pool
+	TxPoolContent() (pending, queued map[common.Address]map[uint64]*rpctypes.RPCTransaction, err error)
+
 	// Send Transaction
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
-	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error)
+	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride) (hexutil.Uint64, error)
+	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride, blockOverrides *rpctypes.BlockOverrides) (*evmtypes.MsgEthereumTxResponse, error)
+	SimulateV1(opts evmtypes.SimOpts, blockNr rpctypes.BlockNumber) ([]evmtypes.SimBlockResult, error)
 	GasPrice() (*hexutil.Big, error)
 
 	// Filter API
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
+	IndexedLogBlocks(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, bool, error)
 	BloomStatus() (uint64, uint64)
 
 	// Tracing
 	TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error)
 	TraceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+	TraceCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, config *rpctypes.TraceCallConfig) (interface{}, error)
+	FlatTraceTransaction(hash common.Hash) ([]*rpctypes.LocalizedTrace, error)
+	FlatTraceBlock(block *tmrpctypes.ResultBlock) ([]*rpctypes.LocalizedTrace, error)
+	TraceFilter(args rpctypes.TraceFilterArgs) ([]*rpctypes.LocalizedTrace, error)
+	ReplayBlockTransactions(block *tmrpctypes.ResultBlock, traceTypes []string) ([]*rpctypes.TraceReplayResult, error)
 }
 
 var _ BackendI = (*Backend)(nil)
@@ -132,6 +155,7 @@ type Backend struct {
 	cfg                 config.Config
 	allowUnprotectedTxs bool
 	indexer             evmostypes.EVMTxIndexer
+	txPool              evmostypes.EVMTxPool
 }
 
 // NewBackend creates a new Backend instance for cosmos and ethereum namespaces
@@ -141,6 +165,7 @@ func NewBackend(
 	clientCtx client.Context,
 	allowUnprotectedTxs bool,
 	indexer evmostypes.EVMTxIndexer,
+	txPool evmostypes.EVMTxPool,
 ) *Backend {
 	chainID, err := evmostypes.ParseChainID(clientCtx.ChainID)
 	if err != nil {
@@ -167,5 +192,6 @@ func NewBackend(
 		cfg:                 appConf,
 		allowUnprotectedTxs: allowUnprotectedTxs,
 		indexer:             indexer,
+		txPool:              txPool,
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"cosmossdk.io/math"
 	dbm "github.com/cosmos/cosmos-db"
 
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -14,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/suite"
@@ -84,7 +86,7 @@ func (suite *BackendTestSuite) SetupTest() {
 	allowUnprotectedTxs := false
 	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), ctx.Logger, clientCtx)
 
-	suite.backend = NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, idxer)
+	suite.backend = NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, idxer, nil)
 	suite.backend.cfg.JSONRPC.GasCap = 0
 	suite.backend.cfg.JSONRPC.EVMTimeout = 0
 	suite.backend.cfg.JSONRPC.AllowInsecureUnlock = true
@@ -120,6 +122,45 @@ func (suite *BackendTestSuite) buildEthereumTx() (*evmtypes.MsgEthereumTx, []byt
 	return msgEthereumTx, bz
 }
 
+// buildSetCodeTx returns an example EIP-7702 set code transaction with a single
+// authorization
+func (suite *BackendTestSuite) buildSetCodeTx() (*evmtypes.MsgEthereumTx, []byte) {
+	auth := evmtypes.SetCodeAuthorization{
+		ChainID: math.ZeroInt(),
+		Address: common.HexToAddress("0xc0de").Hex(),
+		Nonce:   1,
+	}
+	sig, _, err := suite.signer.SignByAddress(sdk.AccAddress(suite.from.Bytes()), auth.SigHash().Bytes(), signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+	suite.Require().NoError(err)
+	auth.R, auth.S, auth.V = sig[:32], sig[32:64], big.NewInt(int64(sig[64])).Bytes()
+
+	chainID := math.NewIntFromBigInt(suite.backend.chainID)
+	tip, feeCap, amount := math.NewInt(1), math.NewInt(10), math.ZeroInt()
+	txData := &evmtypes.SetCodeTx{
+		ChainID:        &chainID,
+		GasTipCap:      &tip,
+		GasFeeCap:      &feeCap,
+		GasLimit:       100000,
+		To:             suite.from.Hex(),
+		Amount:         &amount,
+		Authorizations: evmtypes.AuthorizationList{auth},
+	}
+	anyTxData, err := evmtypes.PackTxData(txData)
+	suite.Require().NoError(err)
+
+	msgEthereumTx := &evmtypes.MsgEthereumTx{Data: anyTxData, From: suite.from.Hex()}
+	err = msgEthereumTx.Sign(ethtypes.LatestSigner(suite.backend.ChainConfig()), suite.signer)
+	suite.Require().NoError(err)
+
+	txBuilder := suite.backend.clientCtx.TxConfig.NewTxBuilder()
+	err = txBuilder.SetMsgs(msgEthereumTx)
+	suite.Require().NoError(err)
+
+	bz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	suite.Require().NoError(err)
+	return msgEthereumTx, bz
+}
+
 // buildFormattedBlock returns a formatted block for testing
 func (suite *BackendTestSuite) buildFormattedBlock(
 	blockRes *tmrpctypes.ResultBlockResults,
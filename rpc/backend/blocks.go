@@ -269,7 +269,7 @@ func (b *Backend) EthMsgsFromTendermintBlock(
 				continue
 			}
 
-			ethMsg.Hash = ethMsg.AsTransaction().Hash().Hex()
+			ethMsg.Hash = ethMsg.TxHash().Hex()
 			result = append(result, ethMsg)
 		}
 	}
@@ -354,6 +354,12 @@ func (b *Backend) BlockBloom(blockRes *tmrpctypes.ResultBlockResults) (ethtypes.
 			}
 		}
 	}
+	// the block bloom is also kept by the EVM indexer, for pruned block results
+	if b.indexer != nil {
+		if bloom, err := b.indexer.GetBlockBloom(blockRes.Height); err == nil {
+			return bloom, nil
+		}
+	}
 	return ethtypes.Bloom{}, errors.New("block bloom event is not found")
 }
 
@@ -381,11 +387,10 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 			continue
 		}
 
-		tx := ethMsg.AsTransaction()
 		height := uint64(block.Height) //nolint:gosec // G115 G701 -- checked for int overflow already
 		index := uint64(txIndex)       //nolint:gosec // G115 G701 -- checked for int overflow already
-		rpcTx, err := rpctypes.NewRPCTransaction(
-			tx,
+		rpcTx, err := rpctypes.NewTransactionFromMsg(
+			ethMsg,
 			common.BytesToHash(block.Hash()),
 			height,
 			index,
@@ -393,7 +398,7 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 			b.chainID,
 		)
 		if err != nil {
-			b.logger.Debug("NewTransactionFromData for receipt failed", "hash", tx.Hash().Hex(), "error", err.Error())
+			b.logger.Debug("NewTransactionFromData for receipt failed", "hash", ethMsg.Hash, "error", err.Error())
 			continue
 		}
 		ethRPCTxs = append(ethRPCTxs, rpcTx)
@@ -503,5 +508,16 @@ func (b *Backend) EthBlockFromTendermintBlock(
 
 	// TODO: add tx receipts
 	ethBlock := ethtypes.NewBlock(ethHeader, txs, nil, nil, trie.NewStackTrie(nil))
+
+	// go-ethereum can't represent the set code transactions, which are converted to
+	// dynamic fee transactions in the body, so the transactions root is derived from
+	// the canonical encodings to cover their authorization lists.
+	for _, ethMsg := range msgs {
+		if txData, err := evmtypes.UnpackTxData(ethMsg.Data); err == nil && txData.TxType() == evmtypes.SetCodeTxType {
+			ethHeader = ethBlock.Header()
+			ethHeader.TxHash = ethtypes.DeriveSha(rpctypes.EthTransactions(msgs), trie.NewStackTrie(nil))
+			return ethtypes.NewBlockWithHeader(ethHeader).WithBody(txs, nil), nil
+		}
+	}
 	return ethBlock, nil
 }
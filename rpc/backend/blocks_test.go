@@ -192,6 +192,54 @@ func (suite *BackendTestSuite) TestGetBlockByNumber() {
 			false,
 			true,
 		},
+		{
+			"pass - finalized block tag resolves to the latest block",
+			blockTag("finalized"),
+			true,
+			math.NewInt(1).BigInt(),
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+			msgEthereumTx,
+			bz,
+			func(_ ethrpc.BlockNumber, baseFee math.Int, validator sdk.AccAddress, txBz []byte) {
+				var header metadata.MD
+				height := int64(1)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				resBlock, _ = RegisterBlock(client, height, txBz)
+				blockRes, _ = RegisterBlockResults(client, height)
+				RegisterConsensusParams(client, height)
+
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(queryClient, &header, height)
+				RegisterBaseFee(queryClient, baseFee)
+				RegisterValidatorAccount(queryClient, validator)
+			},
+			false,
+			true,
+		},
+		{
+			"pass - safe block tag resolves to the latest block",
+			blockTag("safe"),
+			true,
+			math.NewInt(1).BigInt(),
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+			nil,
+			nil,
+			func(_ ethrpc.BlockNumber, baseFee math.Int, validator sdk.AccAddress, txBz []byte) {
+				var header metadata.MD
+				height := int64(1)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				resBlock, _ = RegisterBlock(client, height, txBz)
+				blockRes, _ = RegisterBlockResults(client, height)
+				RegisterConsensusParams(client, height)
+
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(queryClient, &header, height)
+				RegisterBaseFee(queryClient, baseFee)
+				RegisterValidatorAccount(queryClient, validator)
+			},
+			false,
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
@@ -693,6 +741,7 @@ func (suite *BackendTestSuite) TestBlockNumberFromTendermint() {
 	block := cmttypes.MakeBlock(1, []cmttypes.Tx{bz}, nil, nil)
 	blockNum := ethrpc.NewBlockNumber(big.NewInt(block.Height))
 	blockHash := common.BytesToHash(block.Hash())
+	finalizedNum := blockTag("finalized")
 
 	testCases := []struct {
 		name         string
@@ -735,6 +784,13 @@ func (suite *BackendTestSuite) TestBlockNumberFromTendermint() {
 			func(*common.Hash) {},
 			true,
 		},
+		{
+			"pass - with the finalized block tag",
+			&finalizedNum,
+			nil,
+			func(*common.Hash) {},
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
@@ -1132,6 +1188,45 @@ func (suite *BackendTestSuite) TestGetEthBlockFromTendermint() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetEthBlockFromTendermintSetCodeTx() {
+	suite.SetupTest()
+	msgEthereumTx, bz := suite.buildSetCodeTx()
+	resBlock := &tmrpctypes.ResultBlock{
+		Block: cmttypes.MakeBlock(1, []cmttypes.Tx{bz}, nil, nil),
+	}
+	blockRes := &tmrpctypes.ResultBlockResults{
+		Height:     1,
+		TxsResults: []*types.ExecTxResult{{Code: 0, GasUsed: 0}},
+	}
+
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterBaseFee(queryClient, math.NewInt(1))
+	RegisterValidatorAccount(queryClient, sdk.AccAddress(utiltx.GenerateAddress().Bytes()))
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	RegisterConsensusParams(client, 1)
+
+	block, err := suite.backend.RPCBlockFromTendermintBlock(resBlock, blockRes, true)
+	suite.Require().NoError(err)
+
+	txs, ok := block["transactions"].([]interface{})
+	suite.Require().True(ok)
+	suite.Require().Len(txs, 1)
+	rpcTx, ok := txs[0].(*ethrpc.RPCTransaction)
+	suite.Require().True(ok)
+	suite.Require().Equal(hexutil.Uint64(evmtypes.SetCodeTxType), rpcTx.Type)
+	suite.Require().Equal(msgEthereumTx.TxHash(), rpcTx.Hash)
+	suite.Require().Equal(suite.from, rpcTx.From)
+	suite.Require().Len(rpcTx.AuthorizationList, 1)
+	suite.Require().Equal(common.HexToAddress("0xc0de"), rpcTx.AuthorizationList[0].Address)
+
+	// the transactions root of the go-ethereum block covers the authorization list
+	ethBlock, err := suite.backend.EthBlockFromTendermintBlock(resBlock, blockRes)
+	suite.Require().NoError(err)
+	suite.Require().Equal(ethtypes.DeriveSha(ethrpc.EthTransactions{msgEthereumTx}, trie.NewStackTrie(nil)), ethBlock.TxHash())
+	suite.Require().NotEqual(ethtypes.DeriveSha(ethtypes.Transactions{msgEthereumTx.AsTransaction()}, trie.NewStackTrie(nil)), ethBlock.TxHash())
+	suite.Require().Len(ethBlock.Transactions(), 1)
+}
+
 func (suite *BackendTestSuite) TestEthMsgsFromTendermintBlock() {
 	msgEthereumTx, bz := suite.buildEthereumTx()
 
@@ -1630,3 +1725,13 @@ func (suite *BackendTestSuite) TestEthBlockFromTendermintBlock() {
 		})
 	}
 }
+
+// blockTag returns the block number of the given block tag, as decoded from a
+// JSON-RPC request
+func blockTag(tag string) ethrpc.BlockNumber {
+	var blockNum ethrpc.BlockNumber
+	if err := blockNum.UnmarshalJSON([]byte(`"` + tag + `"`)); err != nil {
+		panic(err)
+	}
+	return blockNum
+}
@@ -389,13 +389,34 @@ func (suite *BackendTestSuite) TestDoCall() {
 	argsBz, err := json.Marshal(callArgs)
 	suite.Require().NoError(err)
 
+	balance := (*hexutil.Big)(big.NewInt(1e18))
+	code := hexutil.Bytes{0x60, 0x00}
+	overrides := rpctypes.StateOverride{
+		toAddr: rpctypes.OverrideAccount{
+			Balance: &balance,
+			Code:    &code,
+		},
+	}
+	overridesBz, err := json.Marshal(overrides)
+	suite.Require().NoError(err)
+
+	blockTime := hexutil.Uint64(1700000000)
+	blockOverrides := rpctypes.BlockOverrides{
+		Number: (*hexutil.Big)(big.NewInt(100)),
+		Time:   &blockTime,
+	}
+	blockOverridesBz, err := json.Marshal(blockOverrides)
+	suite.Require().NoError(err)
+
 	testCases := []struct {
-		name         string
-		registerMock func()
-		blockNum     rpctypes.BlockNumber
-		callArgs     evmtypes.TransactionArgs
-		expEthTx     *evmtypes.MsgEthereumTxResponse
-		expPass      bool
+		name           string
+		registerMock   func()
+		blockNum       rpctypes.BlockNumber
+		callArgs       evmtypes.TransactionArgs
+		overrides      *rpctypes.StateOverride
+		blockOverrides *rpctypes.BlockOverrides
+		expEthTx       *evmtypes.MsgEthereumTxResponse
+		expPass        bool
 	}{
 		{
 			"fail - Invalid request",
@@ -408,6 +429,8 @@ func (suite *BackendTestSuite) TestDoCall() {
 			},
 			rpctypes.BlockNumber(1),
 			callArgs,
+			nil,
+			nil,
 			&evmtypes.MsgEthereumTxResponse{},
 			false,
 		},
@@ -422,6 +445,29 @@ func (suite *BackendTestSuite) TestDoCall() {
 			},
 			rpctypes.BlockNumber(1),
 			callArgs,
+			nil,
+			nil,
+			&evmtypes.MsgEthereumTxResponse{},
+			true,
+		},
+		{
+			"pass - state and block overrides are forwarded to the query",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterEthCall(queryClient, &evmtypes.EthCallRequest{
+					Args:           argsBz,
+					ChainId:        suite.backend.chainID.Int64(),
+					Overrides:      overridesBz,
+					BlockOverrides: blockOverridesBz,
+				})
+			},
+			rpctypes.BlockNumber(1),
+			callArgs,
+			&overrides,
+			&blockOverrides,
 			&evmtypes.MsgEthereumTxResponse{},
 			true,
 		},
@@ -432,7 +478,7 @@ func (suite *BackendTestSuite) TestDoCall() {
 			suite.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum)
+			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum, tc.overrides, tc.blockOverrides)
 
 			if tc.expPass {
 				suite.Require().Equal(tc.expEthTx, msgEthTx)
@@ -443,6 +489,85 @@ func (suite *BackendTestSuite) TestDoCall() {
 	}
 }
 
+func (suite *BackendTestSuite) TestSimulateV1() {
+	_, bz := suite.buildEthereumTx()
+	fromAddr, toAddr := utiltx.GenerateAddress(), utiltx.GenerateAddress()
+	opts := evmtypes.SimOpts{
+		BlockStateCalls: []evmtypes.SimBlock{
+			{Calls: []evmtypes.TransactionArgs{{From: &fromAddr, To: &toAddr}}},
+		},
+	}
+	optsBz, err := json.Marshal(opts)
+	suite.Require().NoError(err)
+
+	results := []evmtypes.SimBlockResult{
+		{
+			Number:       2,
+			Timestamp:    1700000000,
+			Transactions: []common.Hash{common.HexToHash("0x1")},
+			Calls: []evmtypes.SimCallResult{
+				{ReturnValue: hexutil.Bytes{0x01}, Logs: []*ethtypes.Log{}, GasUsed: 21000, Status: 1},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResults   []evmtypes.SimBlockResult
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - query returns error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterSimulateV1Error(queryClient, &evmtypes.SimulateV1Request{Opts: optsBz, ChainId: suite.backend.chainID.Int64()})
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - simulated blocks are decoded",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterSimulateV1(queryClient, &evmtypes.SimulateV1Request{Opts: optsBz, ChainId: suite.backend.chainID.Int64()}, results)
+			},
+			results,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.SimulateV1(opts, rpctypes.BlockNumber(1))
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResults, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 
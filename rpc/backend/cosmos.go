@@ -0,0 +1,126 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package backend
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	rpctypes "github.com/evmos/evmos/v20/rpc/types"
+	evmostypes "github.com/evmos/evmos/v20/types"
+)
+
+// CosmosTxHashByEthHash returns the hash of the Cosmos transaction that includes
+// the given Ethereum transaction, in the upper case hex format used by CometBFT.
+func (b *Backend) CosmosTxHashByEthHash(hash common.Hash) (string, error) {
+	res, block, err := b.cosmosTxByEthHash(hash)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%X", block.Block.Txs[res.TxIndex].Hash()), nil
+}
+
+// EthTxHashesByCosmosHash returns the hashes of the Ethereum transactions included
+// in the given Cosmos transaction. The hash can be prefixed with 0x. Cosmos
+// transactions that don't include Ethereum transactions return an empty list.
+func (b *Backend) EthTxHashesByCosmosHash(hash string) ([]common.Hash, error) {
+	hashBz, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(hash, "0x"), "0X"))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid cosmos tx hash %s", hash)
+	}
+
+	res, err := b.rpcClient.Tx(b.ctx, hashBz, false)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to get cosmos tx %s", hash)
+	}
+
+	hashes := []common.Hash{}
+	ethMsgs, err := rpctypes.RawTxToEthTx(b.clientCtx, res.Tx)
+	if err != nil {
+		// not an ethereum tx
+		return hashes, nil
+	}
+
+	for _, msg := range ethMsgs {
+		hashes = append(hashes, msg.TxHash())
+	}
+	return hashes, nil
+}
+
+// CosmosTxEventsByEthHash returns the events emitted by the Cosmos transaction that
+// includes the given Ethereum transaction.
+func (b *Backend) CosmosTxEventsByEthHash(hash common.Hash) ([]abci.Event, error) {
+	res, err := b.GetTxByEthHash(hash)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to find transaction %s", hash.Hex())
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to get block results at height %d", res.Height)
+	}
+
+	if blockRes == nil || int(res.TxIndex) >= len(blockRes.TxsResults) {
+		return nil, fmt.Errorf("cosmos tx %d not found in the results of block %d", res.TxIndex, res.Height)
+	}
+
+	return blockRes.TxsResults[res.TxIndex].Events, nil
+}
+
+// CosmosBalances returns the bank balances in all the denominations of the given
+// address at the given block.
+func (b *Backend) CosmosBalances(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (sdk.Coins, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &banktypes.QueryAllBalancesRequest{
+		Address:    sdk.AccAddress(address.Bytes()).String(),
+		Pagination: &query.PageRequest{},
+	}
+
+	balances := sdk.NewCoins()
+	for {
+		res, err := b.queryClient.Bank.AllBalances(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+		if err != nil {
+			return nil, err
+		}
+
+		balances = balances.Add(res.Balances...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return balances, nil
+		}
+		req.Pagination.Key = res.Pagination.NextKey
+	}
+}
+
+// cosmosTxByEthHash returns the indexed result of the Ethereum transaction and the
+// block that includes it.
+func (b *Backend) cosmosTxByEthHash(hash common.Hash) (*evmostypes.TxResult, *tmrpctypes.ResultBlock, error) {
+	res, err := b.GetTxByEthHash(hash)
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(err, "failed to find transaction %s", hash.Hex())
+	}
+
+	block, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(res.Height))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if block == nil || block.Block == nil || int(res.TxIndex) >= len(block.Block.Txs) {
+		return nil, nil, fmt.Errorf("cosmos tx %d not found in block %d", res.TxIndex, res.Height)
+	}
+
+	return res, block, nil
+}
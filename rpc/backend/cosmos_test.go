@@ -0,0 +1,128 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+
+	"github.com/evmos/evmos/v20/indexer"
+	"github.com/evmos/evmos/v20/rpc/backend/mocks"
+	rpctypes "github.com/evmos/evmos/v20/rpc/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// bankQueryClient returns the balances of all the addresses in two pages.
+type bankQueryClient struct {
+	banktypes.QueryClient
+}
+
+func (bankQueryClient) AllBalances(_ context.Context, req *banktypes.QueryAllBalancesRequest, _ ...grpc.CallOption) (*banktypes.QueryAllBalancesResponse, error) {
+	if req.Pagination == nil || len(req.Pagination.Key) == 0 {
+		return &banktypes.QueryAllBalancesResponse{
+			Balances:   sdk.NewCoins(sdk.NewCoin("aevmos", math.NewInt(10))),
+			Pagination: &query.PageResponse{NextKey: []byte("next")},
+		}, nil
+	}
+	return &banktypes.QueryAllBalancesResponse{
+		Balances:   sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(5))),
+		Pagination: &query.PageResponse{},
+	}, nil
+}
+
+// indexCosmosTx indexes a block with a Cosmos tx followed by an Ethereum tx.
+func (suite *BackendTestSuite) indexCosmosTx() (common.Hash, *cmttypes.Block, []*abci.ExecTxResult) {
+	suite.backend.indexer = indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), suite.backend.clientCtx)
+
+	msgEthTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthTx)
+	txHash := msgEthTx.AsTransaction().Hash()
+	events := []abci.Event{
+		{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+			{Key: "ethereumTxHash", Value: txHash.Hex()},
+			{Key: "txIndex", Value: "0"},
+			{Key: "amount", Value: "0"},
+			{Key: "txGasUsed", Value: "21000"},
+			{Key: "txHash", Value: ""},
+			{Key: "recipient", Value: common.Address{}.Hex()},
+		}},
+		{Type: "message", Attributes: []abci.EventAttribute{{Key: "module", Value: "evm"}}},
+	}
+
+	cosmosTx := cmttypes.Tx("cosmos tx")
+	block := &cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{cosmosTx, txBz}}}
+	txResults := []*abci.ExecTxResult{{Code: 0}, {Code: 0, Events: events}}
+	suite.Require().NoError(suite.backend.indexer.IndexBlock(block, txResults))
+
+	return txHash, block, txResults
+}
+
+func (suite *BackendTestSuite) TestCosmosTxHashByEthHash() {
+	suite.SetupTest()
+	txHash, block, _ := suite.indexCosmosTx()
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	client.On("Block", rpctypes.ContextWithHeight(1), mock.AnythingOfType("*int64")).
+		Return(&tmrpctypes.ResultBlock{Block: block}, nil)
+
+	hash, err := suite.backend.CosmosTxHashByEthHash(txHash)
+	suite.Require().NoError(err)
+	suite.Require().Equal(fmt.Sprintf("%X", block.Txs[1].Hash()), hash)
+
+	_, err = suite.backend.CosmosTxHashByEthHash(common.HexToHash("0x01"))
+	suite.Require().Error(err)
+}
+
+func (suite *BackendTestSuite) TestEthTxHashesByCosmosHash() {
+	suite.SetupTest()
+	txHash, block, _ := suite.indexCosmosTx()
+	cosmosTx, txBz := block.Txs[0], block.Txs[1]
+
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	client.On("Tx", mock.Anything, []byte(txBz.Hash()), false).Return(&tmrpctypes.ResultTx{Tx: txBz}, nil)
+	client.On("Tx", mock.Anything, []byte(cosmosTx.Hash()), false).Return(&tmrpctypes.ResultTx{Tx: cosmosTx}, nil)
+
+	hashes, err := suite.backend.EthTxHashesByCosmosHash(fmt.Sprintf("0x%x", txBz.Hash()))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]common.Hash{txHash}, hashes)
+
+	// cosmos txs without ethereum txs
+	hashes, err = suite.backend.EthTxHashesByCosmosHash(fmt.Sprintf("%X", cosmosTx.Hash()))
+	suite.Require().NoError(err)
+	suite.Require().Empty(hashes)
+
+	_, err = suite.backend.EthTxHashesByCosmosHash("0xzz")
+	suite.Require().Error(err)
+}
+
+func (suite *BackendTestSuite) TestCosmosTxEventsByEthHash() {
+	suite.SetupTest()
+	txHash, _, txResults := suite.indexCosmosTx()
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	client.On("BlockResults", mock.Anything, mock.AnythingOfType("*int64")).
+		Return(&tmrpctypes.ResultBlockResults{Height: 1, TxsResults: txResults}, nil)
+
+	res, err := suite.backend.CosmosTxEventsByEthHash(txHash)
+	suite.Require().NoError(err)
+	suite.Require().Equal(txResults[1].Events, res)
+}
+
+func (suite *BackendTestSuite) TestCosmosBalances() {
+	suite.SetupTest()
+	suite.backend.queryClient.Bank = bankQueryClient{}
+	blockNum := rpctypes.BlockNumber(1)
+
+	balances, err := suite.backend.CosmosBalances(common.HexToAddress("0x01"), rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("aevmos", math.NewInt(10)), sdk.NewCoin("uatom", math.NewInt(5))), balances)
+}
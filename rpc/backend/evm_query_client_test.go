@@ -59,11 +59,31 @@ func RegisterTraceBlock(queryClient *mocks.EVMQueryClient, txs []*evmtypes.MsgEt
 		Return(&evmtypes.QueryTraceBlockResponse{Data: data}, nil)
 }
 
+func RegisterTraceBlockWithTracer(queryClient *mocks.EVMQueryClient, tracer string, data []byte) {
+	queryClient.On("TraceBlock", rpc.ContextWithHeight(1),
+		mock.MatchedBy(func(req *evmtypes.QueryTraceBlockRequest) bool {
+			return req.TraceConfig != nil && req.TraceConfig.Tracer == tracer
+		})).
+		Return(&evmtypes.QueryTraceBlockResponse{Data: data}, nil)
+}
+
 func RegisterTraceBlockError(queryClient *mocks.EVMQueryClient) {
 	queryClient.On("TraceBlock", rpc.ContextWithHeight(1), &evmtypes.QueryTraceBlockRequest{}).
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// TraceCall
+func RegisterTraceCall(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest) {
+	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
+	queryClient.On("TraceCall", rpc.ContextWithHeight(1), request).
+		Return(&evmtypes.QueryTraceCallResponse{Data: data}, nil)
+}
+
+func RegisterTraceCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest) {
+	queryClient.On("TraceCall", rpc.ContextWithHeight(1), request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Params
 func RegisterParams(queryClient *mocks.EVMQueryClient, header *metadata.MD, height int64) {
 	queryClient.On("Params", rpc.ContextWithHeight(height), &evmtypes.QueryParamsRequest{}, grpc.Header(header)).
@@ -140,14 +160,32 @@ func TestRegisterParamsError(t *testing.T) {
 
 // ETH Call
 func RegisterEthCall(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
-	ctx, _ := context.WithCancel(rpc.ContextWithHeight(1)) //nolint
+	ctx, cancel := context.WithCancel(rpc.ContextWithHeight(1))
 	queryClient.On("EthCall", ctx, request).
+		Run(func(mock.Arguments) { cancel() }).
 		Return(&evmtypes.MsgEthereumTxResponse{}, nil)
 }
 
 func RegisterEthCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
-	ctx, _ := context.WithCancel(rpc.ContextWithHeight(1)) //nolint
+	ctx, cancel := context.WithCancel(rpc.ContextWithHeight(1))
 	queryClient.On("EthCall", ctx, request).
+		Run(func(mock.Arguments) { cancel() }).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// SimulateV1
+func RegisterSimulateV1(queryClient *mocks.EVMQueryClient, request *evmtypes.SimulateV1Request, results []evmtypes.SimBlockResult) {
+	ctx, cancel := context.WithCancel(rpc.ContextWithHeight(1))
+	resultBz, _ := json.Marshal(results)
+	queryClient.On("SimulateV1", ctx, request).
+		Run(func(mock.Arguments) { cancel() }).
+		Return(&evmtypes.SimulateV1Response{Result: resultBz}, nil)
+}
+
+func RegisterSimulateV1Error(queryClient *mocks.EVMQueryClient, request *evmtypes.SimulateV1Request) {
+	ctx, cancel := context.WithCancel(rpc.ContextWithHeight(1))
+	queryClient.On("SimulateV1", ctx, request).
+		Run(func(mock.Arguments) { cancel() }).
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
@@ -21,7 +21,15 @@ func (b *Backend) GetLogs(hash common.Hash) ([][]*ethtypes.Log, error) {
 }
 
 // GetLogsByHeight returns all the logs from all the ethereum transactions in a block.
+// The logs are read from the EVM indexer when it covers the block, so that they are
+// still available after the block results are pruned.
 func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
+	if b.indexer != nil && height != nil {
+		if logs, err := b.indexer.GetLogsByHeight(*height); err == nil {
+			return logs, nil
+		}
+	}
+
 	// NOTE: we query the state in case the tx result logs are not persisted after an upgrade.
 	blockRes, err := b.rpcClient.BlockResults(b.ctx, height)
 	if err != nil {
@@ -31,6 +39,51 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 	return GetLogsFromBlockResults(blockRes)
 }
 
+// IndexedLogBlocks returns the blocks within [from, to] that may contain logs matching
+// the addresses and topics, using the log index of the EVM indexer. The blocks after
+// the last indexed one are all returned, so that they are scanned by the caller. It
+// returns false if the log index can't be used for the range, either because the
+// indexer is disabled, it doesn't cover the start of the range or too many blocks
+// remain to be indexed.
+func (b *Backend) IndexedLogBlocks(
+	from, to int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+) ([]int64, bool, error) {
+	if b.indexer == nil {
+		return nil, false, nil
+	}
+
+	first, last, err := b.indexer.LogIndexRange()
+	if err != nil {
+		return nil, false, err
+	}
+	if first == -1 || from < first {
+		return nil, false, nil
+	}
+
+	scanFrom := from
+	if last+1 > scanFrom {
+		scanFrom = last + 1
+	}
+	if to-scanFrom >= int64(b.RPCBlockRangeCap()) {
+		return nil, false, nil
+	}
+
+	indexedTo := to
+	if last < indexedTo {
+		indexedTo = last
+	}
+	heights, err := b.indexer.FilterLogBlocks(from, indexedTo, addresses, topics)
+	if err != nil {
+		return nil, false, err
+	}
+	for height := scanFrom; height <= to; height++ {
+		heights = append(heights, height)
+	}
+	return heights, true, nil
+}
+
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
@@ -3,9 +3,13 @@ package backend
 import (
 	"encoding/json"
 
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v20/indexer"
 	"github.com/evmos/evmos/v20/rpc/backend/mocks"
 	ethrpc "github.com/evmos/evmos/v20/rpc/types"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
@@ -120,3 +124,171 @@ func (suite *BackendTestSuite) TestBloomStatus() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestIndexedLogBlocks() {
+	var (
+		token    = common.HexToAddress("0x10")
+		transfer = common.HexToHash("0x01")
+	)
+
+	// indexBlock indexes a block with an eth tx emitting a log of the token contract if withLog is true
+	indexBlock := func(height int64, withLog bool) {
+		msgEthTx, _ := suite.buildEthereumTx()
+		txBz := suite.signAndEncodeEthTx(msgEthTx)
+		txHash := msgEthTx.AsTransaction().Hash()
+
+		events := []abci.Event{
+			{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+				{Key: "ethereumTxHash", Value: txHash.Hex()},
+				{Key: "txIndex", Value: "0"},
+				{Key: "amount", Value: "0"},
+				{Key: "txGasUsed", Value: "21000"},
+				{Key: "txHash", Value: ""},
+				{Key: "recipient", Value: common.Address{}.Hex()},
+			}},
+		}
+		if withLog {
+			bz, err := json.Marshal(evmtypes.NewLogFromEth(&ethtypes.Log{
+				Address:     token,
+				Topics:      []common.Hash{transfer},
+				BlockNumber: uint64(height), //nolint:gosec // G115
+				TxHash:      txHash,
+			}))
+			suite.Require().NoError(err)
+			events = append(events, abci.Event{
+				Type:       evmtypes.EventTypeTxLog,
+				Attributes: []abci.EventAttribute{{Key: evmtypes.AttributeKeyTxLog, Value: string(bz)}},
+			})
+		}
+
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: height}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+		err := suite.backend.indexer.IndexBlock(block, []*abci.ExecTxResult{{Code: 0, Events: events}})
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		from, to   int64
+		addresses  []common.Address
+		expIndexed bool
+		expHeights []int64
+	}{
+		{
+			"not indexed - indexer disabled",
+			func() {
+				suite.backend.indexer = nil
+			},
+			1, 3,
+			nil,
+			false,
+			nil,
+		},
+		{
+			"not indexed - empty log index",
+			func() {},
+			1, 3,
+			nil,
+			false,
+			nil,
+		},
+		{
+			"not indexed - range starts before the log index",
+			func() {
+				indexBlock(2, true)
+			},
+			1, 3,
+			nil,
+			false,
+			nil,
+		},
+		{
+			"not indexed - too many blocks to scan after the log index",
+			func() {
+				indexBlock(1, true)
+				suite.backend.cfg.JSONRPC.BlockRangeCap = 2
+			},
+			1, 4,
+			nil,
+			false,
+			nil,
+		},
+		{
+			"indexed - matching blocks of the log index",
+			func() {
+				indexBlock(1, true)
+				indexBlock(2, false)
+				indexBlock(3, true)
+			},
+			1, 3,
+			[]common.Address{token},
+			true,
+			[]int64{1, 3},
+		},
+		{
+			"indexed - blocks after the log index are scanned",
+			func() {
+				indexBlock(1, false)
+				indexBlock(2, true)
+			},
+			1, 4,
+			[]common.Address{token},
+			true,
+			[]int64{2, 3, 4},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.backend.indexer = indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), suite.backend.clientCtx)
+			tc.malleate()
+
+			heights, indexed, err := suite.backend.IndexedLogBlocks(tc.from, tc.to, tc.addresses, nil)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expIndexed, indexed)
+			suite.Require().Equal(tc.expHeights, heights)
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestGetLogsByHeightFromIndexer() {
+	suite.SetupTest()
+	suite.backend.indexer = indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), suite.backend.clientCtx)
+
+	msgEthTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthTx)
+	txHash := msgEthTx.AsTransaction().Hash()
+	ethLog := &ethtypes.Log{
+		Address:     common.HexToAddress("0x10"),
+		Topics:      []common.Hash{common.HexToHash("0x01")},
+		Data:        []byte{0x01},
+		BlockNumber: 1,
+		TxHash:      txHash,
+	}
+	logBz, err := json.Marshal(evmtypes.NewLogFromEth(ethLog))
+	suite.Require().NoError(err)
+
+	block := &cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+	err = suite.backend.indexer.IndexBlock(block, []*abci.ExecTxResult{{
+		Code: 0,
+		Events: []abci.Event{
+			{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+				{Key: "ethereumTxHash", Value: txHash.Hex()},
+				{Key: "txIndex", Value: "0"},
+				{Key: "amount", Value: "0"},
+				{Key: "txGasUsed", Value: "21000"},
+				{Key: "txHash", Value: ""},
+				{Key: "recipient", Value: common.Address{}.Hex()},
+			}},
+			{Type: evmtypes.EventTypeTxLog, Attributes: []abci.EventAttribute{{Key: evmtypes.AttributeKeyTxLog, Value: string(logBz)}}},
+		},
+	}})
+	suite.Require().NoError(err)
+
+	// no block results are registered, the logs are read from the indexer
+	height := int64(1)
+	logs, err := suite.backend.GetLogsByHeight(&height)
+	suite.Require().NoError(err)
+	suite.Require().Equal([][]*ethtypes.Log{{ethLog}}, logs)
+}
@@ -16,6 +16,13 @@ import (
 	"github.com/pkg/errors"
 )
 
+const (
+	// callTracer is the native tracer used to build the flat call traces.
+	callTracer = "callTracer"
+	// prestateTracer is the native tracer used to build the state diffs.
+	prestateTracer = "prestateTracer"
+)
+
 // TraceTransaction returns the structured logs created during the execution of EVM
 // and returns them as a JSON object.
 func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error) {
@@ -168,6 +175,16 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		}
 	}
 
+	return b.traceMessages(height, config, block, txsMessages)
+}
+
+// traceMessages traces the given Ethereum messages on top of the state at the
+// beginning of the block. The return value will be one item per message.
+func (b *Backend) traceMessages(height rpctypes.BlockNumber,
+	config *evmtypes.TraceConfig,
+	block *tmrpctypes.ResultBlock,
+	txsMessages []*evmtypes.MsgEthereumTx,
+) ([]*evmtypes.TxTraceResult, error) {
 	// minus one to get the context at the beginning of the block
 	contextHeight := height - 1
 	if contextHeight < 1 {
@@ -202,10 +219,295 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		return nil, err
 	}
 
-	decodedResults := make([]*evmtypes.TxTraceResult, txsLength)
+	decodedResults := make([]*evmtypes.TxTraceResult, len(txsMessages))
 	if err := json.Unmarshal(res.Data, &decodedResults); err != nil {
 		return nil, err
 	}
 
 	return decodedResults, nil
 }
+
+// TraceCall traces the given call on top of the state of the requested block,
+// after applying the state and block overrides of the config, and returns the
+// tracer output as a JSON object.
+func (b *Backend) TraceCall(
+	args evmtypes.TransactionArgs,
+	blockNr rpctypes.BlockNumber,
+	config *rpctypes.TraceCallConfig,
+) (interface{}, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.QueryTraceCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	if config != nil {
+		req.TraceConfig = &config.TraceConfig
+		req.Overrides, req.BlockOverrides, err = marshalOverrides(config.StateOverrides, config.BlockOverrides)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	traceResult, err := b.queryClient.TraceCall(rpctypes.ContextWithHeight(blockNr.Int64()), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
+	var decodedResult interface{}
+	if err := json.Unmarshal(traceResult.Data, &decodedResult); err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
+
+// FlatTraceTransaction returns the Parity-style flat traces of the
+// transaction, built from the output of the callTracer.
+func (b *Backend) FlatTraceTransaction(hash common.Hash) ([]*rpctypes.LocalizedTrace, error) {
+	res, err := b.GetTxByEthHash(hash)
+	if err != nil {
+		b.logger.Debug("tx not found", "hash", hash)
+		return nil, err
+	}
+
+	blk, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(res.Height))
+	if err != nil {
+		b.logger.Debug("block not found", "height", res.Height)
+		return nil, err
+	}
+
+	result, err := b.TraceTransaction(hash, &evmtypes.TraceConfig{Tracer: callTracer})
+	if err != nil {
+		return nil, err
+	}
+
+	var frame rpctypes.CallFrame
+	if err := decodeTraceResult(result, &frame); err != nil {
+		return nil, err
+	}
+
+	return rpctypes.LocalizeTraces(
+		rpctypes.FlattenCallFrame(frame),
+		common.BytesToHash(blk.BlockID.Hash),
+		uint64(res.Height), //nolint:gosec // G115 -- block height is always positive
+		hash,
+		uint64(res.EthTxIndex), //nolint:gosec // G115 -- eth tx index is always positive for indexed txs
+	), nil
+}
+
+// FlatTraceBlock returns the Parity-style flat traces of all the Ethereum
+// transactions included in the block.
+func (b *Backend) FlatTraceBlock(block *tmrpctypes.ResultBlock) ([]*rpctypes.LocalizedTrace, error) {
+	msgs, err := b.blockEthMsgs(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// the blocks without Ethereum transactions have no traces
+	if len(msgs) == 0 {
+		return []*rpctypes.LocalizedTrace{}, nil
+	}
+
+	results, err := b.traceMessages(rpctypes.BlockNumber(block.Block.Height), &evmtypes.TraceConfig{Tracer: callTracer}, block, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHash := common.BytesToHash(block.BlockID.Hash)
+	traces := make([]*rpctypes.LocalizedTrace, 0, len(msgs))
+	for i, msg := range msgs {
+		var frame rpctypes.CallFrame
+		if err := decodeTxTraceResult(results, i, &frame); err != nil {
+			return nil, errors.Wrapf(err, "failed to trace tx %s", msg.Hash)
+		}
+
+		traces = append(traces, rpctypes.LocalizeTraces(
+			rpctypes.FlattenCallFrame(frame),
+			blockHash,
+			uint64(block.Block.Height), //nolint:gosec // G115 -- block height is always positive
+			common.HexToHash(msg.Hash),
+			uint64(i),
+		)...)
+	}
+
+	return traces, nil
+}
+
+// TraceFilter returns the Parity-style flat traces of the blocks in the given
+// range that match the address filters.
+func (b *Backend) TraceFilter(args rpctypes.TraceFilterArgs) ([]*rpctypes.LocalizedTrace, error) {
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := int64(latest), int64(latest) //nolint:gosec // G115 -- block number is always within int64 range
+	if args.FromBlock != nil && *args.FromBlock >= 0 {
+		from = args.FromBlock.Int64()
+	}
+	if args.ToBlock != nil && *args.ToBlock >= 0 {
+		to = args.ToBlock.Int64()
+	}
+
+	if from > to {
+		return nil, fmt.Errorf("invalid block range: from block %d is after to block %d", from, to)
+	}
+	if to-from > int64(b.RPCBlockRangeCap()) {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", b.RPCBlockRangeCap())
+	}
+
+	var (
+		skipped uint64
+		traces  = []*rpctypes.LocalizedTrace{}
+	)
+	for height := max(from, 1); height <= to; height++ {
+		blk, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		if blk == nil || blk.Block == nil {
+			return nil, fmt.Errorf("block %d not found", height)
+		}
+
+		// skip the empty blocks without querying their results and traces
+		if len(blk.Block.Txs) == 0 {
+			continue
+		}
+
+		blockTraces, err := b.FlatTraceBlock(blk)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, trace := range blockTraces {
+			if !args.Matches(&trace.Trace) {
+				continue
+			}
+			if args.After != nil && skipped < *args.After {
+				skipped++
+				continue
+			}
+			traces = append(traces, trace)
+			if args.Count != nil && uint64(len(traces)) >= *args.Count {
+				return traces, nil
+			}
+		}
+	}
+
+	return traces, nil
+}
+
+// ReplayBlockTransactions replays all the Ethereum transactions included in
+// the block and returns the requested trace types for each of them. The
+// supported trace types are "trace" and "stateDiff".
+func (b *Backend) ReplayBlockTransactions(block *tmrpctypes.ResultBlock, traceTypes []string) ([]*rpctypes.TraceReplayResult, error) {
+	var withTrace, withStateDiff bool
+	for _, traceType := range traceTypes {
+		switch traceType {
+		case rpctypes.TraceTypeTrace:
+			withTrace = true
+		case rpctypes.TraceTypeStateDiff:
+			withStateDiff = true
+		case rpctypes.TraceTypeVMTrace:
+			return nil, errors.New("vmTrace is not supported")
+		default:
+			return nil, fmt.Errorf("invalid trace type %s", traceType)
+		}
+	}
+
+	msgs, err := b.blockEthMsgs(block)
+	if err != nil {
+		return nil, err
+	}
+
+	height := rpctypes.BlockNumber(block.Block.Height)
+
+	// the call traces are always needed to return the output of the transactions
+	callResults, err := b.traceMessages(height, &evmtypes.TraceConfig{Tracer: callTracer}, block, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffResults []*evmtypes.TxTraceResult
+	if withStateDiff {
+		diffConfig := &evmtypes.TraceConfig{Tracer: prestateTracer, TracerJsonConfig: `{"diffMode":true}`}
+		if diffResults, err = b.traceMessages(height, diffConfig, block, msgs); err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]*rpctypes.TraceReplayResult, 0, len(msgs))
+	for i, msg := range msgs {
+		var frame rpctypes.CallFrame
+		if err := decodeTxTraceResult(callResults, i, &frame); err != nil {
+			return nil, errors.Wrapf(err, "failed to trace tx %s", msg.Hash)
+		}
+
+		result := &rpctypes.TraceReplayResult{
+			Output:          frame.Output,
+			TransactionHash: common.HexToHash(msg.Hash),
+		}
+		if withTrace {
+			result.Trace = rpctypes.FlattenCallFrame(frame)
+		}
+		if withStateDiff {
+			var diff rpctypes.PrestateDiff
+			if err := decodeTxTraceResult(diffResults, i, &diff); err != nil {
+				return nil, errors.Wrapf(err, "failed to trace tx %s", msg.Hash)
+			}
+			result.StateDiff = diff.ToStateDiff()
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// blockEthMsgs returns the Ethereum messages that were executed on the block.
+func (b *Backend) blockEthMsgs(block *tmrpctypes.ResultBlock) ([]*evmtypes.MsgEthereumTx, error) {
+	blockRes, err := b.TendermintBlockResultByNumber(&block.Block.Height)
+	if err != nil {
+		b.logger.Debug("block result not found", "height", block.Block.Height, "error", err.Error())
+		return nil, err
+	}
+
+	return b.EthMsgsFromTendermintBlock(block, blockRes), nil
+}
+
+// decodeTxTraceResult decodes the i-th result of a block trace into the given
+// tracer output.
+func decodeTxTraceResult(results []*evmtypes.TxTraceResult, i int, v interface{}) error {
+	if i >= len(results) || results[i] == nil {
+		return errors.New("missing trace result")
+	}
+	if results[i].Error != "" {
+		return errors.New(results[i].Error)
+	}
+	return decodeTraceResult(results[i].Result, v)
+}
+
+// decodeTraceResult decodes the generic JSON output of a tracer into the given
+// tracer output.
+func decodeTraceResult(result interface{}, v interface{}) error {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, v)
+}
@@ -1,7 +1,9 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/big"
 
 	"cosmossdk.io/log"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -10,11 +12,15 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/evmos/v20/crypto/ethsecp256k1"
 	"github.com/evmos/evmos/v20/indexer"
 	"github.com/evmos/evmos/v20/rpc/backend/mocks"
+	rpctypes "github.com/evmos/evmos/v20/rpc/types"
+	utiltx "github.com/evmos/evmos/v20/testutil/tx"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+	"google.golang.org/grpc/metadata"
 )
 
 func (suite *BackendTestSuite) TestTraceTransaction() {
@@ -260,3 +266,340 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestTraceCall() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := utiltx.GenerateAddress()
+	callArgs := evmtypes.TransactionArgs{To: &toAddr}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+
+	balance := (*hexutil.Big)(big.NewInt(1e18))
+	overrides := rpctypes.StateOverride{
+		toAddr: rpctypes.OverrideAccount{Balance: &balance},
+	}
+	overridesBz, err := json.Marshal(overrides)
+	suite.Require().NoError(err)
+
+	blockOverrides := rpctypes.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(100))}
+	blockOverridesBz, err := json.Marshal(blockOverrides)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		config       *rpctypes.TraceCallConfig
+		expResult    interface{}
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - trace call error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterTraceCallError(queryClient, &evmtypes.QueryTraceCallRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64()})
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - without config",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterTraceCall(queryClient, &evmtypes.QueryTraceCallRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64()})
+			},
+			nil,
+			map[string]interface{}{"test": "hello"},
+			true,
+		},
+		{
+			"pass - with tracer and overrides",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterTraceCall(queryClient, &evmtypes.QueryTraceCallRequest{
+					Args:           argsBz,
+					ChainId:        suite.backend.chainID.Int64(),
+					TraceConfig:    &evmtypes.TraceConfig{Tracer: callTracer},
+					Overrides:      overridesBz,
+					BlockOverrides: blockOverridesBz,
+				})
+			},
+			&rpctypes.TraceCallConfig{
+				TraceConfig:    evmtypes.TraceConfig{Tracer: callTracer},
+				StateOverrides: &overrides,
+				BlockOverrides: &blockOverrides,
+			},
+			map[string]interface{}{"test": "hello"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := suite.backend.TraceCall(callArgs, rpctypes.BlockNumber(1), tc.config)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, result)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestFlatTraceBlock() {
+	callTrace := []byte(`[{"result":{"type":"CALL","from":"0x0000000000000000000000000000000000000001","to":"0x0000000000000000000000000000000000000002","value":"0x0","gas":"0x186a0","gasUsed":"0x5208","input":"0x","output":"0x","calls":[{"type":"STATICCALL","from":"0x0000000000000000000000000000000000000002","to":"0x0000000000000000000000000000000000000003","gas":"0x100","gasUsed":"0x10","input":"0x","output":"0x01"}]}}]`)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expTraces    int
+		expPass      bool
+	}{
+		{
+			"fail - block results error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockResultsError(client, 1)
+			},
+			0,
+			false,
+		},
+		{
+			"fail - transaction trace error",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterTraceBlockWithTracer(queryClient, callTracer, []byte(`[{"error":"execution timeout"}]`))
+			},
+			0,
+			false,
+		},
+		{
+			"pass - flattens the call frames",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterTraceBlockWithTracer(queryClient, callTracer, callTrace)
+			},
+			2,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			msgEthTx, bz := suite.buildEthereumTx()
+			block := types.MakeBlock(1, []types.Tx{bz}, nil, nil)
+			resBlock := &tmrpctypes.ResultBlock{Block: block, BlockID: block.LastBlockID}
+			tc.registerMock()
+
+			traces, err := suite.backend.FlatTraceBlock(resBlock)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(traces, tc.expTraces)
+				suite.Require().Equal(msgEthTx.AsTransaction().Hash(), traces[0].TransactionHash)
+				suite.Require().Equal(uint64(1), traces[0].BlockNumber)
+				suite.Require().Equal(uint64(0), traces[0].TransactionPosition)
+				suite.Require().Equal([]int{}, traces[0].TraceAddress)
+				suite.Require().Equal(1, traces[0].Subtraces)
+				suite.Require().Equal([]int{0}, traces[1].TraceAddress)
+				suite.Require().Equal("staticcall", traces[1].Action.CallType)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestReplayBlockTransactions() {
+	callTrace := []byte(`[{"result":{"type":"CALL","from":"0x0000000000000000000000000000000000000001","to":"0x0000000000000000000000000000000000000002","value":"0x0","gas":"0x186a0","gasUsed":"0x5208","input":"0x","output":"0x01"}}]`)
+	diffTrace := []byte(`[{"result":{"pre":{"0x0000000000000000000000000000000000000001":{"balance":"0x10","nonce":1}},"post":{"0x0000000000000000000000000000000000000001":{"balance":"0x5","nonce":2}}}}]`)
+
+	testCases := []struct {
+		name         string
+		traceTypes   []string
+		registerMock func()
+		expTrace     bool
+		expStateDiff bool
+		expPass      bool
+	}{
+		{
+			"fail - vmTrace is not supported",
+			[]string{rpctypes.TraceTypeTrace, rpctypes.TraceTypeVMTrace},
+			func() {},
+			false,
+			false,
+			false,
+		},
+		{
+			"fail - invalid trace type",
+			[]string{"invalid"},
+			func() {},
+			false,
+			false,
+			false,
+		},
+		{
+			"pass - only the output",
+			[]string{},
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterTraceBlockWithTracer(queryClient, callTracer, callTrace)
+			},
+			false,
+			false,
+			true,
+		},
+		{
+			"pass - trace and state diff",
+			[]string{rpctypes.TraceTypeTrace, rpctypes.TraceTypeStateDiff},
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterTraceBlockWithTracer(queryClient, callTracer, callTrace)
+				RegisterTraceBlockWithTracer(queryClient, prestateTracer, diffTrace)
+			},
+			true,
+			true,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			msgEthTx, bz := suite.buildEthereumTx()
+			block := types.MakeBlock(1, []types.Tx{bz}, nil, nil)
+			resBlock := &tmrpctypes.ResultBlock{Block: block, BlockID: block.LastBlockID}
+			tc.registerMock()
+
+			results, err := suite.backend.ReplayBlockTransactions(resBlock, tc.traceTypes)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(results, 1)
+				suite.Require().Equal(msgEthTx.AsTransaction().Hash(), results[0].TransactionHash)
+				suite.Require().Equal(hexutil.Bytes{0x01}, results[0].Output)
+				suite.Require().Equal(tc.expTrace, results[0].Trace != nil)
+				suite.Require().Equal(tc.expStateDiff, results[0].StateDiff != nil)
+				if tc.expStateDiff {
+					accountDiff := results[0].StateDiff[common.BytesToAddress([]byte{0x01})]
+					suite.Require().NotNil(accountDiff)
+					suite.Require().Equal("=", accountDiff.Code)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestTraceFilter() {
+	var header metadata.MD
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		args         rpctypes.TraceFilterArgs
+		expPass      bool
+	}{
+		{
+			"fail - block range exceeds the cap",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(queryClient, &header, 1)
+			},
+			rpctypes.TraceFilterArgs{
+				FromBlock: blockNumberPtr(1),
+				ToBlock:   blockNumberPtr(int64(suite.backend.RPCBlockRangeCap()) + 2),
+			},
+			false,
+		},
+		{
+			"pass - empty block is not traced",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterParams(queryClient, &header, 1)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+			},
+			rpctypes.TraceFilterArgs{FromBlock: blockNumberPtr(1), ToBlock: blockNumberPtr(1)},
+			true,
+		},
+		{
+			"pass - blocks without Ethereum transactions are not traced",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterParams(queryClient, &header, 1)
+				_, err := RegisterBlock(client, 1, []byte("cosmos tx"))
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+			},
+			rpctypes.TraceFilterArgs{FromBlock: blockNumberPtr(1), ToBlock: blockNumberPtr(1)},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			traces, err := suite.backend.TraceFilter(tc.args)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Empty(traces)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func blockNumberPtr(height int64) *rpctypes.BlockNumber {
+	blockNumber := rpctypes.BlockNumber(height)
+	return &blockNumber
+}
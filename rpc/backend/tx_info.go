@@ -9,6 +9,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -182,13 +183,6 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 
 	cumulativeGasUsed += res.CumulativeGasUsed
 
-	var status hexutil.Uint
-	if res.Failed {
-		status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
-	} else {
-		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
-	}
-
 	chainID, err := b.ChainID()
 	if err != nil {
 		return nil, err
@@ -201,7 +195,7 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 
 	// parse tx logs from events
 	msgIndex := int(res.MsgIndex) // #nosec G701 -- checked for int overflow already
-	logs, err := TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, msgIndex)
+	logs, err := rpctypes.TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, msgIndex)
 	if err != nil {
 		b.logger.Debug("failed to parse logs", "hash", hexTx, "error", err.Error())
 	}
@@ -221,6 +215,185 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		return nil, errors.New("can't find index of ethereum tx")
 	}
 
+	var baseFee *big.Int
+	if isDynamicFeeTx(txData) {
+		baseFee, err = b.BaseFee(blockRes)
+		if err != nil {
+			// tolerate the error for pruned node.
+			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
+		}
+	}
+
+	blockHash := common.BytesToHash(resBlock.Block.Header.Hash())
+	return b.formatTxReceipt(ethMsg, txData, res, from, blockHash, cumulativeGasUsed, logs, baseFee), nil
+}
+
+// GetBlockReceipts returns the receipts of all the Ethereum transactions of the
+// given block. The receipts are built from a single fetch of the block results,
+// computing the cumulative gas used and the log indices once for the whole block.
+func (b *Backend) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil || resBlock == nil || resBlock.Block == nil {
+		b.logger.Debug("block not found", "height", blockNum)
+		return nil, nil
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", resBlock.Block.Height, "error", err.Error())
+		return nil, nil
+	}
+
+	chainID, err := b.ChainID()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		blockHash         = common.BytesToHash(resBlock.Block.Header.Hash())
+		receipts          = make([]map[string]interface{}, 0, len(resBlock.Block.Txs))
+		cumulativeGasUsed uint64
+		ethTxIndex        int32
+		baseFee           *big.Int
+		baseFeeFetched    bool
+	)
+
+	for i, txBz := range resBlock.Block.Txs {
+		txResult := blockRes.TxsResults[i]
+		// the gas used by the previous transactions of the block
+		blockGasUsed := cumulativeGasUsed
+		cumulativeGasUsed += uint64(txResult.GasUsed) //nolint:gosec // G115 -- gas used is never negative
+
+		if !rpctypes.TxSucessOrExpectedFailure(txResult) {
+			continue
+		}
+
+		tx, err := b.clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			b.logger.Debug("failed to decode transaction in block", "height", resBlock.Block.Height, "error", err.Error())
+			continue
+		}
+
+		var parsedTxs *rpctypes.ParsedTxs
+		for msgIndex, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+
+			res, err := b.blockTxResult(ethMsg, txResult, tx, &parsedTxs, resBlock.Block.Height, i, msgIndex)
+			if err != nil {
+				return nil, err
+			}
+			if res.EthTxIndex == -1 {
+				res.EthTxIndex = ethTxIndex
+			}
+			ethTxIndex++
+
+			txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+			if err != nil {
+				b.logger.Error("failed to unpack tx data", "error", err.Error())
+				return nil, err
+			}
+
+			from, err := ethMsg.GetSender(chainID.ToInt())
+			if err != nil {
+				return nil, err
+			}
+
+			logs, err := rpctypes.TxLogsFromEvents(txResult.Events, msgIndex)
+			if err != nil {
+				b.logger.Debug("failed to parse logs", "hash", ethMsg.TxHash().Hex(), "error", err.Error())
+			}
+
+			if isDynamicFeeTx(txData) && !baseFeeFetched {
+				baseFeeFetched = true
+				baseFee, err = b.BaseFee(blockRes)
+				if err != nil {
+					// tolerate the error for pruned node.
+					b.logger.Error("fetch basefee failed, node is pruned?", "height", resBlock.Block.Height, "error", err)
+				}
+			}
+
+			receipts = append(receipts, b.formatTxReceipt(
+				ethMsg, txData, res, from, blockHash, blockGasUsed+res.CumulativeGasUsed, logs, baseFee,
+			))
+		}
+	}
+
+	return receipts, nil
+}
+
+// blockTxResult returns the result of the Ethereum message at msgIndex of the
+// cosmos transaction at txIndex. It uses the EVMTxIndexer when it's enabled and
+// falls back to parsing the events of the transaction result, which are parsed
+// at most once per cosmos transaction.
+func (b *Backend) blockTxResult(
+	ethMsg *evmtypes.MsgEthereumTx,
+	txResult *abci.ExecTxResult,
+	tx sdk.Tx,
+	parsedTxs **rpctypes.ParsedTxs,
+	height int64,
+	txIndex, msgIndex int,
+) (*types.TxResult, error) {
+	hash := ethMsg.TxHash()
+	if b.indexer != nil {
+		res, err := b.indexer.GetByTxHash(hash)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to get tx %s from indexer", hash.Hex())
+		}
+		return res, nil
+	}
+
+	if *parsedTxs == nil {
+		txs, err := rpctypes.ParseTxResult(txResult, tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse tx events: block %d, index %d, %v", height, txIndex, err)
+		}
+		*parsedTxs = txs
+	}
+
+	parsedTx := (*parsedTxs).GetTxByMsgIndex(msgIndex)
+	if parsedTx == nil {
+		return nil, fmt.Errorf("ethereum tx not found in msgs: block %d, index %d", height, txIndex)
+	}
+
+	return &types.TxResult{
+		Height:            height,
+		TxIndex:           uint32(txIndex),  //nolint:gosec // G115 -- block txs count fits in uint32
+		MsgIndex:          uint32(msgIndex), //nolint:gosec // G115 -- msgs count fits in uint32
+		EthTxIndex:        parsedTx.EthTxIndex,
+		Failed:            parsedTx.Failed,
+		GasUsed:           parsedTx.GasUsed,
+		CumulativeGasUsed: (*parsedTxs).AccumulativeGasUsed(msgIndex),
+	}, nil
+}
+
+// formatTxReceipt returns the receipt of an Ethereum transaction in the format of the
+// JSON-RPC API. The base fee is only used for dynamic fee transactions and can be nil
+// if it's not available.
+func (b *Backend) formatTxReceipt(
+	ethMsg *evmtypes.MsgEthereumTx,
+	txData evmtypes.TxData,
+	res *types.TxResult,
+	from common.Address,
+	blockHash common.Hash,
+	cumulativeGasUsed uint64,
+	logs []*ethtypes.Log,
+	baseFee *big.Int,
+) map[string]interface{} {
+	var status hexutil.Uint
+	if res.Failed {
+		status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
+	} else {
+		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
+	}
+
 	receipt := map[string]interface{}{
 		// Consensus fields: These fields are defined by the Yellow Paper
 		"status":            status,
@@ -230,20 +403,20 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 
 		// Implementation fields: These fields are added by geth when processing a transaction.
 		// They are stored in the chain database.
-		"transactionHash": hash,
+		"transactionHash": ethMsg.TxHash(),
 		"contractAddress": nil,
 		"gasUsed":         hexutil.Uint64(b.GetGasUsed(res, txData.GetGasPrice(), txData.GetGas())),
 
 		// Inclusion information: These fields provide information about the inclusion of the
 		// transaction corresponding to this receipt.
-		"blockHash":        common.BytesToHash(resBlock.Block.Header.Hash()).Hex(),
+		"blockHash":        blockHash.Hex(),
 		"blockNumber":      hexutil.Uint64(res.Height),     //nolint:gosec // G115
 		"transactionIndex": hexutil.Uint64(res.EthTxIndex), //nolint:gosec // G115
 
 		// sender and receiver (contract or EOA) addreses
 		"from": from,
 		"to":   txData.GetTo(),
-		"type": hexutil.Uint(ethMsg.AsTransaction().Type()),
+		"type": hexutil.Uint(txData.TxType()),
 	}
 
 	if logs == nil {
@@ -255,17 +428,18 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
 	}
 
-	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
-		baseFee, err := b.BaseFee(blockRes)
-		if err != nil {
-			// tolerate the error for pruned node.
-			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
-		} else {
-			receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
-		}
+	if isDynamicFeeTx(txData) && baseFee != nil {
+		receipt["effectiveGasPrice"] = hexutil.Big(*txData.EffectiveGasPrice(baseFee))
 	}
 
-	return receipt, nil
+	return receipt
+}
+
+// isDynamicFeeTx returns true if the price of the transaction depends on the base
+// fee, i.e. for dynamic fee and set code transactions.
+func isDynamicFeeTx(txData evmtypes.TxData) bool {
+	txType := txData.TxType()
+	return txType == ethtypes.DynamicFeeTxType || txType == evmtypes.SetCodeTxType
 }
 
 // GetTransactionLogs returns the transaction logs identified by hash.
@@ -291,7 +465,7 @@ func (b *Backend) GetTransactionLogs(hash common.Hash) ([]*ethtypes.Log, error)
 
 	// parse tx logs from events
 	index := int(res.MsgIndex) // #nosec G701
-	return TxLogsFromEvents(resBlockResult.TxsResults[res.TxIndex].Events, index)
+	return rpctypes.TxLogsFromEvents(resBlockResult.TxsResults[res.TxIndex].Events, index)
 }
 
 // GetTransactionByBlockHashAndIndex returns the transaction identified by hash and index.
@@ -12,6 +12,7 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/evmos/v20/indexer"
 	"github.com/evmos/evmos/v20/rpc/backend/mocks"
 	rpctypes "github.com/evmos/evmos/v20/rpc/types"
@@ -612,6 +613,132 @@ func (suite *BackendTestSuite) TestGetTransactionReceipt() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetBlockReceipts() {
+	// ethTxResult returns the result of a cosmos tx with a single ethereum tx
+	ethTxResult := func(txBz []byte, txIndex int, gasUsed int64) *abci.ExecTxResult {
+		tx, err := suite.backend.clientCtx.TxConfig.TxDecoder()(txBz)
+		suite.Require().NoError(err)
+		txHash := tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx).AsTransaction().Hash()
+		return &abci.ExecTxResult{
+			Code:    0,
+			GasUsed: gasUsed,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: fmt.Sprintf("%d", txIndex)},
+					{Key: "amount", Value: "0"},
+					{Key: "txGasUsed", Value: fmt.Sprintf("%d", gasUsed)},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: common.Address{}.Hex()},
+				}},
+			},
+		}
+	}
+
+	// signEthTx returns an encoded transaction signed by the suite account for the
+	// chain id of the chain config, which is the one used to recover the sender
+	signEthTx := func(nonce uint64) []byte {
+		msgEthereumTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  suite.backend.ChainConfig().ChainID,
+			Nonce:    nonce,
+			To:       &common.Address{},
+			Amount:   big.NewInt(0),
+			GasLimit: 100000,
+			GasPrice: big.NewInt(1),
+		})
+		msgEthereumTx.From = suite.from.Hex()
+		suite.Require().NoError(msgEthereumTx.Sign(ethtypes.LatestSigner(suite.backend.ChainConfig()), suite.signer))
+
+		tx, err := msgEthereumTx.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), evmtypes.GetEVMCoinDenom())
+		suite.Require().NoError(err)
+		txBz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(tx)
+		suite.Require().NoError(err)
+		return txBz
+	}
+
+	blockNrOrHash := rpctypes.BlockNumberOrHash{BlockNumber: func() *rpctypes.BlockNumber { n := rpctypes.BlockNumber(1); return &n }()}
+
+	testCases := []struct {
+		name          string
+		registerMock  func(txs []types.Tx, txsResults []*abci.ExecTxResult)
+		useIndexer    bool
+		expCumulative []uint64
+	}{
+		{
+			"pass - block not found returns no receipts",
+			func([]types.Tx, []*abci.ExecTxResult) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			false,
+			nil,
+		},
+		{
+			"pass - receipts parsed from the block results",
+			func(txs []types.Tx, txsResults []*abci.ExecTxResult) {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterParams(queryClient, &header, 1)
+				_, err := RegisterBlockMultipleTxs(client, 1, txs)
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResultsWithTxs(client, 1, txsResults)
+				suite.Require().NoError(err)
+			},
+			false,
+			[]uint64{21000, 51000},
+		},
+		{
+			"pass - receipts from the EVM tx indexer",
+			func(txs []types.Tx, txsResults []*abci.ExecTxResult) {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterParams(queryClient, &header, 1)
+				_, err := RegisterBlockMultipleTxs(client, 1, txs)
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResultsWithTxs(client, 1, txsResults)
+				suite.Require().NoError(err)
+			},
+			true,
+			[]uint64{21000, 51000},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			txs := []types.Tx{signEthTx(0), signEthTx(1)}
+			txsResults := []*abci.ExecTxResult{ethTxResult(txs[0], 0, 21000), ethTxResult(txs[1], 1, 30000)}
+			tc.registerMock(txs, txsResults)
+
+			suite.backend.indexer = nil
+			if tc.useIndexer {
+				db := dbm.NewMemDB()
+				suite.backend.indexer = indexer.NewKVIndexer(db, log.NewNopLogger(), suite.backend.clientCtx)
+				block := &types.Block{Header: types.Header{Height: 1}, Data: types.Data{Txs: txs}}
+				suite.Require().NoError(suite.backend.indexer.IndexBlock(block, txsResults))
+			}
+
+			receipts, err := suite.backend.GetBlockReceipts(blockNrOrHash)
+			suite.Require().NoError(err)
+			suite.Require().Len(receipts, len(tc.expCumulative))
+
+			for i, receipt := range receipts {
+				tx, err := suite.backend.clientCtx.TxConfig.TxDecoder()(txs[i])
+				suite.Require().NoError(err)
+				ethTx := tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx).AsTransaction()
+
+				suite.Require().Equal(ethTx.Hash(), receipt["transactionHash"])
+				suite.Require().Equal(hexutil.Uint64(i), receipt["transactionIndex"])
+				suite.Require().Equal(hexutil.Uint64(tc.expCumulative[i]), receipt["cumulativeGasUsed"])
+				suite.Require().Equal(hexutil.Uint(ethtypes.ReceiptStatusSuccessful), receipt["status"])
+				suite.Require().Equal(suite.from, receipt["from"])
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetGasUsed() {
 	origin := suite.backend.cfg.JSONRPC.FixRevertGasRefundHeight
 	testCases := []struct {
@@ -0,0 +1,137 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package backend
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	rpctypes "github.com/evmos/evmos/v20/rpc/types"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// TxPoolContent returns the Ethereum transactions contained in the node's mempool, grouped
+// by sender and nonce. The transactions are split into pending ones, which are executable
+// on top of the sender's committed nonce, and queued ones, which are nonce-gapped and
+// cannot be executed until the missing nonces are filled. The transactions are read from
+// the app-side mempool when it's enabled, since the CometBFT mempool may still hold the
+// ones it has replaced or evicted, and from the CometBFT mempool otherwise.
+func (b *Backend) TxPoolContent() (
+	pending, queued map[common.Address]map[uint64]*rpctypes.RPCTransaction,
+	err error,
+) {
+	pending = make(map[common.Address]map[uint64]*rpctypes.RPCTransaction)
+	queued = make(map[common.Address]map[uint64]*rpctypes.RPCTransaction)
+
+	txs, err := b.txPoolTransactions()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// group the transactions by sender and nonce
+	txsBySender := make(map[common.Address]map[uint64]*rpctypes.RPCTransaction)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not valid ethereum tx
+				break
+			}
+
+			sender, err := ethMsg.GetSender(b.chainID)
+			if err != nil {
+				b.logger.Debug("failed to recover tx sender", "hash", ethMsg.Hash, "error", err.Error())
+				continue
+			}
+
+			rpcTx, err := rpctypes.NewTransactionFromMsg(
+				ethMsg,
+				common.Hash{},
+				uint64(0),
+				uint64(0),
+				nil,
+				b.chainID,
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if _, ok := txsBySender[sender]; !ok {
+				txsBySender[sender] = make(map[uint64]*rpctypes.RPCTransaction)
+			}
+
+			// a replaced transaction is kept by CometBFT until the mempool is rechecked,
+			// so only the one with the highest price, which replaced the others, is kept
+			nonce := uint64(rpcTx.Nonce)
+			if pooled, ok := txsBySender[sender][nonce]; ok && pooled.GasPrice.ToInt().Cmp(rpcTx.GasPrice.ToInt()) >= 0 {
+				continue
+			}
+			txsBySender[sender][nonce] = rpcTx
+		}
+	}
+
+	for sender, senderTxs := range txsBySender {
+		nonce, err := b.committedNonce(sender)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		nonces := make([]uint64, 0, len(senderTxs))
+		for n := range senderTxs {
+			nonces = append(nonces, n)
+		}
+		sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+
+		// transactions are pending as long as their nonces are sequential starting from
+		// the committed account nonce; every transaction after the first gap is queued
+		for _, n := range nonces {
+			switch {
+			case n < nonce:
+				// stale transaction that will be rejected on the next recheck
+				continue
+			case n == nonce:
+				if _, ok := pending[sender]; !ok {
+					pending[sender] = make(map[uint64]*rpctypes.RPCTransaction)
+				}
+				pending[sender][n] = senderTxs[n]
+				nonce++
+			default:
+				if _, ok := queued[sender]; !ok {
+					queued[sender] = make(map[uint64]*rpctypes.RPCTransaction)
+				}
+				queued[sender][n] = senderTxs[n]
+			}
+		}
+	}
+
+	return pending, queued, nil
+}
+
+// txPoolTransactions returns the transactions of the app-side mempool if it's
+// enabled, or the ones of the CometBFT mempool otherwise.
+func (b *Backend) txPoolTransactions() ([]*sdk.Tx, error) {
+	if b.txPool == nil {
+		return b.PendingTransactions()
+	}
+
+	poolTxs := b.txPool.Txs()
+	txs := make([]*sdk.Tx, len(poolTxs))
+	for i := range poolTxs {
+		txs[i] = &poolTxs[i]
+	}
+	return txs, nil
+}
+
+// committedNonce returns the nonce of the given account at the latest committed block,
+// without taking into account the transactions in the mempool.
+func (b *Backend) committedNonce(address common.Address) (uint64, error) {
+	from := sdk.AccAddress(address.Bytes())
+	if err := b.clientCtx.AccountRetriever.EnsureExists(b.clientCtx, from); err != nil {
+		// account doesn't exist yet, return 0
+		return 0, nil
+	}
+
+	return b.getAccountNonce(address, false, 0, b.logger)
+}
@@ -0,0 +1,157 @@
+package backend
+
+import (
+	"math/big"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v20/rpc/backend/mocks"
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// buildSignedEthereumTx returns a signed and encoded legacy Ethereum transaction
+// with the given nonce sent from the suite account.
+func (suite *BackendTestSuite) buildSignedEthereumTx(nonce uint64) []byte {
+	return suite.buildSignedEthereumTxWithPrice(nonce, big.NewInt(1))
+}
+
+// buildSignedEthereumTxWithPrice returns a signed and encoded legacy Ethereum
+// transaction with the given nonce and gas price sent from the suite account.
+func (suite *BackendTestSuite) buildSignedEthereumTxWithPrice(nonce uint64, gasPrice *big.Int) []byte {
+	msgEthereumTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  suite.backend.chainID,
+		Nonce:    nonce,
+		To:       &common.Address{},
+		Amount:   big.NewInt(0),
+		GasLimit: 100000,
+		GasPrice: gasPrice,
+	})
+	msgEthereumTx.From = suite.from.Hex()
+
+	ethSigner := ethtypes.LatestSignerForChainID(suite.backend.chainID)
+	err := msgEthereumTx.Sign(ethSigner, suite.signer)
+	suite.Require().NoError(err)
+
+	tx, err := msgEthereumTx.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), evmtypes.GetEVMCoinDenom())
+	suite.Require().NoError(err)
+
+	bz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+	return bz
+}
+
+func (suite *BackendTestSuite) TestTxPoolContent() {
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expPending   []uint64
+		expQueued    []uint64
+		expPass      bool
+	}{
+		{
+			"fail - pending transactions returns error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxsError(client, nil)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - empty mempool",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxsEmpty(client, nil)
+			},
+			nil,
+			nil,
+			true,
+		},
+		{
+			"pass - sequential nonces are pending",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, types.Txs{
+					suite.buildSignedEthereumTx(1),
+					suite.buildSignedEthereumTx(0),
+				})
+			},
+			[]uint64{0, 1},
+			nil,
+			true,
+		},
+		{
+			"pass - nonce-gapped transactions are queued",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, types.Txs{
+					suite.buildSignedEthereumTx(0),
+					suite.buildSignedEthereumTx(1),
+					suite.buildSignedEthereumTx(3),
+					suite.buildSignedEthereumTx(4),
+				})
+			},
+			[]uint64{0, 1},
+			[]uint64{3, 4},
+			true,
+		},
+		{
+			"pass - all transactions queued when the next nonce is missing",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, types.Txs{
+					suite.buildSignedEthereumTx(2),
+				})
+			},
+			nil,
+			[]uint64{2},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			pending, queued, err := suite.backend.TxPoolContent()
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Len(pending[suite.from], len(tc.expPending))
+			for _, nonce := range tc.expPending {
+				suite.Require().Contains(pending[suite.from], nonce)
+				suite.Require().Equal(suite.from, pending[suite.from][nonce].From)
+			}
+			suite.Require().Len(queued[suite.from], len(tc.expQueued))
+			for _, nonce := range tc.expQueued {
+				suite.Require().Contains(queued[suite.from], nonce)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestTxPoolContentReplacement() {
+	suite.SetupTest()
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	RegisterUnconfirmedTxs(client, nil, types.Txs{
+		suite.buildSignedEthereumTxWithPrice(0, big.NewInt(1)),
+		suite.buildSignedEthereumTxWithPrice(0, big.NewInt(3)),
+		suite.buildSignedEthereumTxWithPrice(0, big.NewInt(2)),
+		suite.buildSignedEthereumTx(1),
+	})
+
+	pending, queued, err := suite.backend.TxPoolContent()
+	suite.Require().NoError(err)
+	suite.Require().Empty(queued)
+	suite.Require().Len(pending[suite.from], 2)
+
+	// the replacement with the highest price is the one reported
+	suite.Require().Equal(big.NewInt(3), pending[suite.from][0].GasPrice.ToInt())
+}
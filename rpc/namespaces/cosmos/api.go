@@ -0,0 +1,83 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package cosmos
+
+import (
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/rpc/backend"
+	rpctypes "github.com/evmos/evmos/v20/rpc/types"
+	"github.com/evmos/evmos/v20/utils"
+)
+
+// API is the collection of lookups between the Cosmos and Ethereum
+// representations of the chain: transaction hashes, events, addresses and
+// balances.
+type API struct {
+	logger  log.Logger
+	backend backend.BackendI
+}
+
+// NewAPI creates a new API definition for the cosmos namespace.
+func NewAPI(
+	ctx *server.Context,
+	backend backend.BackendI,
+) *API {
+	return &API{
+		logger:  ctx.Logger.With("module", "cosmos"),
+		backend: backend,
+	}
+}
+
+// GetCosmosTxHash returns the hash of the Cosmos transaction that includes the
+// Ethereum transaction.
+func (api *API) GetCosmosTxHash(hash common.Hash) (string, error) {
+	api.logger.Debug("cosmos_getCosmosTxHash", "hash", hash)
+	return api.backend.CosmosTxHashByEthHash(hash)
+}
+
+// GetEthTxHashes returns the hashes of the Ethereum transactions included in the
+// Cosmos transaction.
+func (api *API) GetEthTxHashes(hash string) ([]common.Hash, error) {
+	api.logger.Debug("cosmos_getEthTxHashes", "hash", hash)
+	return api.backend.EthTxHashesByCosmosHash(hash)
+}
+
+// GetTxEvents returns the Cosmos events emitted by the transaction that includes
+// the Ethereum transaction.
+func (api *API) GetTxEvents(hash common.Hash) ([]abci.Event, error) {
+	api.logger.Debug("cosmos_getTxEvents", "hash", hash)
+	return api.backend.CosmosTxEventsByEthHash(hash)
+}
+
+// GetAllBalances returns the bank balances in all the denominations of the
+// address at the given block.
+func (api *API) GetAllBalances(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (sdk.Coins, error) {
+	api.logger.Debug("cosmos_getAllBalances", "address", address, "block number or hash", blockNrOrHash)
+	return api.backend.CosmosBalances(address, blockNrOrHash)
+}
+
+// HexToBech32 returns the bech32 representation of the hex address. The prefix
+// defaults to the chain's account prefix.
+func (api *API) HexToBech32(address common.Address, prefix *string) (string, error) {
+	api.logger.Debug("cosmos_hexToBech32", "address", address)
+	if prefix == nil || *prefix == "" {
+		return utils.EthToCosmosAddr(address).String(), nil
+	}
+	return sdk.Bech32ifyAddressBytes(*prefix, address.Bytes())
+}
+
+// Bech32ToHex returns the hex representation of the bech32 address, whatever
+// its prefix.
+func (api *API) Bech32ToHex(address string) (common.Address, error) {
+	api.logger.Debug("cosmos_bech32ToHex", "address", address)
+	accAddr, err := utils.GetEvmosAddressFromBech32(address)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return utils.CosmosToEthAddr(accAddr), nil
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	evmostypes "github.com/evmos/evmos/v20/types"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
@@ -45,6 +46,7 @@ type Backend interface {
 	TendermintBlockResultByNumber(height *int64) (*coretypes.ResultBlockResults, error)
 	GetLogs(blockHash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(*int64) ([][]*ethtypes.Log, error)
+	IndexedLogBlocks(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, bool, error)
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 
 	BloomStatus() (uint64, uint64)
@@ -176,7 +178,7 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 					for _, msg := range tx.GetMsgs() {
 						ethTx, ok := msg.(*evmtypes.MsgEthereumTx)
 						if ok {
-							f.hashes = append(f.hashes, ethTx.AsTransaction().Hash())
+							f.hashes = append(f.hashes, ethTx.TxHash())
 						}
 					}
 				}
@@ -194,12 +196,23 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 
 // NewPendingTransactions creates a subscription that is triggered each time a transaction
 // enters the transaction pool and was signed from one of the transactions this nodes manages.
-func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Subscription, error) {
+// The criteria select between the transaction hashes and the full transactions, and
+// optionally restrict them to the given senders and recipients.
+func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context, crit *types.PendingTxsCriteria) (*rpc.Subscription, error) {
 	notifier, supported := rpc.NotifierFromContext(ctx)
 	if !supported {
 		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
 	}
 
+	if crit == nil {
+		crit = &types.PendingTxsCriteria{}
+	}
+
+	chainID, err := evmostypes.ParseChainID(api.clientCtx.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
 	rpcSub := notifier.CreateSubscription()
 
 	ctx, cancelFn := context.WithTimeout(context.Background(), deadline)
@@ -237,12 +250,17 @@ func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Su
 					continue
 				}
 
+				ethTxs := make([]*evmtypes.MsgEthereumTx, 0, len(tx.GetMsgs()))
 				for _, msg := range tx.GetMsgs() {
 					ethTx, ok := msg.(*evmtypes.MsgEthereumTx)
 					if ok {
-						_ = notifier.Notify(rpcSub.ID, ethTx.AsTransaction().Hash()) // #nosec G703
+						ethTxs = append(ethTxs, ethTx)
 					}
 				}
+
+				for _, result := range crit.Results(ethTxs, chainID) {
+					_ = notifier.Notify(rpcSub.ID, result) // #nosec G703
+				}
 			case <-rpcSub.Err():
 				pendingTxSub.Unsubscribe(api.events)
 				return
@@ -142,10 +142,6 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 		f.criteria.ToBlock = big.NewInt(1)
 	}
 
-	if f.criteria.ToBlock.Int64()-f.criteria.FromBlock.Int64() > blockLimit {
-		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
-	}
-
 	// check bounds
 	if f.criteria.FromBlock.Int64() > head {
 		return []*ethtypes.Log{}, nil
@@ -156,6 +152,21 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	from := f.criteria.FromBlock.Int64()
 	to := f.criteria.ToBlock.Int64()
 
+	// jump straight to the matching blocks if the EVM indexer keeps the log index
+	// of the range. The block range isn't capped in that case, since only the
+	// matching blocks are fetched and the number of logs is still limited.
+	heights, indexed, err := f.backend.IndexedLogBlocks(from, to, f.criteria.Addresses, f.criteria.Topics)
+	if err != nil {
+		return nil, err
+	}
+	if indexed {
+		return f.indexedLogs(heights, logLimit)
+	}
+
+	if to-from > blockLimit {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	}
+
 	for height := from; height <= to; height++ {
 		blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
 		if err != nil {
@@ -182,6 +193,30 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	return logs, nil
 }
 
+// indexedLogs returns the logs matching the filter criteria within the given blocks.
+func (f *Filter) indexedLogs(heights []int64, logLimit int) ([]*ethtypes.Log, error) {
+	logs := []*ethtypes.Log{}
+	for _, height := range heights {
+		logsList, err := f.backend.GetLogsByHeight(&height)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch logs block number %d", height)
+		}
+
+		unfiltered := make([]*ethtypes.Log, 0)
+		for _, txLogs := range logsList {
+			unfiltered = append(unfiltered, txLogs...)
+		}
+		filtered := FilterLogs(unfiltered, nil, nil, f.criteria.Addresses, f.criteria.Topics)
+
+		// check logs limit
+		if len(logs)+len(filtered) > logLimit {
+			return nil, fmt.Errorf("query returned more than %d results", logLimit)
+		}
+		logs = append(logs, filtered...)
+	}
+	return logs, nil
+}
+
 // blockLogs returns the logs matching the filter criteria within a single block.
 func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
 	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
@@ -0,0 +1,67 @@
+package filters
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/rpc/types"
+)
+
+// indexedBackend is a backend whose EVM indexer keeps the log index of the
+// whole chain, unless disabled.
+type indexedBackend struct {
+	Backend
+
+	head    int64
+	indexed bool
+	logs    map[int64][]*ethtypes.Log
+}
+
+func (b indexedBackend) HeaderByNumber(types.BlockNumber) (*ethtypes.Header, error) {
+	return &ethtypes.Header{Number: big.NewInt(b.head)}, nil
+}
+
+func (b indexedBackend) IndexedLogBlocks(from, to int64, _ []common.Address, _ [][]common.Hash) ([]int64, bool, error) {
+	if !b.indexed {
+		return nil, false, nil
+	}
+
+	var heights []int64
+	for height := range b.logs {
+		if height >= from && height <= to {
+			heights = append(heights, height)
+		}
+	}
+	return heights, true, nil
+}
+
+func (b indexedBackend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
+	return [][]*ethtypes.Log{b.logs[*height]}, nil
+}
+
+func TestLogsBlockLimit(t *testing.T) {
+	address := common.HexToAddress("0xc0de")
+	log1 := &ethtypes.Log{Address: address, BlockNumber: 1}
+	backend := indexedBackend{
+		head:    100_000,
+		indexed: true,
+		logs:    map[int64][]*ethtypes.Log{1: {log1}},
+	}
+
+	// the block range isn't capped when it's covered by the log index
+	filter := NewRangeFilter(log.NewNopLogger(), backend, 1, 100_000, []common.Address{address}, nil)
+	logs, err := filter.Logs(context.Background(), 100, 10_000)
+	require.NoError(t, err)
+	require.Equal(t, []*ethtypes.Log{log1}, logs)
+
+	backend.indexed = false
+	filter = NewRangeFilter(log.NewNopLogger(), backend, 1, 100_000, []common.Address{address}, nil)
+	_, err = filter.Logs(context.Background(), 100, 10_000)
+	require.ErrorContains(t, err, "maximum [from, to] blocks distance: 10000")
+}
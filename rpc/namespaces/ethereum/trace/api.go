@@ -0,0 +1,94 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package trace
+
+import (
+	"errors"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/rpc/backend"
+	rpctypes "github.com/evmos/evmos/v20/rpc/types"
+)
+
+// API is the collection of Parity-style (OpenEthereum) tracing APIs. The flat
+// traces are built from the output of the native callTracer.
+type API struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates a new API definition for the Parity-style tracing methods.
+func NewAPI(
+	ctx *server.Context,
+	backend backend.EVMBackend,
+) *API {
+	return &API{
+		logger:  ctx.Logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+// Block returns the flat traces of all the transactions included in the block.
+func (api *API) Block(blockNr rpctypes.BlockNumber) ([]*rpctypes.LocalizedTrace, error) {
+	api.logger.Debug("trace_block", "number", blockNr)
+	if blockNr == rpctypes.EthEarliestBlockNumber {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	resBlock, err := api.backend.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		api.logger.Debug("get block failed", "number", blockNr, "error", err.Error())
+		return nil, err
+	}
+
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, nil
+	}
+
+	return api.backend.FlatTraceBlock(resBlock)
+}
+
+// Transaction returns the flat traces of the transaction.
+func (api *API) Transaction(hash common.Hash) ([]*rpctypes.LocalizedTrace, error) {
+	api.logger.Debug("trace_transaction", "hash", hash)
+	return api.backend.FlatTraceTransaction(hash)
+}
+
+// Filter returns the flat traces of the given block range that match the
+// sender and recipient addresses.
+func (api *API) Filter(args rpctypes.TraceFilterArgs) ([]*rpctypes.LocalizedTrace, error) {
+	api.logger.Debug("trace_filter", "args", args)
+	return api.backend.TraceFilter(args)
+}
+
+// ReplayBlockTransactions replays all the transactions of the block and returns
+// the requested trace types ("trace" and/or "stateDiff") for each of them.
+func (api *API) ReplayBlockTransactions(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	traceTypes []string,
+) ([]*rpctypes.TraceReplayResult, error) {
+	api.logger.Debug("trace_replayBlockTransactions", "block", blockNrOrHash, "types", traceTypes)
+
+	blockNr, err := api.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	if blockNr == rpctypes.EthEarliestBlockNumber {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	resBlock, err := api.backend.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		api.logger.Debug("get block failed", "number", blockNr, "error", err.Error())
+		return nil, err
+	}
+
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, nil
+	}
+
+	return api.backend.ReplayBlockTransactions(resBlock, traceTypes)
+}
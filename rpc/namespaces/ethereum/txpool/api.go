@@ -3,23 +3,30 @@
 package txpool
 
 import (
+	"fmt"
+
 	"cosmossdk.io/log"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/evmos/evmos/v20/rpc/backend"
 	"github.com/evmos/evmos/v20/rpc/types"
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/evmos/ethermint/issues/124
+// The transaction pool is backed by the CometBFT mempool, so the pending and queued sets are computed
+// from the unconfirmed transactions and the committed nonce of each sender.
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend backend.EVMBackend
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
@@ -30,24 +37,90 @@ func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTran
 		"pending": make(map[string]map[string]*types.RPCTransaction),
 		"queued":  make(map[string]map[string]*types.RPCTransaction),
 	}
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	for account, txs := range pending {
+		dump := make(map[string]*types.RPCTransaction, len(txs))
+		for nonce, tx := range txs {
+			dump[fmt.Sprintf("%d", nonce)] = tx
+		}
+		content["pending"][account.Hex()] = dump
+	}
+	for account, txs := range queued {
+		dump := make(map[string]*types.RPCTransaction, len(txs))
+		for nonce, tx := range txs {
+			dump[fmt.Sprintf("%d", nonce)] = tx
+		}
+		content["queued"][account.Hex()] = dump
+	}
+
 	return content, nil
 }
 
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list.
 func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
 	content := map[string]map[string]map[string]string{
 		"pending": make(map[string]map[string]string),
 		"queued":  make(map[string]map[string]string),
 	}
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	for account, txs := range pending {
+		dump := make(map[string]string, len(txs))
+		for nonce, tx := range txs {
+			dump[fmt.Sprintf("%d", nonce)] = formatInspect(tx)
+		}
+		content["pending"][account.Hex()] = dump
+	}
+	for account, txs := range queued {
+		dump := make(map[string]string, len(txs))
+		for nonce, tx := range txs {
+			dump[fmt.Sprintf("%d", nonce)] = formatInspect(tx)
+		}
+		content["queued"][account.Hex()] = dump
+	}
+
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
-func (api *PublicAPI) Status() map[string]hexutil.Uint {
+func (api *PublicAPI) Status() (map[string]hexutil.Uint, error) {
 	api.logger.Debug("txpool_status")
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(countTxs(pending)),
+		"queued":  hexutil.Uint(countTxs(queued)),
+	}, nil
+}
+
+// formatInspect returns the geth-compatible summary of a transaction used by txpool_inspect.
+func formatInspect(tx *types.RPCTransaction) string {
+	if tx.To != nil {
+		return fmt.Sprintf("%s: %v wei + %v gas × %v wei", tx.To.Hex(), tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt())
+	}
+	return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt())
+}
+
+// countTxs returns the total number of transactions in the given sender to nonce mapping.
+func countTxs(txs map[common.Address]map[uint64]*types.RPCTransaction) int {
+	count := 0
+	for _, senderTxs := range txs {
+		count += len(senderTxs)
 	}
+	return count
 }
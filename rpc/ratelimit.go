@@ -0,0 +1,418 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package rpc
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+	"golang.org/x/time/rate"
+
+	"github.com/evmos/evmos/v20/server/config"
+)
+
+const (
+	// RateLimitErrorCode is the JSON-RPC error code returned to rate limited requests.
+	// It matches the "limit exceeded" code used by the Ethereum JSON-RPC providers.
+	RateLimitErrorCode = -32005
+
+	// maxRateLimitedBodySize is the max number of bytes of the request body that are
+	// parsed to get the requested methods. It matches the go-ethereum request size limit.
+	maxRateLimitedBodySize = 5 * 1024 * 1024
+
+	// ipLimitersSweepInterval is the interval at which the idle per-IP limiters are removed.
+	ipLimitersSweepInterval = time.Minute
+
+	// forwardedForHeader is the header set by the reverse proxies with the client IP.
+	forwardedForHeader = "X-Forwarded-For"
+	// wsForwardedHeader is the header of the WebSocket requests forwarded to the HTTP
+	// server, which are already rate limited by the WebSocket server.
+	wsForwardedHeader = "X-Evmos-Ws-Forwarded"
+)
+
+var (
+	rateLimitAcceptedCounter       = metrics.NewRegisteredCounter("rpc/ratelimit/accepted", nil)
+	rateLimitUnitsCounter          = metrics.NewRegisteredCounter("rpc/ratelimit/units", nil)
+	rateLimitRejectedIPCounter     = metrics.NewRegisteredCounter("rpc/ratelimit/rejected/ip", nil)
+	rateLimitRejectedGlobalCounter = metrics.NewRegisteredCounter("rpc/ratelimit/rejected/global", nil)
+	rateLimitRejectedTraceCounter  = metrics.NewRegisteredCounter("rpc/ratelimit/rejected/traces", nil)
+)
+
+// rateLimitMessage is the subset of a JSON-RPC request used to compute its cost.
+type rateLimitMessage struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+}
+
+type rateLimitErrorMessage struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type rateLimitErrorResponse struct {
+	Jsonrpc string                `json:"jsonrpc"`
+	ID      json.RawMessage       `json:"id"`
+	Error   rateLimitErrorMessage `json:"error"`
+}
+
+// ipLimiter is the token bucket of a single client IP.
+type ipLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// RateLimiter throttles the HTTP and WebSocket JSON-RPC requests. Each request costs
+// the sum of the weights of its methods, which are taken from a per-IP and a global
+// token bucket. The number of trace requests served at once is limited too.
+type RateLimiter struct {
+	global *rate.Limiter
+	traces chan struct{}
+
+	trustedProxies []*net.IPNet
+	// wsToken authenticates the requests forwarded by the WebSocket server, so
+	// that they aren't charged twice to the loopback address.
+	wsToken string
+
+	perIPRate  rate.Limit
+	perIPBurst int
+
+	exactWeights  map[string]int
+	prefixWeights map[string]int
+
+	mu        sync.Mutex
+	ips       map[string]*ipLimiter
+	lastSweep time.Time
+}
+
+// NewRateLimiter creates a new JSON-RPC rate limiter from the given configuration.
+func NewRateLimiter(cfg config.JSONRPCConfig) (*RateLimiter, error) {
+	weights, err := cfg.MethodWeights()
+	if err != nil {
+		return nil, err
+	}
+
+	trustedProxies, err := cfg.TrustedProxies()
+	if err != nil {
+		return nil, err
+	}
+
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+
+	l := &RateLimiter{
+		trustedProxies: trustedProxies,
+		wsToken:        hex.EncodeToString(token),
+		perIPRate:      rate.Inf,
+		perIPBurst:     cfg.RateLimitPerIPBurst,
+		exactWeights:   make(map[string]int),
+		prefixWeights:  make(map[string]int),
+		ips:            make(map[string]*ipLimiter),
+		lastSweep:      time.Now(),
+	}
+
+	if cfg.RateLimitGlobal > 0 {
+		l.global = rate.NewLimiter(rate.Limit(cfg.RateLimitGlobal), cfg.RateLimitGlobalBurst)
+	}
+
+	if cfg.RateLimitPerIP > 0 {
+		l.perIPRate = rate.Limit(cfg.RateLimitPerIP)
+	}
+
+	if cfg.MaxConcurrentTraces > 0 {
+		l.traces = make(chan struct{}, cfg.MaxConcurrentTraces)
+	}
+
+	for method, weight := range weights {
+		if prefix, ok := strings.CutSuffix(method, "*"); ok {
+			l.prefixWeights[prefix] = weight
+			continue
+		}
+		l.exactWeights[method] = weight
+	}
+
+	return l, nil
+}
+
+// Weight returns the cost in request units of the given method. Exact matches take
+// precedence over the longest matching prefix. Methods that aren't listed cost 1 unit.
+func (l *RateLimiter) Weight(method string) int {
+	if weight, ok := l.exactWeights[method]; ok {
+		return weight
+	}
+
+	weight, matched := 1, ""
+	for prefix, w := range l.prefixWeights {
+		if strings.HasPrefix(method, prefix) && len(prefix) >= len(matched) {
+			weight, matched = w, prefix
+		}
+	}
+	return weight
+}
+
+// Handler wraps the given JSON-RPC handler with the rate limiter. Requests that
+// cannot be parsed cost 1 unit and are then passed through, so that the JSON-RPC
+// server returns the corresponding error. Requests whose body exceeds the max
+// request size are rejected.
+func (l *RateLimiter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRateLimitedBodySize+1))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if len(body) > maxRateLimitedBodySize {
+			http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		msgs, batch, _ := parseRateLimitMessages(body)
+
+		// the WebSocket requests are charged to the client IP by the WebSocket server
+		if r.Header.Get(wsForwardedHeader) != l.wsToken {
+			if !l.Allow(l.ClientIP(r), body) {
+				writeRateLimitError(w, msgs, batch, "rate limit exceeded")
+				return
+			}
+		}
+
+		traces := 0
+		for _, msg := range msgs {
+			if isTraceMethod(msg.Method) {
+				traces++
+			}
+		}
+
+		if !l.acquireTraces(traces) {
+			rateLimitRejectedTraceCounter.Inc(1)
+			writeRateLimitError(w, msgs, batch, "too many concurrent trace requests")
+			return
+		}
+		defer l.releaseTraces(traces)
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Allow takes the cost of the given request body from the client IP and the
+// global token buckets. A request that cannot be parsed costs 1 unit.
+func (l *RateLimiter) Allow(ip string, body []byte) bool {
+	cost := 1
+	if msgs, _, ok := parseRateLimitMessages(body); ok {
+		cost = 0
+		for _, msg := range msgs {
+			cost += l.Weight(msg.Method)
+		}
+	}
+
+	if !l.allow(ip, cost) {
+		return false
+	}
+
+	rateLimitAcceptedCounter.Inc(1)
+	rateLimitUnitsCounter.Inc(int64(cost))
+	return true
+}
+
+// ForwardedHeader returns the header to set on the WebSocket requests forwarded
+// to the HTTP server, so that they aren't rate limited twice.
+func (l *RateLimiter) ForwardedHeader() (string, string) {
+	return wsForwardedHeader, l.wsToken
+}
+
+// allow takes the given cost from the client IP and the global token buckets. No
+// tokens are taken if any of the buckets doesn't hold enough of them.
+func (l *RateLimiter) allow(ip string, cost int) bool {
+	now := time.Now()
+
+	var ipRes *rate.Reservation
+	if l.perIPRate != rate.Inf {
+		ipRes = l.ipLimiter(ip, now).ReserveN(now, cost)
+		if !ipRes.OK() || ipRes.DelayFrom(now) > 0 {
+			ipRes.CancelAt(now)
+			rateLimitRejectedIPCounter.Inc(1)
+			return false
+		}
+	}
+
+	if l.global == nil {
+		return true
+	}
+
+	globalRes := l.global.ReserveN(now, cost)
+	if !globalRes.OK() || globalRes.DelayFrom(now) > 0 {
+		globalRes.CancelAt(now)
+		if ipRes != nil {
+			ipRes.CancelAt(now)
+		}
+		rateLimitRejectedGlobalCounter.Inc(1)
+		return false
+	}
+
+	return true
+}
+
+// ipLimiter returns the token bucket of the client IP, creating it if needed. The
+// limiters that are full again are removed periodically, since they are in the same
+// state as a newly created one.
+func (l *RateLimiter) ipLimiter(ip string, now time.Time) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastSweep) >= ipLimitersSweepInterval {
+		for key, entry := range l.ips {
+			if entry.limiter.TokensAt(now) >= float64(l.perIPBurst) && now.Sub(entry.lastSeen) >= ipLimitersSweepInterval {
+				delete(l.ips, key)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	entry, ok := l.ips[ip]
+	if !ok {
+		entry = &ipLimiter{limiter: rate.NewLimiter(l.perIPRate, l.perIPBurst)}
+		l.ips[ip] = entry
+	}
+	entry.lastSeen = now
+
+	return entry.limiter
+}
+
+// acquireTraces takes n slots of the concurrent traces limit without blocking.
+func (l *RateLimiter) acquireTraces(n int) bool {
+	if l.traces == nil {
+		return true
+	}
+
+	for i := 0; i < n; i++ {
+		select {
+		case l.traces <- struct{}{}:
+		default:
+			l.releaseTraces(i)
+			return false
+		}
+	}
+	return true
+}
+
+// releaseTraces returns n slots of the concurrent traces limit.
+func (l *RateLimiter) releaseTraces(n int) {
+	if l.traces == nil {
+		return
+	}
+
+	for i := 0; i < n; i++ {
+		<-l.traces
+	}
+}
+
+// isTraceMethod returns true if the method replays transactions to trace them.
+func isTraceMethod(method string) bool {
+	return strings.HasPrefix(method, "debug_trace") || strings.HasPrefix(method, "trace_")
+}
+
+// parseRateLimitMessages decodes a single or a batch JSON-RPC request.
+func parseRateLimitMessages(body []byte) ([]rateLimitMessage, bool, bool) {
+	body = bytes.TrimLeft(body, " \t\r\n")
+	if len(body) > 0 && body[0] == '[' {
+		var msgs []rateLimitMessage
+		if err := json.Unmarshal(body, &msgs); err != nil || len(msgs) == 0 {
+			return nil, true, false
+		}
+		return msgs, true, true
+	}
+
+	var msg rateLimitMessage
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return nil, false, false
+	}
+	return []rateLimitMessage{msg}, false, true
+}
+
+// ClientIP returns the IP address of the client that sent the request. The
+// X-Forwarded-For header is only used if the request comes from a trusted proxy,
+// in which case the client IP is the last address of the header that isn't a
+// trusted proxy, since the previous ones can be forged by the client.
+func (l *RateLimiter) ClientIP(r *http.Request) string {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+	if !l.isTrustedProxy(ip) {
+		return ip
+	}
+
+	var forwarded []string
+	for _, header := range r.Header.Values(forwardedForHeader) {
+		forwarded = append(forwarded, strings.Split(header, ",")...)
+	}
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(forwarded[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		ip = hop
+		if !l.isTrustedProxy(hop) {
+			break
+		}
+	}
+	return ip
+}
+
+// isTrustedProxy returns true if the IP address belongs to a trusted proxy.
+func (l *RateLimiter) isTrustedProxy(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, proxy := range l.trustedProxies {
+		if proxy.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeRateLimitError writes a JSON-RPC error for each of the rejected messages.
+func writeRateLimitError(w http.ResponseWriter, msgs []rateLimitMessage, batch bool, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+	_ = json.NewEncoder(w).Encode(rateLimitErrorResponses(msgs, batch, message))
+}
+
+// rateLimitErrorResponses returns a JSON-RPC error for each of the rejected
+// messages, or a single error without ID if the request couldn't be parsed.
+func rateLimitErrorResponses(msgs []rateLimitMessage, batch bool, message string) interface{} {
+	if len(msgs) == 0 {
+		msgs, batch = []rateLimitMessage{{}}, false
+	}
+
+	responses := make([]rateLimitErrorResponse, len(msgs))
+	for i, msg := range msgs {
+		id := msg.ID
+		if len(id) == 0 {
+			id = json.RawMessage("null")
+		}
+		responses[i] = rateLimitErrorResponse{
+			Jsonrpc: "2.0",
+			ID:      id,
+			Error:   rateLimitErrorMessage{Code: RateLimitErrorCode, Message: message},
+		}
+	}
+
+	if batch {
+		return responses
+	}
+	return responses[0]
+}
@@ -0,0 +1,243 @@
+package rpc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/server/config"
+)
+
+func newTestRateLimiter(t *testing.T, modify func(cfg *config.JSONRPCConfig)) *RateLimiter {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.EnableRateLimit = true
+	cfg.RateLimitGlobal = 0
+	if modify != nil {
+		modify(cfg)
+	}
+	require.NoError(t, cfg.Validate())
+
+	limiter, err := NewRateLimiter(*cfg)
+	require.NoError(t, err)
+	return limiter
+}
+
+func doRateLimitedRequest(handler http.Handler, ip, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.RemoteAddr = ip + ":1234"
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+// echoHandler replies with the request body, to check that it's forwarded unchanged.
+var echoHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	var body json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	_, _ = w.Write(body)
+})
+
+func TestRateLimiterWeight(t *testing.T) {
+	limiter := newTestRateLimiter(t, func(cfg *config.JSONRPCConfig) {
+		cfg.RateLimitMethodWeights = []string{"eth_getLogs=10", "debug_*=50", "debug_trace*=80", "debug_traceTransaction=100"}
+	})
+
+	require.Equal(t, 1, limiter.Weight("eth_blockNumber"))
+	require.Equal(t, 10, limiter.Weight("eth_getLogs"))
+	require.Equal(t, 50, limiter.Weight("debug_getRawBlock"))
+	require.Equal(t, 80, limiter.Weight("debug_traceBlockByNumber"))
+	require.Equal(t, 100, limiter.Weight("debug_traceTransaction"))
+}
+
+func TestRateLimiterPerIP(t *testing.T) {
+	limiter := newTestRateLimiter(t, func(cfg *config.JSONRPCConfig) {
+		cfg.RateLimitPerIP = 0.001
+		cfg.RateLimitPerIPBurst = 12
+	})
+	handler := limiter.Handler(echoHandler)
+
+	getLogs := `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[]}`
+	rec := doRateLimitedRequest(handler, "10.0.0.1", getLogs)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, getLogs, rec.Body.String())
+
+	// the bucket holds 2 units, which isn't enough for another eth_getLogs
+	rec = doRateLimitedRequest(handler, "10.0.0.1", getLogs)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":1,"error":{"code":-32005,"message":"rate limit exceeded"}}`, rec.Body.String())
+
+	// but it's enough for cheaper requests
+	rec = doRateLimitedRequest(handler, "10.0.0.1", `{"jsonrpc":"2.0","id":2,"method":"eth_blockNumber"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// other clients have their own bucket
+	rec = doRateLimitedRequest(handler, "10.0.0.2", getLogs)
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestRateLimiterGlobal(t *testing.T) {
+	limiter := newTestRateLimiter(t, func(cfg *config.JSONRPCConfig) {
+		cfg.RateLimitGlobal = 0.001
+		cfg.RateLimitGlobalBurst = 3
+	})
+	handler := limiter.Handler(echoHandler)
+
+	batch := `[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":"a","method":"eth_blockNumber"}]`
+	rec := doRateLimitedRequest(handler, "10.0.0.1", batch)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// batches cost the sum of their methods and are rejected as a whole
+	rec = doRateLimitedRequest(handler, "10.0.0.2", batch)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.JSONEq(t, `[
+		{"jsonrpc":"2.0","id":1,"error":{"code":-32005,"message":"rate limit exceeded"}},
+		{"jsonrpc":"2.0","id":"a","error":{"code":-32005,"message":"rate limit exceeded"}}
+	]`, rec.Body.String())
+
+	rec = doRateLimitedRequest(handler, "10.0.0.2", `{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestRateLimiterConcurrentTraces(t *testing.T) {
+	limiter := newTestRateLimiter(t, func(cfg *config.JSONRPCConfig) {
+		cfg.MaxConcurrentTraces = 1
+	})
+
+	started, done := make(chan struct{}), make(chan struct{})
+	handler := limiter.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.RawQuery, "block") {
+			close(started)
+			<-done
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	trace := `{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction","params":[]}`
+	finished := make(chan int)
+	go func() {
+		req := httptest.NewRequest(http.MethodPost, "/?block", strings.NewReader(trace))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		finished <- rec.Code
+	}()
+	<-started
+
+	rec := doRateLimitedRequest(handler, "10.0.0.2", trace)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Contains(t, rec.Body.String(), "too many concurrent trace requests")
+
+	// other methods are not limited
+	rec = doRateLimitedRequest(handler, "10.0.0.2", `{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[]}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	close(done)
+	require.Equal(t, http.StatusOK, <-finished)
+
+	rec = doRateLimitedRequest(handler, "10.0.0.2", trace)
+	require.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestRateLimiterInvalidRequest(t *testing.T) {
+	limiter := newTestRateLimiter(t, func(cfg *config.JSONRPCConfig) {
+		cfg.RateLimitPerIP = 0.001
+		cfg.RateLimitPerIPBurst = 1
+	})
+
+	called := 0
+	handler := limiter.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called++
+	}))
+
+	// requests that can't be parsed cost 1 unit and are left to the JSON-RPC server
+	rec := doRateLimitedRequest(handler, "10.0.0.1", `{"jsonrpc":`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, 1, called)
+
+	rec = doRateLimitedRequest(handler, "10.0.0.1", `{"jsonrpc":`)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":null,"error":{"code":-32005,"message":"rate limit exceeded"}}`, rec.Body.String())
+	require.Equal(t, 1, called)
+}
+
+func TestRateLimiterBodyTooLarge(t *testing.T) {
+	limiter := newTestRateLimiter(t, nil)
+
+	called := false
+	handler := limiter.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	body := `{"jsonrpc":"2.0","id":1,"method":"eth_call","params":["` + strings.Repeat("0", maxRateLimitedBodySize) + `"]}`
+	rec := doRateLimitedRequest(handler, "10.0.0.1", body)
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	require.False(t, called)
+}
+
+func TestRateLimiterClientIP(t *testing.T) {
+	limiter := newTestRateLimiter(t, func(cfg *config.JSONRPCConfig) {
+		cfg.RateLimitTrustedProxies = []string{"127.0.0.1", "10.0.0.0/8"}
+	})
+
+	testCases := []struct {
+		name       string
+		remoteAddr string
+		forwarded  []string
+		expIP      string
+	}{
+		{"no proxy", "1.2.3.4:1234", nil, "1.2.3.4"},
+		{"untrusted proxy", "1.2.3.4:1234", []string{"5.6.7.8"}, "1.2.3.4"},
+		{"trusted proxy", "127.0.0.1:1234", []string{"5.6.7.8"}, "5.6.7.8"},
+		{"trusted proxy without header", "127.0.0.1:1234", nil, "127.0.0.1"},
+		{"chain of trusted proxies", "127.0.0.1:1234", []string{"5.6.7.8, 10.0.0.2"}, "5.6.7.8"},
+		{"forged addresses before the client", "127.0.0.1:1234", []string{"9.9.9.9, 5.6.7.8"}, "5.6.7.8"},
+		{"multiple headers", "127.0.0.1:1234", []string{"9.9.9.9", "5.6.7.8, 10.0.0.2"}, "5.6.7.8"},
+		{"invalid forwarded address", "127.0.0.1:1234", []string{"5.6.7.8, unknown"}, "127.0.0.1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.RemoteAddr = tc.remoteAddr
+			for _, header := range tc.forwarded {
+				req.Header.Add(forwardedForHeader, header)
+			}
+			require.Equal(t, tc.expIP, limiter.ClientIP(req))
+		})
+	}
+}
+
+func TestRateLimiterWebsocketForwarded(t *testing.T) {
+	limiter := newTestRateLimiter(t, func(cfg *config.JSONRPCConfig) {
+		cfg.RateLimitPerIP = 0.001
+		cfg.RateLimitPerIPBurst = 1
+	})
+	handler := limiter.Handler(echoHandler)
+	body := `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`
+
+	// the requests forwarded by the WebSocket server are already rate limited
+	for i := 0; i < 3; i++ {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.RemoteAddr = "127.0.0.1:1234"
+		req.Header.Set(limiter.ForwardedHeader())
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+	}
+
+	// a forged token doesn't bypass the limiter
+	for i, expCode := range []int{http.StatusOK, http.StatusTooManyRequests} {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.RemoteAddr = "127.0.0.1:1234"
+		req.Header.Set(wsForwardedHeader, "forged")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, expCode, rec.Code, "request %d", i)
+	}
+}
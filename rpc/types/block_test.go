@@ -78,6 +78,33 @@ func TestUnmarshalBlockNumberOrHash(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"String input with block number finalized",
+			[]byte("\"finalized\""),
+			func() {
+				require.Equal(t, *bnh.BlockNumber, EthLatestBlockNumber)
+				require.Nil(t, bnh.BlockHash)
+			},
+			true,
+		},
+		{
+			"String input with block number safe",
+			[]byte("\"safe\""),
+			func() {
+				require.Equal(t, *bnh.BlockNumber, EthLatestBlockNumber)
+				require.Nil(t, bnh.BlockHash)
+			},
+			true,
+		},
+		{
+			"JSON input with block number safe",
+			[]byte("{\"blockNumber\": \"safe\"}"),
+			func() {
+				require.Equal(t, *bnh.BlockNumber, EthLatestBlockNumber)
+				require.Nil(t, bnh.BlockHash)
+			},
+			true,
+		},
 		{
 			"String input with block number overflow",
 			[]byte("\"0xffffffffffffffffffffffffffffffffffffff\""),
@@ -100,3 +127,33 @@ func TestUnmarshalBlockNumberOrHash(t *testing.T) {
 		}
 	}
 }
+
+func TestUnmarshalBlockNumber(t *testing.T) {
+	testCases := []struct {
+		msg     string
+		input   string
+		expNum  BlockNumber
+		expPass bool
+	}{
+		{"earliest", `"earliest"`, EthEarliestBlockNumber, true},
+		{"latest", `"latest"`, EthLatestBlockNumber, true},
+		{"finalized is the latest block", `"finalized"`, EthLatestBlockNumber, true},
+		{"safe is the latest block", `"safe"`, EthLatestBlockNumber, true},
+		{"pending", `"pending"`, EthPendingBlockNumber, true},
+		{"hex number", `"0x35"`, BlockNumber(0x35), true},
+		{"invalid hex number", `"0xzz"`, 0, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			var bn BlockNumber
+			err := bn.UnmarshalJSON([]byte(tc.input))
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expNum, bn)
+		})
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/store/rootmulti"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// ProofOp is a CometBFT Merkle proof operation. The proofs of the IAVL stores are
+// made of an "ics23:iavl" operation, which proves the key in the module store,
+// followed by an "ics23:simple" operation, which proves the module store root in
+// the app hash.
+type ProofOp struct {
+	Type string        `json:"type"`
+	Key  hexutil.Bytes `json:"key"`
+	Data hexutil.Bytes `json:"data"`
+}
+
+// StoreProof is the ICS-23 proof of the value of a key in a module store. An empty
+// value means that the proof is an absence proof.
+type StoreProof struct {
+	StoreKey string        `json:"storeKey"`
+	Key      hexutil.Bytes `json:"key"`
+	Value    hexutil.Bytes `json:"value"`
+	Proof    []ProofOp     `json:"proof"`
+}
+
+// CosmosAccountProof holds the ICS-23 proofs of the fields of an EVM account:
+//   - Account: the x/auth account, which holds the nonce.
+//   - Balance: the x/bank balance of the EVM denom.
+//   - CodeHash: the x/evm code hash, which is only stored for contracts.
+//
+// The proofs are for the state at the given height, whose app hash is included in
+// the header of the block at Height + 1.
+type CosmosAccountProof struct {
+	Height   hexutil.Uint64 `json:"height"`
+	Account  StoreProof     `json:"account"`
+	Balance  StoreProof     `json:"balance"`
+	CodeHash StoreProof     `json:"codeHash"`
+}
+
+// NewStoreProof creates a StoreProof from the result of an ABCI store query.
+func NewStoreProof(storeKey string, key, value []byte, proof *crypto.ProofOps) *StoreProof {
+	res := &StoreProof{
+		StoreKey: storeKey,
+		Key:      key,
+		Value:    value,
+		Proof:    []ProofOp{},
+	}
+	if proof == nil {
+		return res
+	}
+
+	for _, op := range proof.Ops {
+		res.Proof = append(res.Proof, ProofOp{Type: op.Type, Key: op.Key, Data: op.Data})
+	}
+	return res
+}
+
+// AuthAccountKey returns the key of the account of the given address in the x/auth store.
+func AuthAccountKey(address common.Address) []byte {
+	return append(authtypes.AddressStoreKeyPrefix.Bytes(), address.Bytes()...)
+}
+
+// BankBalanceKey returns the key of the balance of the given address and denom in the
+// x/bank store.
+func BankBalanceKey(address common.Address, denom string) ([]byte, error) {
+	keyCodec := collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey)
+	return collections.EncodeKeyWithPrefix(
+		banktypes.BalancesPrefix.Bytes(),
+		keyCodec,
+		collections.Join(sdk.AccAddress(address.Bytes()), denom),
+	)
+}
+
+// Verify checks the proof of the value against the app hash. The store and key of
+// the proof must match the given ones, so that the proof can't be used for another
+// key.
+func (p StoreProof) Verify(appHash []byte, storeKey string, key []byte) error {
+	if p.StoreKey != storeKey || !bytes.Equal(p.Key, key) {
+		return fmt.Errorf("proof of key %s/%x, expected %s/%x", p.StoreKey, []byte(p.Key), storeKey, key)
+	}
+
+	proofOps := &crypto.ProofOps{Ops: make([]crypto.ProofOp, len(p.Proof))}
+	for i, op := range p.Proof {
+		proofOps.Ops[i] = crypto.ProofOp{Type: op.Type, Key: op.Key, Data: op.Data}
+	}
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(storeKey), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex).
+		String()
+
+	prt := rootmulti.DefaultProofRuntime()
+	if len(p.Value) == 0 {
+		return prt.VerifyAbsence(proofOps, appHash, keyPath)
+	}
+	return prt.VerifyValue(proofOps, appHash, keyPath, p.Value)
+}
+
+// VerifyAccountProof verifies the Cosmos proofs of an eth_getProof result against
+// the app hash of the block at CosmosProof.Height + 1. Besides checking the proofs,
+// it checks that the proven values match the nonce, balance, code hash and storage
+// values of the result. The codec must be able to decode the accounts of the chain,
+// and the coin info is the one of the EVM denom of the chain.
+func VerifyAccountProof(cdc codec.BinaryCodec, coinInfo evmtypes.EvmCoinInfo, res *AccountResult, appHash []byte) error {
+	if res.CosmosProof == nil {
+		return fmt.Errorf("missing cosmos proof of account %s", res.Address)
+	}
+	proof := res.CosmosProof
+
+	// nonce
+	if err := proof.Account.Verify(appHash, authtypes.StoreKey, AuthAccountKey(res.Address)); err != nil {
+		return fmt.Errorf("invalid account proof: %w", err)
+	}
+
+	nonce := uint64(0)
+	if len(proof.Account.Value) > 0 {
+		var account sdk.AccountI
+		if err := cdc.UnmarshalInterface(proof.Account.Value, &account); err != nil {
+			return fmt.Errorf("failed to decode account: %w", err)
+		}
+		nonce = account.GetSequence()
+	}
+	if nonce != uint64(res.Nonce) {
+		return fmt.Errorf("proven nonce %d doesn't match %d", nonce, uint64(res.Nonce))
+	}
+
+	// balance
+	balanceKey, err := BankBalanceKey(res.Address, coinInfo.Denom)
+	if err != nil {
+		return err
+	}
+	if err := proof.Balance.Verify(appHash, banktypes.StoreKey, balanceKey); err != nil {
+		return fmt.Errorf("invalid balance proof: %w", err)
+	}
+
+	balance := new(big.Int)
+	if len(proof.Balance.Value) > 0 {
+		amount, err := banktypes.BalanceValueCodec.Decode(proof.Balance.Value)
+		if err != nil {
+			return fmt.Errorf("failed to decode balance: %w", err)
+		}
+		balance = amount.Mul(coinInfo.Decimals.ConversionFactor()).BigInt()
+	}
+	if res.Balance == nil || balance.Cmp(res.Balance.ToInt()) != 0 {
+		return fmt.Errorf("proven balance %s doesn't match %s", balance, res.Balance)
+	}
+
+	// code hash, which isn't stored for accounts without code
+	if err := proof.CodeHash.Verify(appHash, evmtypes.StoreKey, evmtypes.CodeHashKey(res.Address)); err != nil {
+		return fmt.Errorf("invalid code hash proof: %w", err)
+	}
+
+	codeHash := common.BytesToHash(evmtypes.EmptyCodeHash)
+	if len(proof.CodeHash.Value) > 0 {
+		codeHash = common.BytesToHash(proof.CodeHash.Value)
+	}
+	if codeHash != res.CodeHash {
+		return fmt.Errorf("proven code hash %s doesn't match %s", codeHash, res.CodeHash)
+	}
+
+	// storage, where the zero values aren't stored
+	for _, storage := range res.StorageProof {
+		if storage.CosmosProof == nil {
+			return fmt.Errorf("missing cosmos proof of storage key %s", storage.Key)
+		}
+
+		key := evmtypes.StateKey(res.Address, common.HexToHash(storage.Key).Bytes())
+		if err := storage.CosmosProof.Verify(appHash, evmtypes.StoreKey, key); err != nil {
+			return fmt.Errorf("invalid proof of storage key %s: %w", storage.Key, err)
+		}
+
+		value := new(big.Int).SetBytes(storage.CosmosProof.Value)
+		if storage.Value == nil || value.Cmp(storage.Value.ToInt()) != 0 {
+			return fmt.Errorf("proven value %s of storage key %s doesn't match %s", value, storage.Key, storage.Value)
+		}
+	}
+
+	return nil
+}
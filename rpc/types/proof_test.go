@@ -0,0 +1,207 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func TestVerifyAccountProof(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	coinInfo := evmtypes.EvmCoinInfo{Denom: "uevmos", DisplayDenom: "evmos", Decimals: evmtypes.SixDecimals}
+
+	contract := common.HexToAddress("0x01")
+	eoa := common.HexToAddress("0x02")
+	codeHash := common.HexToHash("0xc0de")
+	slot, emptySlot := common.HexToHash("0x1"), common.HexToHash("0x2")
+
+	// commit the state of the accounts to a multistore
+	store := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	keys := storetypes.NewKVStoreKeys(authtypes.StoreKey, banktypes.StoreKey, evmtypes.StoreKey)
+	for _, key := range keys {
+		store.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, store.LoadLatestVersion())
+
+	account := authtypes.NewBaseAccount(contract.Bytes(), nil, 1, 3)
+	accountBz, err := cdc.MarshalInterface(account)
+	require.NoError(t, err)
+	store.GetKVStore(keys[authtypes.StoreKey]).Set(AuthAccountKey(contract), accountBz)
+
+	balanceKey, err := BankBalanceKey(contract, coinInfo.Denom)
+	require.NoError(t, err)
+	balanceBz, err := math.NewInt(5).Marshal()
+	require.NoError(t, err)
+	store.GetKVStore(keys[banktypes.StoreKey]).Set(balanceKey, balanceBz)
+
+	evmStore := store.GetKVStore(keys[evmtypes.StoreKey])
+	evmStore.Set(evmtypes.CodeHashKey(contract), codeHash.Bytes())
+	evmStore.Set(evmtypes.StateKey(contract, slot.Bytes()), common.BigToHash(big.NewInt(7)).Bytes())
+
+	commitID := store.Commit()
+	appHash := commitID.Hash
+
+	storeProof := func(storeKey string, key []byte) *StoreProof {
+		res, err := store.Query(&storetypes.RequestQuery{
+			Path:   "/" + storeKey + "/key",
+			Data:   key,
+			Height: commitID.Version,
+			Prove:  true,
+		})
+		require.NoError(t, err)
+		return NewStoreProof(storeKey, key, res.Value, res.ProofOps)
+	}
+
+	accountResult := func(address common.Address, slots ...common.Hash) *AccountResult {
+		balanceKey, err := BankBalanceKey(address, coinInfo.Denom)
+		require.NoError(t, err)
+
+		res := &AccountResult{
+			Address:      address,
+			Balance:      (*hexutil.Big)(big.NewInt(0)),
+			CodeHash:     common.BytesToHash(evmtypes.EmptyCodeHash),
+			StorageProof: []StorageResult{},
+			CosmosProof: &CosmosAccountProof{
+				Height:   hexutil.Uint64(commitID.Version), //#nosec G115
+				Account:  *storeProof(authtypes.StoreKey, AuthAccountKey(address)),
+				Balance:  *storeProof(banktypes.StoreKey, balanceKey),
+				CodeHash: *storeProof(evmtypes.StoreKey, evmtypes.CodeHashKey(address)),
+			},
+		}
+		for _, slot := range slots {
+			key := evmtypes.StateKey(address, slot.Bytes())
+			res.StorageProof = append(res.StorageProof, StorageResult{
+				Key:         slot.Hex(),
+				Value:       (*hexutil.Big)(big.NewInt(0)),
+				CosmosProof: storeProof(evmtypes.StoreKey, key),
+			})
+		}
+		return res
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func() *AccountResult
+		expErr   string
+	}{
+		{
+			"pass - contract with storage",
+			func() *AccountResult {
+				res := accountResult(contract, slot, emptySlot)
+				res.Nonce = 3
+				res.Balance = (*hexutil.Big)(big.NewInt(5e12))
+				res.CodeHash = codeHash
+				res.StorageProof[0].Value = (*hexutil.Big)(big.NewInt(7))
+				return res
+			},
+			"",
+		},
+		{
+			"pass - account that doesn't exist",
+			func() *AccountResult {
+				return accountResult(eoa, slot)
+			},
+			"",
+		},
+		{
+			"fail - missing proof",
+			func() *AccountResult {
+				res := accountResult(eoa)
+				res.CosmosProof = nil
+				return res
+			},
+			"missing cosmos proof",
+		},
+		{
+			"fail - nonce mismatch",
+			func() *AccountResult {
+				res := accountResult(contract)
+				res.Nonce = 4
+				return res
+			},
+			"proven nonce 3 doesn't match 4",
+		},
+		{
+			"fail - balance not scaled to 18 decimals",
+			func() *AccountResult {
+				res := accountResult(contract)
+				res.Nonce = 3
+				res.Balance = (*hexutil.Big)(big.NewInt(5))
+				return res
+			},
+			"proven balance",
+		},
+		{
+			"fail - code hash of an account without code",
+			func() *AccountResult {
+				res := accountResult(eoa)
+				res.CodeHash = codeHash
+				return res
+			},
+			"proven code hash",
+		},
+		{
+			"fail - storage value mismatch",
+			func() *AccountResult {
+				res := accountResult(eoa, slot)
+				res.StorageProof[0].Value = (*hexutil.Big)(big.NewInt(7))
+				return res
+			},
+			"proven value 0",
+		},
+		{
+			"fail - proof of another account",
+			func() *AccountResult {
+				res := accountResult(eoa)
+				res.CosmosProof.Account = accountResult(contract).CosmosProof.Account
+				return res
+			},
+			"invalid account proof",
+		},
+		{
+			"fail - tampered value",
+			func() *AccountResult {
+				res := accountResult(contract, slot)
+				res.Nonce = 3
+				res.Balance = (*hexutil.Big)(big.NewInt(5e12))
+				res.CodeHash = codeHash
+				res.StorageProof[0].Value = (*hexutil.Big)(big.NewInt(8))
+				res.StorageProof[0].CosmosProof.Value = common.BigToHash(big.NewInt(8)).Bytes()
+				return res
+			},
+			"invalid proof of storage key",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := VerifyAccountProof(cdc, coinInfo, tc.malleate(), appHash)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+
+	// the proofs are only valid for the app hash of the state
+	err = VerifyAccountProof(cdc, coinInfo, accountResult(eoa), common.HexToHash("0x01").Bytes())
+	require.ErrorContains(t, err, "invalid account proof")
+}
@@ -0,0 +1,363 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+)
+
+// Trace types of the Parity-style (OpenEthereum) trace_* namespace.
+const (
+	TraceTypeCall    = "call"
+	TraceTypeCreate  = "create"
+	TraceTypeSuicide = "suicide"
+
+	// TraceTypeTrace requests the flat call traces on trace_replay* methods.
+	TraceTypeTrace = "trace"
+	// TraceTypeStateDiff requests the state diff on trace_replay* methods.
+	TraceTypeStateDiff = "stateDiff"
+	// TraceTypeVMTrace requests the VM traces on trace_replay* methods.
+	TraceTypeVMTrace = "vmTrace"
+)
+
+// traceErrors maps the EVM errors to the error messages used by OpenEthereum.
+var traceErrors = map[string]string{
+	vm.ErrExecutionReverted.Error(): "Reverted",
+	vm.ErrOutOfGas.Error():          "Out of gas",
+	vm.ErrInvalidJump.Error():       "Bad jump destination",
+	vm.ErrWriteProtection.Error():   "Mutable Call In Static Context",
+	vm.ErrDepth.Error():             "Out of stack",
+}
+
+// CallFrame is the output of the native callTracer.
+type CallFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Calls   []CallFrame     `json:"calls,omitempty"`
+}
+
+// TraceAction is the action of a Parity-style trace. The populated fields
+// depend on the trace type.
+type TraceAction struct {
+	CallType      string          `json:"callType,omitempty"`
+	From          *common.Address `json:"from,omitempty"`
+	To            *common.Address `json:"to,omitempty"`
+	Gas           *hexutil.Uint64 `json:"gas,omitempty"`
+	Input         *hexutil.Bytes  `json:"input,omitempty"`
+	Init          *hexutil.Bytes  `json:"init,omitempty"`
+	Value         *hexutil.Big    `json:"value,omitempty"`
+	Address       *common.Address `json:"address,omitempty"`
+	RefundAddress *common.Address `json:"refundAddress,omitempty"`
+	Balance       *hexutil.Big    `json:"balance,omitempty"`
+}
+
+// TraceResult is the result of a successful Parity-style trace.
+type TraceResult struct {
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Output  *hexutil.Bytes  `json:"output,omitempty"`
+	Address *common.Address `json:"address,omitempty"`
+	Code    *hexutil.Bytes  `json:"code,omitempty"`
+}
+
+// Trace is a single Parity-style flat trace. The position of the call frame on
+// the call tree is given by its trace address.
+type Trace struct {
+	Action       TraceAction  `json:"action"`
+	Error        string       `json:"error,omitempty"`
+	Result       *TraceResult `json:"result"`
+	Subtraces    int          `json:"subtraces"`
+	TraceAddress []int        `json:"traceAddress"`
+	Type         string       `json:"type"`
+}
+
+// LocalizedTrace is a flat trace along with the block and transaction that
+// produced it.
+type LocalizedTrace struct {
+	Trace
+	BlockHash           common.Hash `json:"blockHash"`
+	BlockNumber         uint64      `json:"blockNumber"`
+	TransactionHash     common.Hash `json:"transactionHash"`
+	TransactionPosition uint64      `json:"transactionPosition"`
+}
+
+// TraceReplayResult is the result of replaying a transaction on the
+// trace_replay* methods. The fields that weren't requested are left empty.
+type TraceReplayResult struct {
+	Output          hexutil.Bytes `json:"output"`
+	StateDiff       StateDiff     `json:"stateDiff"`
+	Trace           []*Trace      `json:"trace"`
+	VMTrace         interface{}   `json:"vmTrace"`
+	TransactionHash common.Hash   `json:"transactionHash"`
+}
+
+// FlattenCallFrame converts the call tree returned by the callTracer into the
+// list of Parity-style traces, ordered by a depth-first traversal.
+func FlattenCallFrame(frame CallFrame) []*Trace {
+	return flattenCallFrame(frame, []int{}, nil)
+}
+
+func flattenCallFrame(frame CallFrame, traceAddress []int, traces []*Trace) []*Trace {
+	trace := &Trace{
+		Subtraces:    len(frame.Calls),
+		TraceAddress: traceAddress,
+	}
+
+	switch typ := strings.ToLower(frame.Type); typ {
+	case "create", "create2":
+		trace.Type = TraceTypeCreate
+		trace.Action = TraceAction{
+			From:  &frame.From,
+			Gas:   &frame.Gas,
+			Init:  &frame.Input,
+			Value: valueOrZero(frame.Value),
+		}
+		if frame.Error == "" {
+			trace.Result = &TraceResult{
+				GasUsed: frame.GasUsed,
+				Address: frame.To,
+				Code:    &frame.Output,
+			}
+		}
+	case "selfdestruct":
+		trace.Type = TraceTypeSuicide
+		trace.Action = TraceAction{
+			Address:       &frame.From,
+			RefundAddress: frame.To,
+			Balance:       valueOrZero(frame.Value),
+		}
+	default:
+		trace.Type = TraceTypeCall
+		trace.Action = TraceAction{
+			CallType: typ,
+			From:     &frame.From,
+			To:       frame.To,
+			Gas:      &frame.Gas,
+			Input:    &frame.Input,
+			Value:    valueOrZero(frame.Value),
+		}
+		if frame.Error == "" {
+			trace.Result = &TraceResult{
+				GasUsed: frame.GasUsed,
+				Output:  &frame.Output,
+			}
+		}
+	}
+
+	if frame.Error != "" {
+		trace.Error = frame.Error
+		if msg, ok := traceErrors[frame.Error]; ok {
+			trace.Error = msg
+		}
+	}
+
+	traces = append(traces, trace)
+	for i, call := range frame.Calls {
+		// copy the address so that the siblings don't share the same backing array
+		childAddress := make([]int, len(traceAddress), len(traceAddress)+1)
+		copy(childAddress, traceAddress)
+		traces = flattenCallFrame(call, append(childAddress, i), traces)
+	}
+
+	return traces
+}
+
+// LocalizeTraces adds the block and transaction information to the flat traces
+// of a transaction.
+func LocalizeTraces(
+	traces []*Trace,
+	blockHash common.Hash,
+	blockNumber uint64,
+	txHash common.Hash,
+	txPosition uint64,
+) []*LocalizedTrace {
+	localized := make([]*LocalizedTrace, len(traces))
+	for i, trace := range traces {
+		localized[i] = &LocalizedTrace{
+			Trace:               *trace,
+			BlockHash:           blockHash,
+			BlockNumber:         blockNumber,
+			TransactionHash:     txHash,
+			TransactionPosition: txPosition,
+		}
+	}
+	return localized
+}
+
+func valueOrZero(value *hexutil.Big) *hexutil.Big {
+	if value == nil {
+		return (*hexutil.Big)(new(big.Int))
+	}
+	return value
+}
+
+// PrestateAccount is an account of the prestateTracer output.
+type PrestateAccount struct {
+	Balance *hexutil.Big                `json:"balance,omitempty"`
+	Nonce   uint64                      `json:"nonce,omitempty"`
+	Code    hexutil.Bytes               `json:"code,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+}
+
+// PrestateDiff is the output of the prestateTracer when running on diff mode.
+// The pre state only contains the accounts and storage slots modified by the
+// transaction, while the post state only contains their modified fields.
+type PrestateDiff struct {
+	Pre  map[common.Address]*PrestateAccount `json:"pre"`
+	Post map[common.Address]*PrestateAccount `json:"post"`
+}
+
+// AccountDiff is the Parity-style diff of an account. Each field is either
+// "=" when unchanged, {"+": value} when the account was created, {"-": value}
+// when the account was deleted or {"*": {"from": value, "to": value}} when the
+// value was modified.
+type AccountDiff struct {
+	Balance interface{}                 `json:"balance"`
+	Nonce   interface{}                 `json:"nonce"`
+	Code    interface{}                 `json:"code"`
+	Storage map[common.Hash]interface{} `json:"storage"`
+}
+
+// StateDiff is the Parity-style diff of the accounts modified by a transaction.
+type StateDiff map[common.Address]*AccountDiff
+
+// diffUnchanged is the diff of a value that wasn't modified.
+const diffUnchanged = "="
+
+// ToStateDiff converts the prestateTracer diff into a Parity-style state diff.
+func (d PrestateDiff) ToStateDiff() StateDiff {
+	stateDiff := make(StateDiff, len(d.Post))
+
+	for addr, post := range d.Post {
+		pre, ok := d.Pre[addr]
+		if !ok {
+			stateDiff[addr] = bornAccountDiff(post)
+			continue
+		}
+
+		accountDiff := &AccountDiff{
+			Balance: diffUnchanged,
+			Nonce:   diffUnchanged,
+			Code:    diffUnchanged,
+			Storage: make(map[common.Hash]interface{}),
+		}
+		if post.Balance != nil {
+			accountDiff.Balance = changedDiff(valueOrZero(pre.Balance), post.Balance)
+		}
+		if post.Nonce != 0 {
+			accountDiff.Nonce = changedDiff(hexutil.Uint64(pre.Nonce), hexutil.Uint64(post.Nonce))
+		}
+		if len(post.Code) != 0 {
+			accountDiff.Code = changedDiff(pre.Code, post.Code)
+		}
+		// the modified slots are on the pre state, unless they were empty
+		for key, value := range pre.Storage {
+			accountDiff.Storage[key] = changedDiff(value, post.Storage[key])
+		}
+		for key, value := range post.Storage {
+			if _, ok := pre.Storage[key]; !ok {
+				accountDiff.Storage[key] = changedDiff(common.Hash{}, value)
+			}
+		}
+		stateDiff[addr] = accountDiff
+	}
+
+	// the accounts that are only on the pre state were deleted
+	for addr, pre := range d.Pre {
+		if _, ok := d.Post[addr]; !ok {
+			stateDiff[addr] = diedAccountDiff(pre)
+		}
+	}
+
+	return stateDiff
+}
+
+func bornAccountDiff(account *PrestateAccount) *AccountDiff {
+	accountDiff := &AccountDiff{
+		Balance: map[string]interface{}{"+": valueOrZero(account.Balance)},
+		Nonce:   map[string]interface{}{"+": hexutil.Uint64(account.Nonce)},
+		Code:    map[string]interface{}{"+": account.Code},
+		Storage: make(map[common.Hash]interface{}, len(account.Storage)),
+	}
+	for key, value := range account.Storage {
+		accountDiff.Storage[key] = map[string]interface{}{"+": value}
+	}
+	return accountDiff
+}
+
+func diedAccountDiff(account *PrestateAccount) *AccountDiff {
+	accountDiff := &AccountDiff{
+		Balance: map[string]interface{}{"-": valueOrZero(account.Balance)},
+		Nonce:   map[string]interface{}{"-": hexutil.Uint64(account.Nonce)},
+		Code:    map[string]interface{}{"-": account.Code},
+		Storage: make(map[common.Hash]interface{}, len(account.Storage)),
+	}
+	for key, value := range account.Storage {
+		accountDiff.Storage[key] = map[string]interface{}{"-": value}
+	}
+	return accountDiff
+}
+
+func changedDiff(from, to interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"*": map[string]interface{}{"from": from, "to": to},
+	}
+}
+
+// TraceFilterArgs are the arguments of trace_filter.
+type TraceFilterArgs struct {
+	FromBlock   *BlockNumber     `json:"fromBlock"`
+	ToBlock     *BlockNumber     `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *uint64          `json:"after"`
+	Count       *uint64          `json:"count"`
+}
+
+// Matches returns true if the trace matches the address filters. The sender
+// of a trace is the caller, or the destroyed contract on suicides, while the
+// recipient is the callee, the created contract or the refund address on
+// suicides. An empty list of addresses matches all the traces.
+func (args TraceFilterArgs) Matches(trace *Trace) bool {
+	var from, to *common.Address
+	switch trace.Type {
+	case TraceTypeCreate:
+		from = trace.Action.From
+		if trace.Result != nil {
+			to = trace.Result.Address
+		}
+	case TraceTypeSuicide:
+		from, to = trace.Action.Address, trace.Action.RefundAddress
+	default:
+		from, to = trace.Action.From, trace.Action.To
+	}
+
+	return matchesAddress(args.FromAddress, from) && matchesAddress(args.ToAddress, to)
+}
+
+func matchesAddress(addresses []common.Address, addr *common.Address) bool {
+	if len(addresses) == 0 {
+		return true
+	}
+	if addr == nil {
+		return false
+	}
+	for _, a := range addresses {
+		if a == *addr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,177 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenCallFrame(t *testing.T) {
+	var (
+		sender   = common.HexToAddress("0x01")
+		contract = common.HexToAddress("0x02")
+		callee   = common.HexToAddress("0x03")
+		created  = common.HexToAddress("0x04")
+	)
+
+	frameJSON := `{
+		"type": "CALL", "from": "0x0000000000000000000000000000000000000001", "to": "0x0000000000000000000000000000000000000002",
+		"value": "0x10", "gas": "0x186a0", "gasUsed": "0x5208", "input": "0x1234", "output": "0x01",
+		"calls": [
+			{
+				"type": "DELEGATECALL", "from": "0x0000000000000000000000000000000000000002", "to": "0x0000000000000000000000000000000000000003",
+				"gas": "0x100", "gasUsed": "0x100", "input": "0x", "error": "execution reverted",
+				"calls": [
+					{"type": "SELFDESTRUCT", "from": "0x0000000000000000000000000000000000000003", "to": "0x0000000000000000000000000000000000000001", "value": "0x1", "gas": "0x0", "gasUsed": "0x0", "input": "0x"}
+				]
+			},
+			{
+				"type": "CREATE2", "from": "0x0000000000000000000000000000000000000002", "to": "0x0000000000000000000000000000000000000004",
+				"gas": "0x200", "gasUsed": "0x20", "input": "0x6000", "output": "0x6001"
+			}
+		]
+	}`
+
+	var frame CallFrame
+	require.NoError(t, json.Unmarshal([]byte(frameJSON), &frame))
+
+	traces := FlattenCallFrame(frame)
+	require.Len(t, traces, 4)
+
+	// root call
+	require.Equal(t, TraceTypeCall, traces[0].Type)
+	require.Equal(t, "call", traces[0].Action.CallType)
+	require.Equal(t, sender, *traces[0].Action.From)
+	require.Equal(t, contract, *traces[0].Action.To)
+	require.Equal(t, big.NewInt(16), traces[0].Action.Value.ToInt())
+	require.Equal(t, hexutil.Bytes{0x01}, *traces[0].Result.Output)
+	require.Equal(t, hexutil.Uint64(21000), traces[0].Result.GasUsed)
+	require.Equal(t, []int{}, traces[0].TraceAddress)
+	require.Equal(t, 2, traces[0].Subtraces)
+
+	// reverted delegate call
+	require.Equal(t, "delegatecall", traces[1].Action.CallType)
+	require.Equal(t, callee, *traces[1].Action.To)
+	require.Equal(t, big.NewInt(0), traces[1].Action.Value.ToInt())
+	require.Equal(t, "Reverted", traces[1].Error)
+	require.Nil(t, traces[1].Result)
+	require.Equal(t, []int{0}, traces[1].TraceAddress)
+	require.Equal(t, 1, traces[1].Subtraces)
+
+	// self destruct
+	require.Equal(t, TraceTypeSuicide, traces[2].Type)
+	require.Equal(t, callee, *traces[2].Action.Address)
+	require.Equal(t, sender, *traces[2].Action.RefundAddress)
+	require.Equal(t, big.NewInt(1), traces[2].Action.Balance.ToInt())
+	require.Equal(t, []int{0, 0}, traces[2].TraceAddress)
+
+	// contract creation
+	require.Equal(t, TraceTypeCreate, traces[3].Type)
+	require.Equal(t, hexutil.Bytes{0x60, 0x00}, *traces[3].Action.Init)
+	require.Equal(t, created, *traces[3].Result.Address)
+	require.Equal(t, hexutil.Bytes{0x60, 0x01}, *traces[3].Result.Code)
+	require.Equal(t, []int{1}, traces[3].TraceAddress)
+
+	localized := LocalizeTraces(traces, common.HexToHash("0xaa"), 10, common.HexToHash("0xbb"), 2)
+	require.Len(t, localized, 4)
+	require.Equal(t, uint64(10), localized[3].BlockNumber)
+	require.Equal(t, uint64(2), localized[3].TransactionPosition)
+	require.Equal(t, []int{1}, localized[3].TraceAddress)
+}
+
+func TestPrestateDiffToStateDiff(t *testing.T) {
+	var (
+		modified = common.HexToAddress("0x01")
+		born     = common.HexToAddress("0x02")
+		died     = common.HexToAddress("0x03")
+		slot     = common.HexToHash("0x01")
+		newSlot  = common.HexToHash("0x02")
+	)
+
+	diff := PrestateDiff{
+		Pre: map[common.Address]*PrestateAccount{
+			modified: {Balance: (*hexutil.Big)(big.NewInt(10)), Nonce: 1, Code: hexutil.Bytes{0x60}, Storage: map[common.Hash]common.Hash{slot: common.HexToHash("0x05")}},
+			died:     {Balance: (*hexutil.Big)(big.NewInt(3)), Code: hexutil.Bytes{0x61}},
+		},
+		Post: map[common.Address]*PrestateAccount{
+			modified: {Balance: (*hexutil.Big)(big.NewInt(4)), Storage: map[common.Hash]common.Hash{newSlot: common.HexToHash("0x06")}},
+			born:     {Balance: (*hexutil.Big)(big.NewInt(1)), Nonce: 1, Code: hexutil.Bytes{0x62}},
+		},
+	}
+
+	stateDiff := diff.ToStateDiff()
+	require.Len(t, stateDiff, 3)
+
+	require.Equal(t, changedDiff((*hexutil.Big)(big.NewInt(10)), (*hexutil.Big)(big.NewInt(4))), stateDiff[modified].Balance)
+	require.Equal(t, diffUnchanged, stateDiff[modified].Nonce)
+	require.Equal(t, diffUnchanged, stateDiff[modified].Code)
+	require.Equal(t, changedDiff(common.HexToHash("0x05"), common.Hash{}), stateDiff[modified].Storage[slot])
+	require.Equal(t, changedDiff(common.Hash{}, common.HexToHash("0x06")), stateDiff[modified].Storage[newSlot])
+
+	require.Equal(t, map[string]interface{}{"+": hexutil.Uint64(1)}, stateDiff[born].Nonce)
+	require.Equal(t, map[string]interface{}{"+": hexutil.Bytes{0x62}}, stateDiff[born].Code)
+
+	require.Equal(t, map[string]interface{}{"-": (*hexutil.Big)(big.NewInt(3))}, stateDiff[died].Balance)
+	require.Equal(t, map[string]interface{}{"-": hexutil.Uint64(0)}, stateDiff[died].Nonce)
+
+	bz, err := json.Marshal(stateDiff)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"code":"="`)
+}
+
+func TestTraceFilterArgsMatches(t *testing.T) {
+	var (
+		addr1 = common.HexToAddress("0x01")
+		addr2 = common.HexToAddress("0x02")
+		addr3 = common.HexToAddress("0x03")
+	)
+
+	call := &Trace{Type: TraceTypeCall, Action: TraceAction{From: &addr1, To: &addr2}}
+	create := &Trace{Type: TraceTypeCreate, Action: TraceAction{From: &addr1}, Result: &TraceResult{Address: &addr3}}
+	failedCreate := &Trace{Type: TraceTypeCreate, Action: TraceAction{From: &addr1}}
+	suicide := &Trace{Type: TraceTypeSuicide, Action: TraceAction{Address: &addr2, RefundAddress: &addr3}}
+
+	testCases := []struct {
+		name   string
+		args   TraceFilterArgs
+		trace  *Trace
+		expect bool
+	}{
+		{"no filters", TraceFilterArgs{}, call, true},
+		{"call matches from", TraceFilterArgs{FromAddress: []common.Address{addr1}}, call, true},
+		{"call doesn't match from", TraceFilterArgs{FromAddress: []common.Address{addr2}}, call, false},
+		{"call matches from and to", TraceFilterArgs{FromAddress: []common.Address{addr1}, ToAddress: []common.Address{addr3, addr2}}, call, true},
+		{"call doesn't match to", TraceFilterArgs{FromAddress: []common.Address{addr1}, ToAddress: []common.Address{addr3}}, call, false},
+		{"create matches the created contract", TraceFilterArgs{ToAddress: []common.Address{addr3}}, create, true},
+		{"failed create doesn't match to", TraceFilterArgs{ToAddress: []common.Address{addr3}}, failedCreate, false},
+		{"suicide matches the contract", TraceFilterArgs{FromAddress: []common.Address{addr2}}, suicide, true},
+		{"suicide matches the refund address", TraceFilterArgs{ToAddress: []common.Address{addr3}}, suicide, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expect, tc.args.Matches(tc.trace))
+		})
+	}
+}
+
+func TestUnmarshalTraceCallConfig(t *testing.T) {
+	input := `{
+		"tracer": "callTracer",
+		"tracerConfig": "{\"onlyTopCall\":true}",
+		"stateOverrides": {"0x0000000000000000000000000000000000000001": {"balance": "0x10"}},
+		"blockOverrides": {"number": "0x64"}
+	}`
+
+	var config TraceCallConfig
+	require.NoError(t, json.Unmarshal([]byte(input), &config))
+	require.Equal(t, "callTracer", config.Tracer)
+	require.Equal(t, `{"onlyTopCall":true}`, config.TracerJsonConfig)
+	require.NotNil(t, config.StateOverrides)
+	require.Equal(t, big.NewInt(16), (*(*config.StateOverrides)[common.HexToAddress("0x01")].Balance).ToInt())
+	require.Equal(t, big.NewInt(100), config.BlockOverrides.Number.ToInt())
+}
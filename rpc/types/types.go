@@ -3,11 +3,15 @@
 package types
 
 import (
+	"encoding/json"
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -22,6 +26,9 @@ type AccountResult struct {
 	Nonce        hexutil.Uint64  `json:"nonce"`
 	StorageHash  common.Hash     `json:"storageHash"`
 	StorageProof []StorageResult `json:"storageProof"`
+	// CosmosProof holds the ICS-23 proofs of the account fields, which can be
+	// verified against the app hash with VerifyAccountProof.
+	CosmosProof *CosmosAccountProof `json:"cosmosProof,omitempty"`
 }
 
 // StorageResult defines the format for storage proof return
@@ -29,6 +36,8 @@ type StorageResult struct {
 	Key   string       `json:"key"`
 	Value *hexutil.Big `json:"value"`
 	Proof []string     `json:"proof"`
+	// CosmosProof is the ICS-23 proof of the storage value in the EVM module store.
+	CosmosProof *StoreProof `json:"cosmosProof,omitempty"`
 }
 
 // RPCTransaction represents a transaction that will serialize to the RPC representation of a transaction
@@ -52,23 +61,37 @@ type RPCTransaction struct {
 	V                *hexutil.Big         `json:"v"`
 	R                *hexutil.Big         `json:"r"`
 	S                *hexutil.Big         `json:"s"`
+	// AuthorizationList is only set for EIP-7702 set code transactions
+	AuthorizationList []RPCAuthorization `json:"authorizationList,omitempty"`
+}
+
+// RPCAuthorization is the RPC representation of an EIP-7702 authorization.
+type RPCAuthorization struct {
+	ChainID *hexutil.Big   `json:"chainId"`
+	Address common.Address `json:"address"`
+	Nonce   hexutil.Uint64 `json:"nonce"`
+	YParity hexutil.Uint64 `json:"yParity"`
+	R       *hexutil.Big   `json:"r"`
+	S       *hexutil.Big   `json:"s"`
 }
 
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
+type StateOverride = evmtypes.StateOverride
 
 // OverrideAccount indicates the overriding fields of account during the execution of
 // a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+type OverrideAccount = evmtypes.OverrideAccount
+
+// BlockOverrides is a set of header fields to override during the execution of
+// a message call.
+type BlockOverrides = evmtypes.BlockOverrides
+
+// TraceCallConfig is the config of debug_traceCall. It extends the trace config
+// with the state and block overrides of eth_call.
+type TraceCallConfig struct {
+	evmtypes.TraceConfig
+	StateOverrides *StateOverride  `json:"stateOverrides,omitempty"`
+	BlockOverrides *BlockOverrides `json:"blockOverrides,omitempty"`
 }
 
 type FeeHistoryResult struct {
@@ -89,3 +112,109 @@ type OneFeeHistory struct {
 	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
 	GasUsedRatio         float64    // the ratio of gas used to the gas limit for each block
 }
+
+// SyncProgress is the progress of a node that is catching up with the network.
+type SyncProgress struct {
+	StartingBlock hexutil.Uint64 `json:"startingBlock"`
+	CurrentBlock  hexutil.Uint64 `json:"currentBlock"`
+	HighestBlock  hexutil.Uint64 `json:"highestBlock"`
+}
+
+// SyncingResult is the notification of the syncing subscription sent when the
+// node starts catching up with the network and as it makes progress.
+type SyncingResult struct {
+	Syncing bool         `json:"syncing"`
+	Status  SyncProgress `json:"status"`
+}
+
+// PendingTxsCriteria are the options of the newPendingTransactions subscriptions.
+// They are set either with geth's fullTx boolean or with an object that also
+// restricts the transactions to the given senders and recipients.
+type PendingTxsCriteria struct {
+	// FullTx sends the full transactions instead of their hashes.
+	FullTx bool
+	// FromAddresses are the accepted senders. An empty list accepts all of them.
+	FromAddresses []common.Address
+	// ToAddresses are the accepted recipients. An empty list accepts all of them.
+	ToAddresses []common.Address
+}
+
+// UnmarshalJSON parses the fullTx boolean or a {"fullTx", "from", "to"} object,
+// where the addresses are a single address or a list of addresses.
+func (c *PendingTxsCriteria) UnmarshalJSON(data []byte) error {
+	var fullTx bool
+	if err := json.Unmarshal(data, &fullTx); err == nil {
+		*c = PendingTxsCriteria{FullTx: fullTx}
+		return nil
+	}
+
+	var raw struct {
+		FullTx bool            `json:"fullTx"`
+		From   json.RawMessage `json:"from"`
+		To     json.RawMessage `json:"to"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("invalid pending transactions criteria: %w", err)
+	}
+
+	from, err := unmarshalAddresses(raw.From)
+	if err != nil {
+		return fmt.Errorf("invalid from addresses: %w", err)
+	}
+
+	to, err := unmarshalAddresses(raw.To)
+	if err != nil {
+		return fmt.Errorf("invalid to addresses: %w", err)
+	}
+
+	*c = PendingTxsCriteria{FullTx: raw.FullTx, FromAddresses: from, ToAddresses: to}
+	return nil
+}
+
+// Matches returns true if the transaction matches the address filters. Contract
+// creations only match an empty list of recipients.
+func (c PendingTxsCriteria) Matches(tx *RPCTransaction) bool {
+	return matchesAddress(c.FromAddresses, &tx.From) && matchesAddress(c.ToAddresses, tx.To)
+}
+
+// Results returns the subscription results of the ethereum transactions that
+// match the criteria: the full transactions or their hashes.
+func (c PendingTxsCriteria) Results(msgs []*evmtypes.MsgEthereumTx, chainID *big.Int) []interface{} {
+	results := make([]interface{}, 0, len(msgs))
+	for _, msg := range msgs {
+		// recovering the sender is only needed to filter or return the full tx
+		if !c.FullTx && len(c.FromAddresses) == 0 && len(c.ToAddresses) == 0 {
+			results = append(results, msg.TxHash())
+			continue
+		}
+
+		rpcTx, err := NewTransactionFromMsg(msg, common.Hash{}, 0, 0, nil, chainID)
+		if err != nil || !c.Matches(rpcTx) {
+			continue
+		}
+
+		if c.FullTx {
+			results = append(results, rpcTx)
+		} else {
+			results = append(results, rpcTx.Hash)
+		}
+	}
+	return results
+}
+
+func unmarshalAddresses(data json.RawMessage) ([]common.Address, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+
+	var address common.Address
+	if err := json.Unmarshal(data, &address); err == nil {
+		return []common.Address{address}, nil
+	}
+
+	var addresses []common.Address
+	if err := json.Unmarshal(data, &addresses); err != nil {
+		return nil, err
+	}
+	return addresses, nil
+}
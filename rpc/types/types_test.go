@@ -0,0 +1,115 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func TestUnmarshalPendingTxsCriteria(t *testing.T) {
+	var (
+		addr1 = common.HexToAddress("0x01")
+		addr2 = common.HexToAddress("0x02")
+	)
+
+	testCases := []struct {
+		name   string
+		input  string
+		expect PendingTxsCriteria
+		expErr bool
+	}{
+		{"geth fullTx boolean", `true`, PendingTxsCriteria{FullTx: true}, false},
+		{"empty object", `{}`, PendingTxsCriteria{}, false},
+		{
+			"single addresses",
+			`{"fullTx": true, "from": "0x0000000000000000000000000000000000000001", "to": "0x0000000000000000000000000000000000000002"}`,
+			PendingTxsCriteria{FullTx: true, FromAddresses: []common.Address{addr1}, ToAddresses: []common.Address{addr2}},
+			false,
+		},
+		{
+			"list of addresses",
+			`{"to": ["0x0000000000000000000000000000000000000001", "0x0000000000000000000000000000000000000002"]}`,
+			PendingTxsCriteria{ToAddresses: []common.Address{addr1, addr2}},
+			false,
+		},
+		{"invalid address", `{"from": "0x01"}`, PendingTxsCriteria{}, true},
+		{"invalid criteria", `"latest"`, PendingTxsCriteria{}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var crit PendingTxsCriteria
+			err := json.Unmarshal([]byte(tc.input), &crit)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, crit)
+		})
+	}
+}
+
+func TestPendingTxsCriteriaResults(t *testing.T) {
+	chainID := big.NewInt(9000)
+	signer := ethtypes.LatestSignerForChainID(chainID)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	recipient := common.HexToAddress("0x02")
+
+	newMsg := func(nonce uint64, to *common.Address) *evmtypes.MsgEthereumTx {
+		tx, err := ethtypes.SignNewTx(key, signer, &ethtypes.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     nonce,
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(10),
+			Gas:       21000,
+			To:        to,
+			Value:     big.NewInt(1),
+		})
+		require.NoError(t, err)
+
+		msg := &evmtypes.MsgEthereumTx{}
+		require.NoError(t, msg.FromEthereumTx(tx))
+		return msg
+	}
+
+	transfer := newMsg(0, &recipient)
+	create := newMsg(1, nil)
+	msgs := []*evmtypes.MsgEthereumTx{transfer, create}
+	transferHash, createHash := transfer.AsTransaction().Hash(), create.AsTransaction().Hash()
+
+	// hashes by default
+	results := PendingTxsCriteria{}.Results(msgs, chainID)
+	require.Equal(t, []interface{}{transferHash, createHash}, results)
+
+	// full transactions
+	results = PendingTxsCriteria{FullTx: true}.Results(msgs, chainID)
+	require.Len(t, results, 2)
+	rpcTx, ok := results[0].(*RPCTransaction)
+	require.True(t, ok)
+	require.Equal(t, transferHash, rpcTx.Hash)
+	require.Equal(t, sender, rpcTx.From)
+	require.Equal(t, &recipient, rpcTx.To)
+	require.Nil(t, rpcTx.BlockHash)
+
+	// filtered by sender
+	results = PendingTxsCriteria{FromAddresses: []common.Address{sender}}.Results(msgs, chainID)
+	require.Equal(t, []interface{}{transferHash, createHash}, results)
+	results = PendingTxsCriteria{FromAddresses: []common.Address{recipient}}.Results(msgs, chainID)
+	require.Empty(t, results)
+
+	// filtered by recipient, which excludes the contract creations
+	results = PendingTxsCriteria{FullTx: true, ToAddresses: []common.Address{recipient}}.Results(msgs, chainID)
+	require.Len(t, results, 1)
+	require.Equal(t, transferHash, results[0].(*RPCTransaction).Hash)
+}
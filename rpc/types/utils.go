@@ -3,6 +3,7 @@
 package types
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math/big"
@@ -48,7 +49,7 @@ func RawTxToEthTx(clientCtx client.Context, txBz cmttypes.Tx) ([]*evmtypes.MsgEt
 		if !ok {
 			return nil, fmt.Errorf("invalid message type %T, expected %T", msg, &evmtypes.MsgEthereumTx{})
 		}
-		ethTx.Hash = ethTx.AsTransaction().Hash().Hex()
+		ethTx.Hash = ethTx.TxHash().Hex()
 		ethTxs[i] = ethTx
 	}
 	return ethTxs, nil
@@ -83,6 +84,25 @@ func EthHeaderFromTendermint(header cmttypes.Header, bloom ethtypes.Bloom, baseF
 	}
 }
 
+// EthTransactions is a list of Ethereum transactions that are encoded in their
+// canonical form, unlike the go-ethereum transactions which can't represent the
+// EIP-7702 set code transactions. It implements ethtypes.DerivableList, so that
+// the transactions root of a block covers the authorization lists.
+type EthTransactions []*evmtypes.MsgEthereumTx
+
+// Len returns the number of transactions.
+func (txs EthTransactions) Len() int { return len(txs) }
+
+// EncodeIndex writes the canonical encoding of the i'th transaction to w.
+func (txs EthTransactions) EncodeIndex(i int, w *bytes.Buffer) {
+	bz, err := txs[i].MarshalBinary()
+	if err != nil {
+		// unreachable: the transactions were decoded from a block
+		return
+	}
+	w.Write(bz)
+}
+
 // BlockMaxGasFromConsensusParams returns the gas limit for the current block from the chain consensus params.
 func BlockMaxGasFromConsensusParams(goCtx context.Context, clientCtx client.Context, blockHeight int64) (int64, error) {
 	tmrpcClient, ok := clientCtx.Client.(tmrpcclient.Client)
@@ -161,7 +181,46 @@ func NewTransactionFromMsg(
 	chainID *big.Int,
 ) (*RPCTransaction, error) {
 	tx := msg.AsTransaction()
-	return NewRPCTransaction(tx, blockHash, blockNumber, index, baseFee, chainID)
+	result, err := NewRPCTransaction(tx, blockHash, blockNumber, index, baseFee, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	// go-ethereum can't represent set code transactions, which are converted as
+	// dynamic fee transactions
+	txData, err := evmtypes.UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, err
+	}
+	if setCodeTx, ok := txData.(*evmtypes.SetCodeTx); ok {
+		from, _ := setCodeTx.Sender(setCodeTx.GetChainID()) // #nosec G703
+		result.Type = hexutil.Uint64(evmtypes.SetCodeTxType)
+		result.From = from
+		result.Hash = setCodeTx.Hash()
+		result.AuthorizationList = NewRPCAuthorizations(setCodeTx.GetAuthorizations())
+	}
+
+	return result, nil
+}
+
+// NewRPCAuthorizations returns the RPC representation of an EIP-7702
+// authorization list.
+func NewRPCAuthorizations(authorizations evmtypes.AuthorizationList) []RPCAuthorization {
+	result := make([]RPCAuthorization, len(authorizations))
+	for i, auth := range authorizations {
+		v, r, s := auth.GetRawSignatureValues()
+		result[i] = RPCAuthorization{
+			ChainID: (*hexutil.Big)(auth.GetChainID()),
+			Address: auth.GetAddress(),
+			Nonce:   hexutil.Uint64(auth.Nonce),
+			R:       (*hexutil.Big)(r),
+			S:       (*hexutil.Big)(s),
+		}
+		if v != nil {
+			result[i].YParity = hexutil.Uint64(v.Uint64())
+		}
+	}
+	return result
 }
 
 // NewTransactionFromData returns a transaction that will serialize to the RPC
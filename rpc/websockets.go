@@ -20,12 +20,15 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"cosmossdk.io/log"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	cmttypes "github.com/cometbft/cometbft/types"
 
@@ -33,9 +36,18 @@ import (
 	rpcfilters "github.com/evmos/evmos/v20/rpc/namespaces/ethereum/eth/filters"
 	"github.com/evmos/evmos/v20/rpc/types"
 	"github.com/evmos/evmos/v20/server/config"
+	evmostypes "github.com/evmos/evmos/v20/types"
 	evmtypes "github.com/evmos/evmos/v20/x/evm/types"
 )
 
+var (
+	errSlowConsumer = errors.New("websocket connection closed: outbound queue is full")
+
+	wsActiveSubscriptionsGauge = metrics.NewRegisteredGauge("rpc/ws/subscriptions/active", nil)
+	wsDroppedMessagesCounter   = metrics.NewRegisteredCounter("rpc/ws/messages/dropped", nil)
+	wsDisconnectedConnsCounter = metrics.NewRegisteredCounter("rpc/ws/connections/disconnected", nil)
+)
+
 type WebsocketsServer interface {
 	Start()
 }
@@ -73,11 +85,28 @@ type websocketsServer struct {
 	wsAddr   string // listen address of ws server
 	certFile string
 	keyFile  string
-	api      *pubSubAPI
+	api      subscriber
 	logger   log.Logger
+
+	maxSubscriptions   int
+	outboundQueueSize  int
+	slowConsumerPolicy string
+	// rateLimiter throttles the requests of the clients, nil if the rate limit is disabled
+	rateLimiter *RateLimiter
+}
+
+// subscriber creates the subscriptions requested with eth_subscribe.
+type subscriber interface {
+	subscribe(wsConn *wsConn, subID rpc.ID, params []interface{}) (pubsub.UnsubscribeFunc, error)
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	tmWSClient *rpcclient.WSClient,
+	cfg *config.Config,
+	rateLimiter *RateLimiter,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address) // #nosec G703
 
@@ -88,6 +117,11 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient
 		keyFile:  cfg.TLS.KeyPath,
 		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
 		logger:   logger,
+
+		maxSubscriptions:   cfg.JSONRPC.WSMaxSubscriptionsPerConn,
+		outboundQueueSize:  cfg.JSONRPC.WSOutboundQueueSize,
+		slowConsumerPolicy: cfg.JSONRPC.WSSlowConsumerPolicy,
+		rateLimiter:        rateLimiter,
 	}
 }
 
@@ -128,10 +162,14 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	s.readLoop(&wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
-	})
+	// the requests are rate limited here with the IP of the client, since they are
+	// all forwarded to the HTTP server from the loopback address
+	var clientIP string
+	if s.rateLimiter != nil {
+		clientIP = s.rateLimiter.ClientIP(r)
+	}
+
+	s.readLoop(newWSConn(conn, s.outboundQueueSize, s.slowConsumerPolicy, s.logger), clientIP)
 }
 
 func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
@@ -147,22 +185,174 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 	_ = wsConn.WriteJSON(res) // #nosec G703
 }
 
+// wsTransport is the underlying connection of a wsConn.
+type wsTransport interface {
+	WriteJSON(v interface{}) error
+	ReadMessage() (messageType int, p []byte, err error)
+	Close() error
+}
+
+// wsMessage is a message queued for a WebSocket connection.
+type wsMessage struct {
+	msg          interface{}
+	notification bool
+}
+
+// wsConn is a WebSocket connection whose outbound messages are queued and written
+// by a dedicated goroutine, so that slow clients don't block the subscriptions.
+// The number of queued notifications is bounded, and the slow consumer policy is
+// applied when the queue is full. The number of queued responses is bounded by the
+// same size, but since dropping a response would leave a request unanswered, the
+// connection is closed when they overflow regardless of the policy.
 type wsConn struct {
-	conn *websocket.Conn
-	mux  *sync.Mutex
+	conn   wsTransport
+	logger log.Logger
+
+	queueSize int
+	policy    string
+
+	mux           *sync.Mutex // protects the fields below
+	queue         []wsMessage
+	notifications int // number of queued notifications
+	responses     int // number of queued responses
+	closed        bool
+
+	wake chan struct{}
+	done chan struct{}
 }
 
+func newWSConn(conn wsTransport, queueSize int, policy string, logger log.Logger) *wsConn {
+	w := &wsConn{
+		conn:      conn,
+		logger:    logger,
+		queueSize: queueSize,
+		policy:    policy,
+		mux:       new(sync.Mutex),
+		wake:      make(chan struct{}, 1),
+		done:      make(chan struct{}),
+	}
+
+	go w.writeLoop()
+	return w
+}
+
+// WriteJSON queues a response for the client.
 func (w *wsConn) WriteJSON(v interface{}) error {
+	return w.enqueue(wsMessage{msg: v})
+}
+
+// WriteNotification queues a subscription notification for the client, applying
+// the slow consumer policy if the queue is full.
+func (w *wsConn) WriteNotification(v interface{}) error {
+	return w.enqueue(wsMessage{msg: v, notification: true})
+}
+
+func (w *wsConn) enqueue(msg wsMessage) error {
 	w.mux.Lock()
-	defer w.mux.Unlock()
+	if w.closed {
+		w.mux.Unlock()
+		return websocket.ErrCloseSent
+	}
+
+	if !msg.notification && w.responses >= w.queueSize {
+		w.mux.Unlock()
+		wsDroppedMessagesCounter.Inc(1)
+		wsDisconnectedConnsCounter.Inc(1)
+		w.logger.Debug("closing slow websocket connection: too many queued responses", "queue-size", w.queueSize)
+		_ = w.Close() // #nosec G703
+		return errSlowConsumer
+	}
+
+	if msg.notification && w.notifications >= w.queueSize {
+		wsDroppedMessagesCounter.Inc(1)
+
+		if w.policy == config.WSSlowConsumerDisconnect {
+			w.mux.Unlock()
+			wsDisconnectedConnsCounter.Inc(1)
+			w.logger.Debug("closing slow websocket connection", "queue-size", w.queueSize)
+			_ = w.Close() // #nosec G703
+			return errSlowConsumer
+		}
+
+		w.dropOldestNotification()
+	}
+
+	w.queue = append(w.queue, msg)
+	if msg.notification {
+		w.notifications++
+	} else {
+		w.responses++
+	}
+	w.mux.Unlock()
+
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
 
-	return w.conn.WriteJSON(v)
+// dropOldestNotification removes the oldest notification from the queue. It must
+// be called with the lock held.
+func (w *wsConn) dropOldestNotification() {
+	for i, msg := range w.queue {
+		if msg.notification {
+			w.queue = append(w.queue[:i], w.queue[i+1:]...)
+			w.notifications--
+			return
+		}
+	}
 }
 
+// writeLoop writes the queued messages until the connection is closed.
+func (w *wsConn) writeLoop() {
+	for {
+		select {
+		case <-w.done:
+			return
+		case <-w.wake:
+		}
+
+		for {
+			w.mux.Lock()
+			if w.closed || len(w.queue) == 0 {
+				w.mux.Unlock()
+				break
+			}
+
+			msg := w.queue[0]
+			w.queue[0] = wsMessage{}
+			w.queue = w.queue[1:]
+			if msg.notification {
+				w.notifications--
+			} else {
+				w.responses--
+			}
+			w.mux.Unlock()
+
+			if err := w.conn.WriteJSON(msg.msg); err != nil {
+				w.logger.Debug("failed to write websocket message, closing connection", "error", err.Error())
+				_ = w.Close() // #nosec G703
+				return
+			}
+		}
+	}
+}
+
+// Close closes the connection and discards the queued messages.
 func (w *wsConn) Close() error {
 	w.mux.Lock()
 	defer w.mux.Unlock()
 
+	if w.closed {
+		return nil
+	}
+
+	w.closed = true
+	w.queue = nil
+	w.notifications = 0
+	w.responses = 0
+	close(w.done)
 	return w.conn.Close()
 }
 
@@ -172,7 +362,7 @@ func (w *wsConn) ReadMessage() (messageType int, p []byte, err error) {
 	return w.conn.ReadMessage()
 }
 
-func (s *websocketsServer) readLoop(wsConn *wsConn) {
+func (s *websocketsServer) readLoop(wsConn *wsConn, clientIP string) {
 	// subscriptions of current connection
 	subscriptions := make(map[rpc.ID]pubsub.UnsubscribeFunc)
 	defer func() {
@@ -180,6 +370,7 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 		// #nosec G705
 		for _, unsubFn := range subscriptions {
 			unsubFn()
+			wsActiveSubscriptionsGauge.Dec(1)
 		}
 	}()
 
@@ -191,6 +382,12 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			return
 		}
 
+		if s.rateLimiter != nil && !s.rateLimiter.Allow(clientIP, mb) {
+			msgs, batch, _ := parseRateLimitMessages(mb)
+			_ = wsConn.WriteJSON(rateLimitErrorResponses(msgs, batch, "rate limit exceeded")) // #nosec G703
+			continue
+		}
+
 		if isBatch(mb) {
 			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
@@ -239,6 +436,11 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 				continue
 			}
 
+			if s.maxSubscriptions > 0 && len(subscriptions) >= s.maxSubscriptions {
+				s.sendErrResponse(wsConn, fmt.Sprintf("max subscriptions per connection reached (%d)", s.maxSubscriptions))
+				continue
+			}
+
 			subID := rpc.NewID()
 			unsubFn, err := s.api.subscribe(wsConn, subID, params)
 			if err != nil {
@@ -246,6 +448,7 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 				continue
 			}
 			subscriptions[subID] = unsubFn
+			wsActiveSubscriptionsGauge.Inc(1)
 
 			res := &SubscriptionResponseJSON{
 				Jsonrpc: "2.0",
@@ -273,6 +476,7 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			if ok {
 				delete(subscriptions, subID)
 				unsubFn()
+				wsActiveSubscriptionsGauge.Dec(1)
 			}
 
 			res := &SubscriptionResponseJSON{
@@ -318,6 +522,9 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if s.rateLimiter != nil {
+		req.Header.Set(s.rateLimiter.ForwardedHeader())
+	}
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -373,7 +580,10 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface
 		}
 		return api.subscribeLogs(wsConn, subID, nil)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		if len(params) > 1 {
+			return api.subscribePendingTransactions(wsConn, subID, params[1])
+		}
+		return api.subscribePendingTransactions(wsConn, subID, nil)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
 	default:
@@ -418,7 +628,7 @@ func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (pubsub.Un
 					},
 				}
 
-				err = wsConn.WriteJSON(res)
+				err = wsConn.WriteNotification(res)
 				if err != nil {
 					api.logger.Error("error writing header, will drop peer", "error", err.Error())
 
@@ -600,7 +810,7 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 						},
 					}
 
-					err = wsConn.WriteJSON(res)
+					err = wsConn.WriteNotification(res)
 					if err != nil {
 						try(func() {
 							if err != websocket.ErrCloseSent {
@@ -621,7 +831,24 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID, extra interface{}) (pubsub.UnsubscribeFunc, error) {
+	var crit types.PendingTxsCriteria
+	if extra != nil {
+		bz, err := json.Marshal(extra)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid criteria")
+		}
+		if err := json.Unmarshal(bz, &crit); err != nil {
+			api.logger.Debug("invalid criteria", "type", fmt.Sprintf("%T", extra))
+			return nil, err
+		}
+	}
+
+	chainID, err := evmostypes.ParseChainID(api.clientCtx.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
 	sub, unsubFn, err := api.events.SubscribePendingTxs()
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating block filter: %s")
@@ -645,18 +872,18 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 					continue
 				}
 
-				for _, ethTx := range ethTxs {
+				for _, result := range crit.Results(ethTxs, chainID) {
 					// write to ws conn
 					res := &SubscriptionNotification{
 						Jsonrpc: "2.0",
 						Method:  "eth_subscription",
 						Params: &SubscriptionResult{
 							Subscription: subID,
-							Result:       ethTx.Hash,
+							Result:       result,
 						},
 					}
 
-					err = wsConn.WriteJSON(res)
+					err = wsConn.WriteNotification(res)
 					if err != nil {
 						api.logger.Debug("error writing header, will drop peer", "error", err.Error())
 
@@ -679,8 +906,151 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (pubsub.UnsubscribeFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+	sub, unsubFn, err := api.events.SubscribeNewHeads()
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating block filter")
+	}
+
+	state := &syncingState{}
+	notifySyncing := func() {
+		status, err := api.clientCtx.Client.Status(context.Background())
+		if err != nil {
+			api.logger.Debug("failed to get node status", "error", err.Error())
+			return
+		}
+
+		var highestBlock int64
+		if status.SyncInfo.CatchingUp {
+			highestBlock = api.peersHighestBlock()
+		}
+
+		result, changed := state.update(status.SyncInfo, highestBlock)
+		if !changed {
+			return
+		}
+
+		res := &SubscriptionNotification{
+			Jsonrpc: "2.0",
+			Method:  "eth_subscription",
+			Params: &SubscriptionResult{
+				Subscription: subID,
+				Result:       result,
+			},
+		}
+
+		if err := wsConn.WriteNotification(res); err != nil {
+			api.logger.Error("error writing syncing status, will drop peer", "error", err.Error())
+
+			try(func() {
+				if err != websocket.ErrCloseSent {
+					_ = wsConn.Close() // #nosec G703
+				}
+			}, api.logger, "closing websocket peer sub")
+		}
+	}
+
+	go func() {
+		// notify the current state if the node is already catching up
+		notifySyncing()
+
+		headersCh := sub.Event()
+		errCh := sub.Err()
+		for {
+			select {
+			case _, ok := <-headersCh:
+				if !ok {
+					return
+				}
+				notifySyncing()
+			case err, ok := <-errCh:
+				if !ok {
+					return
+				}
+				api.logger.Debug("dropping Syncing WebSocket subscription", "subscription-id", subID, "error", err.Error())
+			}
+		}
+	}()
+
+	return unsubFn, nil
+}
+
+// consensusStateDumper is implemented by the CometBFT clients that expose the
+// consensus state of the node, including the state of its peers.
+type consensusStateDumper interface {
+	DumpConsensusState(context.Context) (*coretypes.ResultDumpConsensusState, error)
+}
+
+// peerRoundState is the part of the consensus state of a peer holding its height.
+type peerRoundState struct {
+	RoundState struct {
+		Height int64 `json:"height,string"`
+	} `json:"round_state"`
+}
+
+// peersHighestBlock returns the highest block committed by the peers of the
+// node, or 0 if it can't be retrieved. The peers are at the height they're
+// reaching consensus on, so their last block is the one before.
+func (api *pubSubAPI) peersHighestBlock() int64 {
+	dumper, ok := api.clientCtx.Client.(consensusStateDumper)
+	if !ok {
+		return 0
+	}
+
+	res, err := dumper.DumpConsensusState(context.Background())
+	if err != nil {
+		api.logger.Debug("failed to get consensus state", "error", err.Error())
+		return 0
+	}
+
+	var highestBlock int64
+	for _, peer := range res.Peers {
+		var state peerRoundState
+		if err := json.Unmarshal(peer.PeerState, &state); err != nil {
+			continue
+		}
+		highestBlock = max(highestBlock, state.RoundState.Height-1)
+	}
+	return highestBlock
+}
+
+// syncingState tracks the catching up state of the node for a syncing subscription.
+type syncingState struct {
+	syncing  bool
+	progress types.SyncProgress
+}
+
+// update returns the notification to send when the catching up state of the node
+// changes: the sync progress when the node starts catching up or makes progress,
+// and false once it's synced. The highest block is the one of the peers, which
+// can't be below the latest block of the node.
+func (s *syncingState) update(info coretypes.SyncInfo, highestBlock int64) (interface{}, bool) {
+	if !info.CatchingUp {
+		if !s.syncing {
+			return nil, false
+		}
+		s.syncing = false
+		return false, true
+	}
+
+	progress := types.SyncProgress{
+		StartingBlock: s.progress.StartingBlock,
+		CurrentBlock:  hexutil.Uint64(info.LatestBlockHeight),                    //nolint:gosec // G115
+		HighestBlock:  hexutil.Uint64(max(highestBlock, info.LatestBlockHeight)), //nolint:gosec // G115
+	}
+	if !s.syncing {
+		progress.StartingBlock = progress.CurrentBlock
+	}
+	if s.syncing && progress == s.progress {
+		return nil, false
+	}
+
+	s.syncing = true
+	s.progress = progress
+	return &types.SyncingResult{
+		Syncing: true,
+		Status:  progress,
+	}, true
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
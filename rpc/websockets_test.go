@@ -0,0 +1,247 @@
+package rpc
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"cosmossdk.io/log"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/rpc/ethereum/pubsub"
+	"github.com/evmos/evmos/v20/rpc/types"
+	"github.com/evmos/evmos/v20/server/config"
+)
+
+func TestSyncingStateUpdate(t *testing.T) {
+	state := &syncingState{}
+
+	// a synced node doesn't notify anything
+	_, changed := state.update(coretypes.SyncInfo{LatestBlockHeight: 5}, 0)
+	require.False(t, changed)
+
+	// the node starts catching up
+	result, changed := state.update(coretypes.SyncInfo{CatchingUp: true, LatestBlockHeight: 10}, 100)
+	require.True(t, changed)
+	require.Equal(t, &types.SyncingResult{
+		Syncing: true,
+		Status:  types.SyncProgress{StartingBlock: 10, CurrentBlock: 10, HighestBlock: 100},
+	}, result)
+
+	// the progress while catching up is notified
+	result, changed = state.update(coretypes.SyncInfo{CatchingUp: true, LatestBlockHeight: 20}, 100)
+	require.True(t, changed)
+	require.Equal(t, &types.SyncingResult{
+		Syncing: true,
+		Status:  types.SyncProgress{StartingBlock: 10, CurrentBlock: 20, HighestBlock: 100},
+	}, result)
+
+	// unless nothing changed
+	_, changed = state.update(coretypes.SyncInfo{CatchingUp: true, LatestBlockHeight: 20}, 100)
+	require.False(t, changed)
+
+	// the highest block is the latest block when the peers are unknown
+	result, changed = state.update(coretypes.SyncInfo{CatchingUp: true, LatestBlockHeight: 25}, 0)
+	require.True(t, changed)
+	require.Equal(t, &types.SyncingResult{
+		Syncing: true,
+		Status:  types.SyncProgress{StartingBlock: 10, CurrentBlock: 25, HighestBlock: 25},
+	}, result)
+
+	// the node is synced
+	result, changed = state.update(coretypes.SyncInfo{LatestBlockHeight: 30}, 0)
+	require.True(t, changed)
+	require.Equal(t, false, result)
+
+	_, changed = state.update(coretypes.SyncInfo{LatestBlockHeight: 31}, 0)
+	require.False(t, changed)
+
+	// the starting block is reset when the node catches up again
+	result, changed = state.update(coretypes.SyncInfo{CatchingUp: true, LatestBlockHeight: 40}, 50)
+	require.True(t, changed)
+	require.Equal(t, &types.SyncingResult{
+		Syncing: true,
+		Status:  types.SyncProgress{StartingBlock: 40, CurrentBlock: 40, HighestBlock: 50},
+	}, result)
+}
+
+func TestPeerRoundStateHeight(t *testing.T) {
+	var state peerRoundState
+	err := json.Unmarshal([]byte(`{"round_state": {"height": "42", "round": 0}, "stats": {}}`), &state)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), state.RoundState.Height)
+}
+
+// blockingTransport is a WebSocket transport whose writes block until released.
+type blockingTransport struct {
+	writing chan interface{}
+	release chan struct{}
+	reads   chan []byte
+	closed  chan struct{}
+	once    sync.Once
+}
+
+func newBlockingTransport() *blockingTransport {
+	return &blockingTransport{
+		writing: make(chan interface{}, 100),
+		release: make(chan struct{}),
+		reads:   make(chan []byte),
+		closed:  make(chan struct{}),
+	}
+}
+
+func (t *blockingTransport) WriteJSON(v interface{}) error {
+	t.writing <- v
+	select {
+	case <-t.release:
+	case <-t.closed:
+	}
+	return nil
+}
+
+func (t *blockingTransport) ReadMessage() (int, []byte, error) {
+	select {
+	case msg := <-t.reads:
+		return websocket.TextMessage, msg, nil
+	case <-t.closed:
+		return 0, nil, websocket.ErrCloseSent
+	}
+}
+
+func (t *blockingTransport) Close() error {
+	t.once.Do(func() { close(t.closed) })
+	return nil
+}
+
+func TestWSConnDropOldest(t *testing.T) {
+	transport := newBlockingTransport()
+	conn := newWSConn(transport, 2, config.WSSlowConsumerDropOldest, log.NewNopLogger())
+	defer conn.Close()
+
+	// the first notification is being written while the others are queued
+	require.NoError(t, conn.WriteNotification(1))
+	require.Equal(t, 1, <-transport.writing)
+	require.NoError(t, conn.WriteNotification(2))
+	require.NoError(t, conn.WriteNotification(3))
+	require.NoError(t, conn.WriteJSON("response"))
+	require.NoError(t, conn.WriteNotification(4))
+
+	close(transport.release)
+	for _, expected := range []interface{}{3, "response", 4} {
+		require.Equal(t, expected, <-transport.writing)
+	}
+}
+
+func TestWSConnDisconnect(t *testing.T) {
+	transport := newBlockingTransport()
+	conn := newWSConn(transport, 1, config.WSSlowConsumerDisconnect, log.NewNopLogger())
+
+	require.NoError(t, conn.WriteNotification(1))
+	require.Equal(t, 1, <-transport.writing)
+	require.NoError(t, conn.WriteNotification(2))
+
+	// responses are bounded separately
+	require.NoError(t, conn.WriteJSON("response"))
+
+	require.ErrorIs(t, conn.WriteNotification(3), errSlowConsumer)
+	<-transport.closed
+	require.Error(t, conn.WriteJSON("response"))
+}
+
+func TestWSConnResponsesOverflow(t *testing.T) {
+	transport := newBlockingTransport()
+	conn := newWSConn(transport, 2, config.WSSlowConsumerDropOldest, log.NewNopLogger())
+
+	// the first response is being written while the others are queued
+	require.NoError(t, conn.WriteJSON(1))
+	require.Equal(t, 1, <-transport.writing)
+	require.NoError(t, conn.WriteJSON(2))
+	require.NoError(t, conn.WriteJSON(3))
+
+	// responses can't be dropped, so the connection is closed even with the
+	// drop oldest policy
+	require.ErrorIs(t, conn.WriteJSON(4), errSlowConsumer)
+	<-transport.closed
+	require.Error(t, conn.WriteNotification(5))
+}
+
+// countingSubscriber creates subscriptions that do nothing.
+type countingSubscriber struct {
+	active int
+}
+
+func (s *countingSubscriber) subscribe(*wsConn, gethrpc.ID, []interface{}) (pubsub.UnsubscribeFunc, error) {
+	s.active++
+	return func() { s.active-- }, nil
+}
+
+func TestWebsocketsMaxSubscriptions(t *testing.T) {
+	transport := newBlockingTransport()
+	close(transport.release)
+
+	api := &countingSubscriber{}
+	server := &websocketsServer{api: api, logger: log.NewNopLogger(), maxSubscriptions: 2}
+	conn := newWSConn(transport, 10, config.WSSlowConsumerDropOldest, log.NewNopLogger())
+
+	done := make(chan struct{})
+	go func() {
+		server.readLoop(conn, "10.0.0.1")
+		close(done)
+	}()
+
+	subscribe := []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_subscribe","params":["newHeads"]}`)
+	for i := 0; i < 2; i++ {
+		transport.reads <- subscribe
+		res, ok := (<-transport.writing).(*SubscriptionResponseJSON)
+		require.True(t, ok)
+		require.NotEmpty(t, res.Result)
+	}
+
+	transport.reads <- subscribe
+	res, ok := (<-transport.writing).(*ErrorResponseJSON)
+	require.True(t, ok)
+	require.Equal(t, "max subscriptions per connection reached (2)", res.Error.Message)
+	require.Equal(t, 2, api.active)
+
+	// the subscriptions are cancelled when the connection is closed
+	require.NoError(t, conn.Close())
+	<-done
+	require.Equal(t, 0, api.active)
+}
+
+func TestWebsocketsRateLimit(t *testing.T) {
+	transport := newBlockingTransport()
+	close(transport.release)
+
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.RateLimitGlobal = 0
+	cfg.RateLimitPerIP = 0.001
+	cfg.RateLimitPerIPBurst = 1
+	limiter, err := NewRateLimiter(*cfg)
+	require.NoError(t, err)
+
+	api := &countingSubscriber{}
+	server := &websocketsServer{api: api, logger: log.NewNopLogger(), rateLimiter: limiter}
+	conn := newWSConn(transport, 10, config.WSSlowConsumerDropOldest, log.NewNopLogger())
+	defer conn.Close()
+
+	go server.readLoop(conn, "10.0.0.1")
+
+	subscribe := []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_subscribe","params":["newHeads"]}`)
+	transport.reads <- subscribe
+	_, ok := (<-transport.writing).(*SubscriptionResponseJSON)
+	require.True(t, ok)
+
+	// the requests of the client are charged to its IP
+	transport.reads <- subscribe
+	res, ok := (<-transport.writing).(rateLimitErrorResponse)
+	require.True(t, ok)
+	require.Equal(t, RateLimitErrorCode, res.Error.Code)
+	require.Equal(t, 1, api.active)
+
+	// a different client has its own bucket
+	require.True(t, limiter.Allow("10.0.0.2", subscribe))
+}
@@ -5,12 +5,15 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net"
 	"path"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
 
-	"github.com/cometbft/cometbft/libs/strings"
+	cmtstrings "github.com/cometbft/cometbft/libs/strings"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -26,6 +29,15 @@ import (
 	_ "github.com/evmos/evmos/v20/server/config/migration" // Add this import to set up the proper app.toml migration logic for sdk v0.50
 )
 
+const (
+	// WSSlowConsumerDropOldest drops the oldest queued notification of a WebSocket
+	// connection when its outbound queue is full.
+	WSSlowConsumerDropOldest = "drop-oldest"
+
+	// WSSlowConsumerDisconnect closes the WebSocket connections whose outbound queue is full.
+	WSSlowConsumerDisconnect = "disconnect"
+)
+
 const (
 	// ServerStartTime defines the time duration that the server need to stay running after startup
 	// for the startup be considered successful
@@ -70,6 +82,21 @@ const (
 	// DefaultMaxTxGasWanted is the default gas wanted for each eth tx returned in ante handler in check tx mode
 	DefaultMaxTxGasWanted = 0
 
+	// DefaultEVMMempoolMaxTxs is the default maximum number of transactions in the app-side EVM mempool
+	DefaultEVMMempoolMaxTxs = 5000
+
+	// DefaultEVMMempoolPriceBump is the default minimum price bump percentage to replace a transaction in the mempool
+	DefaultEVMMempoolPriceBump uint64 = 10
+
+	// DefaultEVMMempoolMaxNonceGap is the default maximum distance between the nonce of a queued transaction and the account nonce
+	DefaultEVMMempoolMaxNonceGap uint64 = 64
+
+	// DefaultEVMMempoolMaxTxsPerSender is the default maximum number of transactions of a sender in the app-side EVM mempool
+	DefaultEVMMempoolMaxTxsPerSender = 64
+
+	// DefaultEVMMempoolQueuedTTL is the default time a transaction queued after a nonce gap stays in the mempool
+	DefaultEVMMempoolQueuedTTL = 3 * time.Hour
+
 	// DefaultGasCap is the default cap on gas that can be used in eth_call/estimateGas
 	DefaultGasCap uint64 = 25000000
 
@@ -106,6 +133,30 @@ const (
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
 
+	// DefaultRateLimitGlobal is the default number of request units per second served to all the clients
+	DefaultRateLimitGlobal float64 = 1000
+
+	// DefaultRateLimitGlobalBurst is the default max number of request units served at once to all the clients
+	DefaultRateLimitGlobalBurst = 2000
+
+	// DefaultRateLimitPerIP is the default number of request units per second served to a single client IP
+	DefaultRateLimitPerIP float64 = 100
+
+	// DefaultRateLimitPerIPBurst is the default max number of request units served at once to a single client IP
+	DefaultRateLimitPerIPBurst = 200
+
+	// DefaultMaxConcurrentTraces is the default max number of trace requests served at once (0=unlimited)
+	DefaultMaxConcurrentTraces = 4
+
+	// DefaultWSMaxSubscriptionsPerConn is the default max number of subscriptions of a WebSocket connection (0=unlimited)
+	DefaultWSMaxSubscriptionsPerConn = 100
+
+	// DefaultWSOutboundQueueSize is the default max number of notifications, and of responses, queued for a WebSocket connection
+	DefaultWSOutboundQueueSize = 1000
+
+	// DefaultWSSlowConsumerPolicy is the default policy applied to the WebSocket connections with a full queue
+	DefaultWSSlowConsumerPolicy = WSSlowConsumerDropOldest
+
 	// DefaultGasAdjustment value to use as default in gas-adjustment flag
 	DefaultGasAdjustment = 1.2
 
@@ -176,6 +227,18 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// MempoolMaxTxs defines the maximum number of transactions in the app-side EVM mempool.
+	// A negative value disables it in favor of the CometBFT mempool ordering, while 0 means the default.
+	MempoolMaxTxs int `mapstructure:"mempool-max-txs"`
+	// MempoolPriceBump defines the minimum price bump percentage to replace a transaction in the mempool.
+	MempoolPriceBump uint64 `mapstructure:"mempool-price-bump"`
+	// MempoolMaxNonceGap defines the maximum distance between the nonce of a queued transaction
+	// and the nonce of its sender.
+	MempoolMaxNonceGap uint64 `mapstructure:"mempool-max-nonce-gap"`
+	// MempoolMaxTxsPerSender defines the maximum number of transactions of a single sender in the mempool.
+	MempoolMaxTxsPerSender int `mapstructure:"mempool-max-txs-per-sender"`
+	// MempoolQueuedTTL defines the time a transaction queued after a nonce gap stays in the mempool.
+	MempoolQueuedTTL time.Duration `mapstructure:"mempool-queued-ttl"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -220,6 +283,32 @@ type JSONRPCConfig struct {
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
 	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
+	// EnableRateLimit defines if the HTTP JSON-RPC requests are rate limited.
+	EnableRateLimit bool `mapstructure:"enable-rate-limit"`
+	// RateLimitGlobal is the number of request units per second served to all the clients (0=unlimited).
+	RateLimitGlobal float64 `mapstructure:"rate-limit-global"`
+	// RateLimitGlobalBurst is the max number of request units served at once to all the clients.
+	RateLimitGlobalBurst int `mapstructure:"rate-limit-global-burst"`
+	// RateLimitPerIP is the number of request units per second served to a single client IP (0=unlimited).
+	RateLimitPerIP float64 `mapstructure:"rate-limit-per-ip"`
+	// RateLimitPerIPBurst is the max number of request units served at once to a single client IP.
+	RateLimitPerIPBurst int `mapstructure:"rate-limit-per-ip-burst"`
+	// RateLimitMethodWeights defines the cost in request units of the JSON-RPC methods as
+	// "method=weight" entries. A trailing "*" matches every method with the given prefix
+	// (e.g. "debug_*"). Methods that aren't listed cost 1 unit.
+	RateLimitMethodWeights []string `mapstructure:"rate-limit-method-weights"`
+	// RateLimitTrustedProxies defines the IP addresses or CIDR ranges of the reverse proxies
+	// whose X-Forwarded-For header is used to get the client IP.
+	RateLimitTrustedProxies []string `mapstructure:"rate-limit-trusted-proxies"`
+	// MaxConcurrentTraces is the max number of trace requests served at once (0=unlimited).
+	MaxConcurrentTraces int `mapstructure:"max-concurrent-traces"`
+	// WSMaxSubscriptionsPerConn is the max number of subscriptions of a WebSocket connection (0=unlimited).
+	WSMaxSubscriptionsPerConn int `mapstructure:"ws-max-subscriptions-per-connection"`
+	// WSOutboundQueueSize is the max number of notifications, and of responses, queued for a WebSocket connection.
+	WSOutboundQueueSize int `mapstructure:"ws-outbound-queue-size"`
+	// WSSlowConsumerPolicy is the policy applied to the WebSocket connections whose outbound
+	// queue is full: "drop-oldest" or "disconnect".
+	WSSlowConsumerPolicy string `mapstructure:"ws-slow-consumer-policy"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -302,14 +391,19 @@ func DefaultConfig() *Config {
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
-		Tracer:         DefaultEVMTracer,
-		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		Tracer:                 DefaultEVMTracer,
+		MaxTxGasWanted:         DefaultMaxTxGasWanted,
+		MempoolMaxTxs:          DefaultEVMMempoolMaxTxs,
+		MempoolPriceBump:       DefaultEVMMempoolPriceBump,
+		MempoolMaxNonceGap:     DefaultEVMMempoolMaxNonceGap,
+		MempoolMaxTxsPerSender: DefaultEVMMempoolMaxTxsPerSender,
+		MempoolQueuedTTL:       DefaultEVMMempoolQueuedTTL,
 	}
 }
 
 // Validate returns an error if the tracer type is invalid.
 func (c EVMConfig) Validate() error {
-	if c.Tracer != "" && !strings.StringInSlice(c.Tracer, evmTracers) {
+	if c.Tracer != "" && !cmtstrings.StringInSlice(c.Tracer, evmTracers) {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
@@ -323,32 +417,107 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace", "cosmos"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
 func DefaultJSONRPCConfig() *JSONRPCConfig {
 	return &JSONRPCConfig{
-		Enable:                   false,
-		API:                      GetDefaultAPINamespaces(),
-		Address:                  DefaultJSONRPCAddress,
-		WsAddress:                DefaultJSONRPCWsAddress,
-		GasCap:                   DefaultGasCap,
-		AllowInsecureUnlock:      DefaultJSONRPCAllowInsecureUnlock,
-		EVMTimeout:               DefaultEVMTimeout,
-		TxFeeCap:                 DefaultTxFeeCap,
-		FilterCap:                DefaultFilterCap,
-		FeeHistoryCap:            DefaultFeeHistoryCap,
-		BlockRangeCap:            DefaultBlockRangeCap,
-		LogsCap:                  DefaultLogsCap,
-		HTTPTimeout:              DefaultHTTPTimeout,
-		HTTPIdleTimeout:          DefaultHTTPIdleTimeout,
-		AllowUnprotectedTxs:      DefaultAllowUnprotectedTxs,
-		MaxOpenConnections:       DefaultMaxOpenConnections,
-		EnableIndexer:            false,
-		MetricsAddress:           DefaultJSONRPCMetricsAddress,
-		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
+		Enable:                    false,
+		API:                       GetDefaultAPINamespaces(),
+		Address:                   DefaultJSONRPCAddress,
+		WsAddress:                 DefaultJSONRPCWsAddress,
+		GasCap:                    DefaultGasCap,
+		AllowInsecureUnlock:       DefaultJSONRPCAllowInsecureUnlock,
+		EVMTimeout:                DefaultEVMTimeout,
+		TxFeeCap:                  DefaultTxFeeCap,
+		FilterCap:                 DefaultFilterCap,
+		FeeHistoryCap:             DefaultFeeHistoryCap,
+		BlockRangeCap:             DefaultBlockRangeCap,
+		LogsCap:                   DefaultLogsCap,
+		HTTPTimeout:               DefaultHTTPTimeout,
+		HTTPIdleTimeout:           DefaultHTTPIdleTimeout,
+		AllowUnprotectedTxs:       DefaultAllowUnprotectedTxs,
+		MaxOpenConnections:        DefaultMaxOpenConnections,
+		EnableIndexer:             false,
+		MetricsAddress:            DefaultJSONRPCMetricsAddress,
+		FixRevertGasRefundHeight:  DefaultFixRevertGasRefundHeight,
+		EnableRateLimit:           false,
+		RateLimitGlobal:           DefaultRateLimitGlobal,
+		RateLimitGlobalBurst:      DefaultRateLimitGlobalBurst,
+		RateLimitPerIP:            DefaultRateLimitPerIP,
+		RateLimitPerIPBurst:       DefaultRateLimitPerIPBurst,
+		RateLimitMethodWeights:    GetDefaultRateLimitMethodWeights(),
+		RateLimitTrustedProxies:   []string{},
+		MaxConcurrentTraces:       DefaultMaxConcurrentTraces,
+		WSMaxSubscriptionsPerConn: DefaultWSMaxSubscriptionsPerConn,
+		WSOutboundQueueSize:       DefaultWSOutboundQueueSize,
+		WSSlowConsumerPolicy:      DefaultWSSlowConsumerPolicy,
+	}
+}
+
+// GetDefaultRateLimitMethodWeights returns the default request unit costs of the
+// expensive JSON-RPC methods.
+func GetDefaultRateLimitMethodWeights() []string {
+	return []string{
+		"eth_call=5",
+		"eth_estimateGas=5",
+		"eth_getLogs=10",
+		"eth_getFilterLogs=10",
+		"debug_*=50",
+		"trace_*=50",
+	}
+}
+
+// MethodWeights parses the rate limit method weights into a map from the method
+// name (or the method prefix followed by "*") to its cost in request units.
+func (c JSONRPCConfig) MethodWeights() (map[string]int, error) {
+	weights := make(map[string]int, len(c.RateLimitMethodWeights))
+	for _, entry := range c.RateLimitMethodWeights {
+		method, weightStr, found := strings.Cut(entry, "=")
+		method = strings.TrimSpace(method)
+		if !found || method == "" {
+			return nil, fmt.Errorf("invalid rate limit method weight '%s', expected 'method=weight'", entry)
+		}
+
+		weight, err := strconv.Atoi(strings.TrimSpace(weightStr))
+		if err != nil || weight <= 0 {
+			return nil, fmt.Errorf("invalid rate limit weight for method '%s', expected a positive integer", method)
+		}
+
+		if _, ok := weights[method]; ok {
+			return nil, fmt.Errorf("repeated rate limit method weight '%s'", method)
+		}
+
+		weights[method] = weight
+	}
+
+	return weights, nil
+}
+
+// TrustedProxies parses the rate limit trusted proxies into IP networks. The
+// single IP addresses are converted to networks of only one address.
+func (c JSONRPCConfig) TrustedProxies() ([]*net.IPNet, error) {
+	proxies := make([]*net.IPNet, 0, len(c.RateLimitTrustedProxies))
+	for _, entry := range c.RateLimitTrustedProxies {
+		entry = strings.TrimSpace(entry)
+		if _, ipNet, err := net.ParseCIDR(entry); err == nil {
+			proxies = append(proxies, ipNet)
+			continue
+		}
+
+		ip := net.ParseIP(entry)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid rate limit trusted proxy '%s', expected an IP address or a CIDR range", entry)
+		}
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, bits = ip4, 8*net.IPv4len
+		}
+		proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
 	}
+
+	return proxies, nil
 }
 
 // Validate returns an error if the JSON-RPC configuration fields are invalid.
@@ -389,6 +558,39 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.RateLimitGlobal < 0 || c.RateLimitPerIP < 0 {
+		return errors.New("JSON-RPC rate limits cannot be negative")
+	}
+
+	if (c.RateLimitGlobal > 0 && c.RateLimitGlobalBurst <= 0) || (c.RateLimitPerIP > 0 && c.RateLimitPerIPBurst <= 0) {
+		return errors.New("JSON-RPC rate limit bursts cannot be negative or 0")
+	}
+
+	if c.MaxConcurrentTraces < 0 {
+		return errors.New("JSON-RPC max concurrent traces cannot be negative")
+	}
+
+	if _, err := c.MethodWeights(); err != nil {
+		return err
+	}
+
+	if _, err := c.TrustedProxies(); err != nil {
+		return err
+	}
+
+	if c.WSMaxSubscriptionsPerConn < 0 {
+		return errors.New("JSON-RPC WebSocket max subscriptions per connection cannot be negative")
+	}
+
+	if c.WSOutboundQueueSize <= 0 {
+		return errors.New("JSON-RPC WebSocket outbound queue size cannot be negative or 0")
+	}
+
+	if c.WSSlowConsumerPolicy != WSSlowConsumerDropOldest && c.WSSlowConsumerPolicy != WSSlowConsumerDisconnect {
+		return fmt.Errorf("invalid JSON-RPC WebSocket slow consumer policy '%s', expected '%s' or '%s'",
+			c.WSSlowConsumerPolicy, WSSlowConsumerDropOldest, WSSlowConsumerDisconnect)
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -486,9 +688,38 @@ func GetConfig(v *viper.Viper) (Config, error) {
 	if err := v.Unmarshal(conf); err != nil {
 		return Config{}, fmt.Errorf("error extracting app config: %w", err)
 	}
+
+	// the decoder overwrites the default slices element by element without truncating
+	// them, so the configured lists have to be set explicitly
+	if v.IsSet("json-rpc.api") {
+		conf.JSONRPC.API = getStringSlice(v, "json-rpc.api")
+	}
+	if v.IsSet("json-rpc.rate-limit-method-weights") {
+		conf.JSONRPC.RateLimitMethodWeights = getStringSlice(v, "json-rpc.rate-limit-method-weights")
+	}
+	if v.IsSet("json-rpc.rate-limit-trusted-proxies") {
+		conf.JSONRPC.RateLimitTrustedProxies = getStringSlice(v, "json-rpc.rate-limit-trusted-proxies")
+	}
 	return *conf, nil
 }
 
+// getStringSlice returns the list value of the key, splitting the comma-separated
+// strings used in the app.toml.
+func getStringSlice(v *viper.Viper, key string) []string {
+	value, ok := v.Get(key).(string)
+	if !ok {
+		return v.GetStringSlice(key)
+	}
+
+	values := []string{}
+	for _, elem := range strings.Split(value, ",") {
+		if elem = strings.TrimSpace(elem); elem != "" {
+			values = append(values, elem)
+		}
+	}
+	return values
+}
+
 // ValidateBasic returns an error any of the application configuration fields are invalid
 func (c Config) ValidateBasic() error {
 	if err := c.EVM.Validate(); err != nil {
@@ -54,6 +54,36 @@ func TestGetConfig(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"test unmarshal fewer JSON-RPC namespaces than the default ones",
+			func() *viper.Viper {
+				v := viper.New()
+				v.Set("json-rpc.api", "eth")
+				return v
+			},
+			func() Config {
+				cfg := DefaultConfig()
+				cfg.JSONRPC.API = []string{"eth"}
+				return *cfg
+			},
+			false,
+		},
+		{
+			"test unmarshal rate limit method weights",
+			func() *viper.Viper {
+				v := viper.New()
+				v.Set("json-rpc.enable-rate-limit", true)
+				v.Set("json-rpc.rate-limit-method-weights", "eth_getLogs=20,debug_*=100")
+				return v
+			},
+			func() Config {
+				cfg := DefaultConfig()
+				cfg.JSONRPC.EnableRateLimit = true
+				cfg.JSONRPC.RateLimitMethodWeights = []string{"eth_getLogs=20", "debug_*=100"}
+				return *cfg
+			},
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -68,3 +98,106 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONRPCConfigMethodWeights(t *testing.T) {
+	testCases := []struct {
+		name    string
+		weights []string
+		expect  map[string]int
+		expErr  bool
+	}{
+		{"default weights", GetDefaultRateLimitMethodWeights(), map[string]int{
+			"eth_call": 5, "eth_estimateGas": 5, "eth_getLogs": 10, "eth_getFilterLogs": 10, "debug_*": 50, "trace_*": 50,
+		}, false},
+		{"no weights", nil, map[string]int{}, false},
+		{"spaces are trimmed", []string{" eth_call = 3 "}, map[string]int{"eth_call": 3}, false},
+		{"missing weight", []string{"eth_call"}, nil, true},
+		{"missing method", []string{"=3"}, nil, true},
+		{"zero weight", []string{"eth_call=0"}, nil, true},
+		{"invalid weight", []string{"eth_call=a"}, nil, true},
+		{"repeated method", []string{"eth_call=1", "eth_call=2"}, nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultJSONRPCConfig()
+			cfg.RateLimitMethodWeights = tc.weights
+
+			weights, err := cfg.MethodWeights()
+			if tc.expErr {
+				require.Error(t, err)
+				require.Error(t, cfg.Validate())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, weights)
+			require.NoError(t, cfg.Validate())
+		})
+	}
+}
+
+func TestJSONRPCConfigTrustedProxies(t *testing.T) {
+	testCases := []struct {
+		name    string
+		proxies []string
+		expect  []string
+		expErr  bool
+	}{
+		{"no proxies", nil, []string{}, false},
+		{"IPv4 address", []string{"127.0.0.1"}, []string{"127.0.0.1/32"}, false},
+		{"IPv6 address", []string{"::1"}, []string{"::1/128"}, false},
+		{"CIDR ranges", []string{"10.0.0.0/8", " fd00::/8 "}, []string{"10.0.0.0/8", "fd00::/8"}, false},
+		{"invalid address", []string{"localhost"}, nil, true},
+		{"invalid CIDR range", []string{"10.0.0.0/33"}, nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultJSONRPCConfig()
+			cfg.RateLimitTrustedProxies = tc.proxies
+
+			proxies, err := cfg.TrustedProxies()
+			if tc.expErr {
+				require.Error(t, err)
+				require.Error(t, cfg.Validate())
+				return
+			}
+			require.NoError(t, err)
+			networks := make([]string, len(proxies))
+			for i, proxy := range proxies {
+				networks[i] = proxy.String()
+			}
+			require.Equal(t, tc.expect, networks)
+			require.NoError(t, cfg.Validate())
+		})
+	}
+}
+
+func TestJSONRPCConfigValidateWebsockets(t *testing.T) {
+	testCases := []struct {
+		name   string
+		modify func(cfg *JSONRPCConfig)
+		expErr bool
+	}{
+		{"default", func(*JSONRPCConfig) {}, false},
+		{"unlimited subscriptions", func(cfg *JSONRPCConfig) { cfg.WSMaxSubscriptionsPerConn = 0 }, false},
+		{"disconnect policy", func(cfg *JSONRPCConfig) { cfg.WSSlowConsumerPolicy = WSSlowConsumerDisconnect }, false},
+		{"negative max subscriptions", func(cfg *JSONRPCConfig) { cfg.WSMaxSubscriptionsPerConn = -1 }, true},
+		{"zero queue size", func(cfg *JSONRPCConfig) { cfg.WSOutboundQueueSize = 0 }, true},
+		{"unknown policy", func(cfg *JSONRPCConfig) { cfg.WSSlowConsumerPolicy = "block" }, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultJSONRPCConfig()
+			tc.modify(cfg)
+
+			err := cfg.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -18,6 +18,24 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# MempoolMaxTxs defines the maximum number of transactions in the app-side EVM mempool, which
+# queues the transactions with a future nonce and allows to replace pending ones.
+# A negative value disables it in favor of the CometBFT mempool ordering, while 0 means the default.
+mempool-max-txs = {{ .EVM.MempoolMaxTxs }}
+
+# MempoolPriceBump defines the minimum price bump percentage to replace a transaction in the mempool.
+mempool-price-bump = {{ .EVM.MempoolPriceBump }}
+
+# MempoolMaxNonceGap defines the maximum distance between the nonce of a queued transaction
+# and the nonce of its sender.
+mempool-max-nonce-gap = {{ .EVM.MempoolMaxNonceGap }}
+
+# MempoolMaxTxsPerSender defines the maximum number of transactions of a single sender in the mempool.
+mempool-max-txs-per-sender = {{ .EVM.MempoolMaxTxsPerSender }}
+
+# MempoolQueuedTTL defines the time a transaction queued after a nonce gap stays in the mempool.
+mempool-queued-ttl = "{{ .EVM.MempoolQueuedTTL }}"
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -85,6 +103,48 @@ metrics-address = "{{ .JSONRPC.MetricsAddress }}"
 # Upgrade height for fix of revert gas refund logic when transaction reverted.
 fix-revert-gas-refund-height = {{ .JSONRPC.FixRevertGasRefundHeight }}
 
+# EnableRateLimit defines if the HTTP JSON-RPC requests are rate limited. Each request costs
+# a number of units given by its method weight, which are taken from both a per-IP and a global
+# token bucket. Rejected requests return the JSON-RPC error code -32005.
+enable-rate-limit = {{ .JSONRPC.EnableRateLimit }}
+
+# RateLimitGlobal is the number of request units per second served to all the clients (0=unlimited).
+rate-limit-global = {{ .JSONRPC.RateLimitGlobal }}
+
+# RateLimitGlobalBurst is the max number of request units served at once to all the clients.
+rate-limit-global-burst = {{ .JSONRPC.RateLimitGlobalBurst }}
+
+# RateLimitPerIP is the number of request units per second served to a single client IP (0=unlimited).
+rate-limit-per-ip = {{ .JSONRPC.RateLimitPerIP }}
+
+# RateLimitPerIPBurst is the max number of request units served at once to a single client IP.
+rate-limit-per-ip-burst = {{ .JSONRPC.RateLimitPerIPBurst }}
+
+# RateLimitMethodWeights defines the cost in request units of the JSON-RPC methods as "method=weight".
+# A trailing "*" matches every method with the given prefix. Methods that aren't listed cost 1 unit.
+# Example: "eth_getLogs=10,debug_*=50"
+rate-limit-method-weights = "{{range $index, $elmt := .JSONRPC.RateLimitMethodWeights}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# RateLimitTrustedProxies defines the IP addresses or CIDR ranges of the reverse proxies in front of
+# the JSON-RPC server. The client IP is taken from the X-Forwarded-For header of their requests.
+# Example: "127.0.0.1,10.0.0.0/8"
+rate-limit-trusted-proxies = "{{range $index, $elmt := .JSONRPC.RateLimitTrustedProxies}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# MaxConcurrentTraces is the max number of debug_trace* and trace_* requests served at once
+# when the rate limit is enabled (0=unlimited).
+max-concurrent-traces = {{ .JSONRPC.MaxConcurrentTraces }}
+
+# WSMaxSubscriptionsPerConn is the max number of subscriptions of a WebSocket connection (0=unlimited).
+ws-max-subscriptions-per-connection = {{ .JSONRPC.WSMaxSubscriptionsPerConn }}
+
+# WSOutboundQueueSize is the max number of notifications, and of responses, queued for a WebSocket connection.
+ws-outbound-queue-size = {{ .JSONRPC.WSOutboundQueueSize }}
+
+# WSSlowConsumerPolicy is the policy applied to the WebSocket connections whose outbound queue is full:
+# "drop-oldest" drops the oldest queued notification, "disconnect" closes the connection.
+# The connection is always closed when the queued responses overflow.
+ws-slow-consumer-policy = "{{ .JSONRPC.WSSlowConsumerPolicy }}"
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
@@ -56,14 +56,30 @@ const (
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
-	JSONRPCEnableMetrics            = "metrics"
-	JSONRPCFixRevertGasRefundHeight = "json-rpc.fix-revert-gas-refund-height"
+	JSONRPCEnableMetrics             = "metrics"
+	JSONRPCFixRevertGasRefundHeight  = "json-rpc.fix-revert-gas-refund-height"
+	JSONRPCEnableRateLimit           = "json-rpc.enable-rate-limit"
+	JSONRPCRateLimitGlobal           = "json-rpc.rate-limit-global"
+	JSONRPCRateLimitGlobalBurst      = "json-rpc.rate-limit-global-burst"
+	JSONRPCRateLimitPerIP            = "json-rpc.rate-limit-per-ip"
+	JSONRPCRateLimitPerIPBurst       = "json-rpc.rate-limit-per-ip-burst"
+	JSONRPCRateLimitMethodWeights    = "json-rpc.rate-limit-method-weights"
+	JSONRPCRateLimitTrustedProxies   = "json-rpc.rate-limit-trusted-proxies"
+	JSONRPCMaxConcurrentTraces       = "json-rpc.max-concurrent-traces"
+	JSONRPCWSMaxSubscriptionsPerConn = "json-rpc.ws-max-subscriptions-per-connection"
+	JSONRPCWSOutboundQueueSize       = "json-rpc.ws-outbound-queue-size"
+	JSONRPCWSSlowConsumerPolicy      = "json-rpc.ws-slow-consumer-policy"
 )
 
 // EVM flags
 const (
-	EVMTracer         = "evm.tracer"
-	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
+	EVMTracer                 = "evm.tracer"
+	EVMMaxTxGasWanted         = "evm.max-tx-gas-wanted"
+	EVMMempoolMaxTxs          = "evm.mempool-max-txs"
+	EVMMempoolPriceBump       = "evm.mempool-price-bump"
+	EVMMempoolMaxNonceGap     = "evm.mempool-max-nonce-gap"
+	EVMMempoolMaxTxsPerSender = "evm.mempool-max-txs-per-sender"
+	EVMMempoolQueuedTTL       = "evm.mempool-queued-ttl"
 )
 
 // TLS flags
@@ -51,19 +51,10 @@ func NewIndexTxCmd() *cobra.Command {
 			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
 
 			// open local tendermint db, because the local rpc won't be available.
-			cmtdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
+			blockStore, stateStore, err := openCometStores(cfg)
 			if err != nil {
 				return err
 			}
-			blockStore := cmtstore.NewBlockStore(cmtdb)
-
-			stateDB, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "state", Config: cfg})
-			if err != nil {
-				return err
-			}
-			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
-				DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
-			})
 
 			indexBlock := func(height int64) error {
 				blk := blockStore.LoadBlock(height)
@@ -119,3 +110,85 @@ func NewIndexTxCmd() *cobra.Command {
 	}
 	return cmd
 }
+
+// NewIndexLogsCmd creates a new Cobra command to backfill the log index of the
+// historical Ethereum transactions.
+func NewIndexLogsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "index-eth-logs",
+		Short: "Index the logs of historical eth txs",
+		Long: `Index the blooms and the logs of historical eth txs, to build the log index on the nodes that indexed their eth txs before it was introduced.
+		The blocks are indexed from the first block of the log index to the earliest block in the chain, if the log index is empty, start from the latest block.
+		It stops at the first block whose results are not available, e.g. because they have been pruned.
+		`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cfg := serverCtx.Config
+			logger := serverCtx.Logger
+			idxDB, err := OpenIndexerDB(cfg.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				logger.Error("failed to open evm indexer DB", "error", err.Error())
+				return err
+			}
+			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
+
+			// open local tendermint db, because the local rpc won't be available.
+			blockStore, stateStore, err := openCometStores(cfg)
+			if err != nil {
+				return err
+			}
+
+			first, _, err := idxer.LogIndexRange()
+			if err != nil {
+				return err
+			}
+			if first == -1 {
+				// start from the latest block if the log index is empty
+				first = blockStore.Height() + 1
+			}
+			for i := first - 1; i > 0; i-- {
+				blk := blockStore.LoadBlock(i)
+				if blk == nil {
+					logger.Info("block not found, stop indexing", "height", i)
+					return nil
+				}
+				resBlk, err := stateStore.LoadFinalizeBlockResponse(i)
+				if err != nil {
+					logger.Info("block results not available, stop indexing", "height", i, "error", err.Error())
+					return nil
+				}
+				if err := idxer.IndexBlockLogs(blk, resBlk.TxResults); err != nil {
+					return err
+				}
+				fmt.Println(i)
+			}
+
+			return nil
+		},
+	}
+	return cmd
+}
+
+// openCometStores opens the local CometBFT block and state stores.
+func openCometStores(cfg *cmtconfig.Config) (*cmtstore.BlockStore, sm.Store, error) {
+	cmtdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return nil, nil, err
+	}
+	blockStore := cmtstore.NewBlockStore(cmtdb)
+
+	stateDB, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return nil, nil, err
+	}
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+	})
+	return blockStore, stateStore, nil
+}
@@ -26,6 +26,7 @@ func StartJSONRPC(ctx *server.Context,
 	tmEndpoint string,
 	config *svrconfig.Config,
 	indexer evmostypes.EVMTxIndexer,
+	txPool evmostypes.EVMTxPool,
 ) (*http.Server, chan struct{}, error) {
 	tmWsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
 
@@ -47,7 +48,7 @@ func StartJSONRPC(ctx *server.Context,
 	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
 	rpcAPIArr := config.JSONRPC.API
 
-	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, rpcAPIArr)
+	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, txPool, rpcAPIArr)
 
 	for _, api := range apis {
 		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
@@ -60,8 +61,21 @@ func StartJSONRPC(ctx *server.Context,
 		}
 	}
 
+	var (
+		rpcHandler  http.Handler = rpcServer
+		rateLimiter *rpc.RateLimiter
+		err         error
+	)
+	if config.JSONRPC.EnableRateLimit {
+		rateLimiter, err = rpc.NewRateLimiter(config.JSONRPC)
+		if err != nil {
+			return nil, nil, err
+		}
+		rpcHandler = rateLimiter.Handler(rpcServer)
+	}
+
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", rpcHandler).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -108,7 +122,7 @@ func StartJSONRPC(ctx *server.Context,
 
 	// allocate separate WS connection to Tendermint
 	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config)
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config, rateLimiter)
 	wsSrv.Start()
 	return httpSrv, httpSrvDone, nil
 }
@@ -50,6 +50,7 @@ import (
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/rosetta"
 
 	"github.com/evmos/evmos/v20/cmd/evmosd/opendb"
@@ -203,9 +204,25 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableRateLimit, false, "Define if the HTTP JSON-RPC requests should be rate limited")
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimitGlobal, config.DefaultRateLimitGlobal, "Sets the number of request units per second served to all the clients")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitGlobalBurst, config.DefaultRateLimitGlobalBurst, "Sets the max number of request units served at once to all the clients")
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimitPerIP, config.DefaultRateLimitPerIP, "Sets the number of request units per second served to a single client IP")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitPerIPBurst, config.DefaultRateLimitPerIPBurst, "Sets the max number of request units served at once to a single client IP")
+	cmd.Flags().StringSlice(srvflags.JSONRPCRateLimitMethodWeights, config.GetDefaultRateLimitMethodWeights(), "Defines the cost in request units of the JSON-RPC methods as 'method=weight'") //nolint:lll
+	cmd.Flags().StringSlice(srvflags.JSONRPCRateLimitTrustedProxies, []string{}, "Defines the IP addresses or CIDR ranges of the reverse proxies whose X-Forwarded-For header is trusted")     //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCMaxConcurrentTraces, config.DefaultMaxConcurrentTraces, "Sets the max number of trace requests served at once (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCWSMaxSubscriptionsPerConn, config.DefaultWSMaxSubscriptionsPerConn, "Sets the max number of subscriptions of a WebSocket connection (0=unlimited)") //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCWSOutboundQueueSize, config.DefaultWSOutboundQueueSize, "Sets the max number of notifications, and of responses, queued for a WebSocket connection")
+	cmd.Flags().String(srvflags.JSONRPCWSSlowConsumerPolicy, config.DefaultWSSlowConsumerPolicy, "Sets the policy applied to the WebSocket connections with a full queue (drop-oldest|disconnect)") //nolint:lll
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
+	cmd.Flags().Int(srvflags.EVMMempoolMaxTxs, config.DefaultEVMMempoolMaxTxs, "the maximum number of transactions in the app-side EVM mempool (-1=disabled)")                               //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMMempoolPriceBump, config.DefaultEVMMempoolPriceBump, "the minimum price bump percentage to replace a transaction in the mempool")                         //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMMempoolMaxNonceGap, config.DefaultEVMMempoolMaxNonceGap, "the maximum distance between the nonce of a queued transaction and the nonce of its sender")    //nolint:lll
+	cmd.Flags().Int(srvflags.EVMMempoolMaxTxsPerSender, config.DefaultEVMMempoolMaxTxsPerSender, "the maximum number of transactions of a single sender in the mempool")                     //nolint:lll
+	cmd.Flags().Duration(srvflags.EVMMempoolQueuedTTL, config.DefaultEVMMempoolQueuedTTL, "the time a transaction queued after a nonce gap stays in the mempool")                            //nolint:lll
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -440,6 +457,12 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		})
 	}
 
+	// the txpool namespace reads the app-side mempool, when it's enabled
+	var txPool evmostypes.EVMTxPool
+	if mpApp, ok := app.(interface{ Mempool() sdkmempool.Mempool }); ok {
+		txPool, _ = mpApp.Mempool().(evmostypes.EVMTxPool)
+	}
+
 	if config.API.Enable || config.JSONRPC.Enable {
 		genDoc, err := genDocProvider()
 		if err != nil {
@@ -464,7 +487,7 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		defer apiSrv.Close()
 	}
 
-	clientCtx, httpSrv, httpSrvDone, err := startJSONRPCServer(svrCtx, clientCtx, g, config, genDocProvider, cfg.RPC.ListenAddress, idxer)
+	clientCtx, httpSrv, httpSrvDone, err := startJSONRPCServer(svrCtx, clientCtx, g, config, genDocProvider, cfg.RPC.ListenAddress, idxer, txPool)
 	if httpSrv != nil {
 		defer func() {
 			shutdownCtx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
@@ -639,6 +662,7 @@ func startAPIServer(
 // - genDocProvider: A function that provides the Genesis document, used to retrieve the chain ID.
 // - cmtRPCAddr: The address of the CometBFT RPC server for WebSocket connections.
 // - idxer: The EVM transaction indexer for indexing transactions.
+// - txPool: The app-side mempool read by the txpool namespace, nil if it's disabled.
 func startJSONRPCServer(
 	svrCtx *server.Context,
 	clientCtx client.Context,
@@ -647,6 +671,7 @@ func startJSONRPCServer(
 	genDocProvider node.GenesisDocProvider,
 	cmtRPCAddr string,
 	idxer evmostypes.EVMTxIndexer,
+	txPool evmostypes.EVMTxPool,
 ) (ctx client.Context, httpSrv *http.Server, httpSrvDone chan struct{}, err error) {
 	ctx = clientCtx
 	if !config.JSONRPC.Enable {
@@ -661,7 +686,7 @@ func startJSONRPCServer(
 	ctx = clientCtx.WithChainID(genDoc.ChainID)
 	cmtEndpoint := "/websocket"
 	g.Go(func() error {
-		httpSrv, httpSrvDone, err = StartJSONRPC(svrCtx, clientCtx, cmtRPCAddr, cmtEndpoint, &config, idxer)
+		httpSrv, httpSrvDone, err = StartJSONRPC(svrCtx, clientCtx, cmtRPCAddr, cmtEndpoint, &config, idxer, txPool)
 		return err
 	})
 	return
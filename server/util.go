@@ -55,8 +55,9 @@ func AddCommands(
 		version.NewVersionCommand(),
 		sdkserver.NewRollbackCmd(opts.AppCreator, opts.DefaultNodeHome),
 
-		// custom tx indexer command
+		// custom tx indexer commands
 		NewIndexTxCmd(),
+		NewIndexLogsCmd(),
 	)
 }
 
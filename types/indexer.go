@@ -6,6 +6,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 // EVMTxIndexer defines the interface of custom eth tx indexer.
@@ -18,4 +19,15 @@ type EVMTxIndexer interface {
 	GetByTxHash(common.Hash) (*TxResult, error)
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
+
+	// LogIndexRange returns the first and last blocks covered by the log index,
+	// -1 if the log index is empty.
+	LogIndexRange() (int64, int64, error)
+	// GetBlockBloom returns an error if the block logs are not indexed.
+	GetBlockBloom(int64) (ethtypes.Bloom, error)
+	// GetLogsByHeight returns an error if the block logs are not indexed.
+	GetLogsByHeight(int64) ([][]*ethtypes.Log, error)
+	// FilterLogBlocks returns the blocks within the range that may contain logs
+	// matching the addresses and topics.
+	FilterLogBlocks(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, error)
 }
@@ -0,0 +1,15 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EVMTxPool defines the interface of the app-side mempool read by the JSON-RPC
+// server to report the content of the transaction pool.
+type EVMTxPool interface {
+	// Txs returns all the pooled transactions, including the ones queued after
+	// a nonce gap.
+	Txs() []sdk.Tx
+}
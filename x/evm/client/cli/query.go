@@ -28,6 +28,7 @@ func GetQueryCmd() *cobra.Command {
 		GetAccountCmd(),
 		GetParamsCmd(),
 		GetConfigCmd(),
+		GetFeeSponsorshipCmd(),
 	)
 	return cmd
 }
@@ -201,3 +202,35 @@ func GetConfigCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetFeeSponsorshipCmd queries the fee sponsorship of a sponsor
+func GetFeeSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-sponsorship SPONSOR",
+		Short: "Get the fee sponsorship of a sponsor",
+		Long:  "Get the fee sponsorship registered by the given bech32 sponsor address.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryFeeSponsorshipRequest{
+				Sponsor: args[0],
+			}
+
+			res, err := queryClient.FeeSponsorship(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
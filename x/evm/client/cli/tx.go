@@ -6,10 +6,15 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -17,6 +22,15 @@ import (
 	"github.com/evmos/evmos/v20/x/evm/types"
 )
 
+const (
+	flagSpendLimit   = "spend-limit"
+	flagMaxFeePerGas = "max-fee-per-gas"
+	flagExpiration   = "expiration"
+	flagContracts    = "contracts"
+	flagSenders      = "senders"
+	flagSelectors    = "selectors"
+)
+
 // NewTxCmd returns a root CLI command handler for evm module transaction commands
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -29,6 +43,9 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewRawTxCmd(),
+		NewSetFeeSponsorshipCmd(),
+		NewRevokeFeeSponsorshipCmd(),
+		NewSetFeeSponsorCmd(),
 	)
 	return txCmd
 }
@@ -110,3 +127,138 @@ func NewRawTxCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewSetFeeSponsorshipCmd returns a CLI command handler for creating or
+// replacing the fee sponsorship of the sender
+func NewSetFeeSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee-sponsorship",
+		Short: "Sponsor the fees of the EVM transactions of the accounts choosing the sender as fee sponsor",
+		Long: `Sponsor the fees of the EVM transactions of the accounts choosing the sender as fee sponsor. The
+sponsorship is capped by a spend limit and a max fee per gas, and can be scoped to specific senders, contracts
+and function selectors and limited by an expiration.`,
+		Example: fmt.Sprintf(
+			"$ %s tx evm set-fee-sponsorship --spend-limit=1000000aevmos --max-fee-per-gas=1000000000 --contracts=0x... --selectors=0xa9059cbb --from=sponsor",
+			version.AppName,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var sponsorship types.FeeSponsorship
+
+			spendLimit, err := cmd.Flags().GetString(flagSpendLimit)
+			if err != nil {
+				return err
+			}
+			sponsorship.SpendLimit, err = sdk.ParseCoinsNormalized(spendLimit)
+			if err != nil {
+				return errors.Wrap(err, "invalid spend limit")
+			}
+
+			maxFeePerGas, err := cmd.Flags().GetString(flagMaxFeePerGas)
+			if err != nil {
+				return err
+			}
+			var ok bool
+			sponsorship.MaxFeePerGas, ok = sdkmath.NewIntFromString(maxFeePerGas)
+			if !ok {
+				return fmt.Errorf("invalid max fee per gas: %s", maxFeePerGas)
+			}
+
+			expiration, err := cmd.Flags().GetString(flagExpiration)
+			if err != nil {
+				return err
+			}
+			if expiration != "" {
+				exp, err := time.Parse(time.RFC3339, expiration)
+				if err != nil {
+					return errors.Wrap(err, "invalid expiration")
+				}
+				sponsorship.Expiration = &exp
+			}
+
+			if sponsorship.Contracts, err = cmd.Flags().GetStringSlice(flagContracts); err != nil {
+				return err
+			}
+			if sponsorship.Senders, err = cmd.Flags().GetStringSlice(flagSenders); err != nil {
+				return err
+			}
+			if sponsorship.Selectors, err = cmd.Flags().GetStringSlice(flagSelectors); err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetFeeSponsorship{
+				Sponsor:     cliCtx.GetFromAddress().String(),
+				Sponsorship: sponsorship,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagSpendLimit, "", "Maximum amount of fees paid by the sponsor")
+	cmd.Flags().String(flagMaxFeePerGas, "", "Highest fee per gas of the sponsored transactions, in the EVM denom with 18 decimals")
+	cmd.Flags().String(flagExpiration, "", "Expiration time of the sponsorship in RFC3339 format, e.g. 2006-01-02T15:04:05Z")
+	cmd.Flags().StringSlice(flagContracts, nil, "Hex addresses of the sponsored contracts, all if empty")
+	cmd.Flags().StringSlice(flagSenders, nil, "Hex addresses of the sponsored senders, all if empty")
+	cmd.Flags().StringSlice(flagSelectors, nil, "Hex encoded 4-byte sponsored function selectors, all if empty")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRevokeFeeSponsorshipCmd returns a CLI command handler for revoking the
+// fee sponsorship of the sender
+func NewRevokeFeeSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-fee-sponsorship",
+		Short: "Revoke the fee sponsorship of the sender",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRevokeFeeSponsorship{
+				Sponsor: cliCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetFeeSponsorCmd returns a CLI command handler for choosing the sponsor
+// paying the fees of the EVM transactions of the sender
+func NewSetFeeSponsorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee-sponsor [SPONSOR]",
+		Short: "Set the sponsor paying the fees of the EVM transactions of the sender. The sender pays its own fees if no sponsor is given.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetFeeSponsor{
+				Sender: cliCtx.GetFromAddress().String(),
+			}
+			if len(args) > 0 {
+				msg.Sponsor = args[0]
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
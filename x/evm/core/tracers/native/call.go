@@ -35,24 +35,34 @@ func init() {
 }
 
 type callFrame struct {
-	Type    string      `json:"type"`
-	From    string      `json:"from"`
-	To      string      `json:"to,omitempty"`
-	Value   string      `json:"value,omitempty"`
-	Gas     string      `json:"gas"`
-	GasUsed string      `json:"gasUsed"`
-	Input   string      `json:"input"`
-	Output  string      `json:"output,omitempty"`
-	Error   string      `json:"error,omitempty"`
-	Calls   []callFrame `json:"calls,omitempty"`
+	Type       string          `json:"type"`
+	From       string          `json:"from"`
+	To         string          `json:"to,omitempty"`
+	Value      string          `json:"value,omitempty"`
+	Gas        string          `json:"gas"`
+	GasUsed    string          `json:"gasUsed"`
+	Input      string          `json:"input"`
+	Output     string          `json:"output,omitempty"`
+	Error      string          `json:"error,omitempty"`
+	Precompile *precompileCall `json:"precompile,omitempty"`
+	Calls      []callFrame     `json:"calls,omitempty"`
+}
+
+// precompileCall contains the decoded call to a stateful precompiled contract
+// and the Cosmos events it emitted.
+type precompileCall struct {
+	Method string                 `json:"method"`
+	Args   map[string]interface{} `json:"args,omitempty"`
+	Events []vm.PrecompileEvent   `json:"events,omitempty"`
 }
 
 type callTracer struct {
-	env       *vm.EVM
-	callstack []callFrame
-	config    callTracerConfig
-	interrupt uint32 // Atomic flag to signal execution interruption
-	reason    error  // Textual reason for the interruption
+	env         *vm.EVM
+	callstack   []callFrame
+	config      callTracerConfig
+	precompiles bool   // If true, the precompile calls are recorded on their frames
+	interrupt   uint32 // Atomic flag to signal execution interruption
+	reason      error  // Textual reason for the interruption
 }
 
 type callTracerConfig struct {
@@ -70,7 +80,11 @@ func newCallTracer(ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, e
 	}
 	// First callframe contains tx context info
 	// and is populated on start and end.
-	return &callTracer{callstack: make([]callFrame, 1), config: config}, nil
+	return &callTracer{
+		callstack:   make([]callFrame, 1),
+		config:      config,
+		precompiles: ctx != nil && ctx.EnablePrecompileFrames,
+	}, nil
 }
 
 // CaptureStart implements the EVMLogger interface to initialize the tracing operation.
@@ -159,6 +173,25 @@ func (t *callTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
 	t.callstack[size-1].Calls = append(t.callstack[size-1].Calls, call)
 }
 
+// CapturePrecompile implements the PrecompileLogger interface to record the
+// decoded call and the Cosmos events of a precompile on its call frame.
+func (t *callTracer) CapturePrecompile(addr common.Address, method string, args map[string]interface{}, events []vm.PrecompileEvent) {
+	if !t.precompiles {
+		return
+	}
+	// The frame on top of the stack is the precompile one, unless the
+	// subcalls are not collected
+	frame := &t.callstack[len(t.callstack)-1]
+	if frame.To != addrToHex(addr) {
+		return
+	}
+	frame.Precompile = &precompileCall{
+		Method: method,
+		Args:   args,
+		Events: events,
+	}
+}
+
 func (*callTracer) CaptureTxStart(gasLimit uint64) {}
 
 func (*callTracer) CaptureTxEnd(restGas uint64) {}
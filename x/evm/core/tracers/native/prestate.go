@@ -17,6 +17,7 @@
 package native
 
 import (
+	"bytes"
 	"encoding/json"
 	"math/big"
 	"sync/atomic"
@@ -36,27 +37,51 @@ func init() {
 type (
 	prestate = map[common.Address]*account
 	account  struct {
-		Balance string                      `json:"balance"`
-		Nonce   uint64                      `json:"nonce"`
-		Code    string                      `json:"code"`
-		Storage map[common.Hash]common.Hash `json:"storage"`
+		Balance string                      `json:"balance,omitempty"`
+		Nonce   uint64                      `json:"nonce,omitempty"`
+		Code    string                      `json:"code,omitempty"`
+		Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
 	}
 )
 
+// exists returns true if the account was present in the state before the
+// transaction was executed.
+func (a *account) exists() bool {
+	return a.Nonce > 0 || len(a.Code) > len("0x") || len(a.Storage) > 0 || hexutil.MustDecodeBig(a.Balance).Sign() != 0
+}
+
 type prestateTracer struct {
 	env       *vm.EVM
 	prestate  prestate
+	post      prestate
 	create    bool
 	to        common.Address
 	gasLimit  uint64 // Amount of gas bought for the whole tx
+	config    prestateTracerConfig
 	interrupt uint32 // Atomic flag to signal execution interruption
 	reason    error  // Textual reason for the interruption
+	created   map[common.Address]bool
+	deleted   map[common.Address]bool
+}
+
+type prestateTracerConfig struct {
+	DiffMode bool `json:"diffMode"` // If true, this tracer will return state modifications
 }
 
-func newPrestateTracer(ctx *tracers.Context, _ json.RawMessage) (tracers.Tracer, error) {
-	// First callframe contains tx context info
-	// and is populated on start and end.
-	return &prestateTracer{prestate: prestate{}}, nil
+func newPrestateTracer(ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+	var config prestateTracerConfig
+	if cfg != nil {
+		if err := json.Unmarshal(cfg, &config); err != nil {
+			return nil, err
+		}
+	}
+	return &prestateTracer{
+		prestate: prestate{},
+		post:     prestate{},
+		config:   config,
+		created:  make(map[common.Address]bool),
+		deleted:  make(map[common.Address]bool),
+	}, nil
 }
 
 // CaptureStart implements the EVMLogger interface to initialize the tracing operation.
@@ -67,6 +92,9 @@ func (t *prestateTracer) CaptureStart(env *vm.EVM, from common.Address, to commo
 
 	t.lookupAccount(from)
 	t.lookupAccount(to)
+	if create && t.config.DiffMode {
+		t.created[to] = true
+	}
 
 	// The recipient balance includes the value transferred.
 	toBal := hexutil.MustDecodeBig(t.prestate[to].Balance)
@@ -85,6 +113,10 @@ func (t *prestateTracer) CaptureStart(env *vm.EVM, from common.Address, to commo
 
 // CaptureEnd is called after the call finishes to finalize the tracing.
 func (t *prestateTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) {
+	if t.config.DiffMode {
+		return
+	}
+
 	if t.create {
 		// Exclude created contract.
 		delete(t.prestate, t.to)
@@ -103,20 +135,27 @@ func (t *prestateTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64,
 	case stackLen >= 1 && (op == vm.EXTCODECOPY || op == vm.EXTCODEHASH || op == vm.EXTCODESIZE || op == vm.BALANCE || op == vm.SELFDESTRUCT):
 		addr := common.Address(stackData[stackLen-1].Bytes20())
 		t.lookupAccount(addr)
+		if op == vm.SELFDESTRUCT {
+			t.deleted[scope.Contract.Address()] = true
+		}
 	case stackLen >= 5 && (op == vm.DELEGATECALL || op == vm.CALL || op == vm.STATICCALL || op == vm.CALLCODE):
 		addr := common.Address(stackData[stackLen-2].Bytes20())
 		t.lookupAccount(addr)
 	case op == vm.CREATE:
 		addr := scope.Contract.Address()
 		nonce := t.env.StateDB.GetNonce(addr)
-		t.lookupAccount(crypto.CreateAddress(addr, nonce))
+		created := crypto.CreateAddress(addr, nonce)
+		t.lookupAccount(created)
+		t.created[created] = true
 	case stackLen >= 4 && op == vm.CREATE2:
 		offset := stackData[stackLen-2]
 		size := stackData[stackLen-3]
 		init := scope.Memory.GetCopy(int64(offset.Uint64()), int64(size.Uint64()))
 		inithash := crypto.Keccak256(init)
 		salt := stackData[stackLen-4]
-		t.lookupAccount(crypto.CreateAddress2(scope.Contract.Address(), salt.Bytes32(), inithash))
+		created := crypto.CreateAddress2(scope.Contract.Address(), salt.Bytes32(), inithash)
+		t.lookupAccount(created)
+		t.created[created] = true
 	}
 }
 
@@ -137,12 +176,88 @@ func (t *prestateTracer) CaptureTxStart(gasLimit uint64) {
 	t.gasLimit = gasLimit
 }
 
-func (t *prestateTracer) CaptureTxEnd(restGas uint64) {}
+// CaptureTxEnd computes the post state of the touched accounts when the
+// tracer runs in diff mode, discarding the accounts and storage slots that
+// weren't modified by the transaction.
+func (t *prestateTracer) CaptureTxEnd(restGas uint64) {
+	if !t.config.DiffMode {
+		return
+	}
+
+	for addr, state := range t.prestate {
+		// the deleted account's state is pruned from the post state
+		if t.deleted[addr] {
+			continue
+		}
+		modified := false
+		postAccount := &account{Storage: make(map[common.Hash]common.Hash)}
+		newBalance := bigToHex(t.env.StateDB.GetBalance(addr))
+		newNonce := t.env.StateDB.GetNonce(addr)
+		newCode := bytesToHex(t.env.StateDB.GetCode(addr))
+
+		if newBalance != state.Balance {
+			modified = true
+			postAccount.Balance = newBalance
+		}
+		if newNonce != state.Nonce {
+			modified = true
+			postAccount.Nonce = newNonce
+		}
+		if !bytes.Equal(common.FromHex(newCode), common.FromHex(state.Code)) {
+			modified = true
+			postAccount.Code = newCode
+		}
+
+		for key, val := range state.Storage {
+			// don't include the empty slot
+			if val == (common.Hash{}) {
+				delete(state.Storage, key)
+			}
+
+			newVal := t.env.StateDB.GetState(addr, key)
+			if val == newVal {
+				// the storage slot wasn't modified
+				delete(state.Storage, key)
+			} else {
+				modified = true
+				if newVal != (common.Hash{}) {
+					postAccount.Storage[key] = newVal
+				}
+			}
+		}
+
+		if modified {
+			t.post[addr] = postAccount
+		} else {
+			// the account wasn't modified, so it's removed from the pre state
+			delete(t.prestate, addr)
+		}
+	}
+
+	// the prestate of the created contracts is empty, unless the account
+	// already existed before the transaction
+	for addr := range t.created {
+		if state := t.prestate[addr]; state != nil && !state.exists() {
+			delete(t.prestate, addr)
+		}
+	}
+}
 
 // GetResult returns the json-encoded nested list of call traces, and any
 // error arising from the encoding or forceful termination (via `Stop`).
 func (t *prestateTracer) GetResult() (json.RawMessage, error) {
-	res, err := json.Marshal(t.prestate)
+	var (
+		res []byte
+		err error
+	)
+	if t.config.DiffMode {
+		res, err = json.Marshal(struct {
+			Post prestate `json:"post"`
+			Pre  prestate `json:"pre"`
+		}{t.post, t.prestate})
+	} else {
+		res, err = json.Marshal(t.prestate)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -14,6 +14,8 @@ type Context struct {
 	BlockHash common.Hash // Hash of the block the tx is contained within (zero if dangling tx or call)
 	TxIndex   int         // Index of the transaction within a block (zero if dangling tx or call)
 	TxHash    common.Hash // Hash of the transaction being traced (zero if dangling call)
+
+	EnablePrecompileFrames bool // Whether to record the precompile calls on the call frames
 }
 
 // Tracer interface extends vm.EVMLogger and additionally
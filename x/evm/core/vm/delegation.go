@@ -0,0 +1,47 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package vm
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DelegationPrefix is the prefix of the code of the accounts that delegate their
+// code to a contract (EIP-7702).
+var DelegationPrefix = []byte{0xef, 0x01, 0x00}
+
+// ParseDelegation returns the address of the contract the code delegates to, if
+// the code is a delegation designator.
+func ParseDelegation(code []byte) (common.Address, bool) {
+	if len(code) != len(DelegationPrefix)+common.AddressLength || !bytes.HasPrefix(code, DelegationPrefix) {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(code[len(DelegationPrefix):]), true
+}
+
+// AddressToDelegation returns the delegation designator of the given contract.
+func AddressToDelegation(addr common.Address) []byte {
+	return append(common.CopyBytes(DelegationPrefix), addr.Bytes()...)
+}
+
+// resolveCode returns the code executed when calling the address. For the
+// accounts that delegate their code, it is the code of the delegation target.
+// Only one level of delegation is followed.
+func (evm *EVM) resolveCode(addr common.Address) []byte {
+	code := evm.StateDB.GetCode(addr)
+	if target, ok := ParseDelegation(code); ok {
+		return evm.StateDB.GetCode(target)
+	}
+	return code
+}
+
+// resolveCodeHash returns the hash of the code returned by resolveCode.
+func (evm *EVM) resolveCodeHash(addr common.Address) common.Hash {
+	if target, ok := ParseDelegation(evm.StateDB.GetCode(addr)); ok {
+		return evm.StateDB.GetCodeHash(target)
+	}
+	return evm.StateDB.GetCodeHash(addr)
+}
@@ -42,3 +42,25 @@ type EVMLogger interface {
 	CaptureState(pc uint64, op OpCode, gas, cost uint64, scope *ScopeContext, rData []byte, depth int, err error)
 	CaptureFault(pc uint64, op OpCode, gas, cost uint64, scope *ScopeContext, depth int, err error)
 }
+
+// PrecompileLogger is an optional interface of the EVMLogger to collect the
+// calls to the stateful precompiled contracts. Their Cosmos SDK side effects
+// are otherwise opaque to the EVM.
+type PrecompileLogger interface {
+	// CapturePrecompile is called after a successful precompiled contract
+	// execution, within its call frame, with the precompile address, the ABI
+	// method name, the decoded arguments and the Cosmos events emitted by the call.
+	CapturePrecompile(addr common.Address, method string, args map[string]interface{}, events []PrecompileEvent)
+}
+
+// PrecompileEvent is a Cosmos SDK event emitted by a precompiled contract.
+type PrecompileEvent struct {
+	Type       string                     `json:"type"`
+	Attributes []PrecompileEventAttribute `json:"attributes,omitempty"`
+}
+
+// PrecompileEventAttribute is a key-value attribute of a PrecompileEvent.
+type PrecompileEventAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
@@ -164,8 +164,9 @@ func makeCallVariantGasCallEIP2929(oldCalculator gasFunc) gasFunc {
 		warmAccess := evm.StateDB.AddressInAccessList(addr)
 		// The WarmStorageReadCostEIP2929 (100) is already deducted in the form of a constant cost, so
 		// the cost to charge for cold access, if any, is Cold - Warm
-		coldCost := params.ColdAccountAccessCostEIP2929 - params.WarmStorageReadCostEIP2929
+		var coldCost uint64
 		if !warmAccess {
+			coldCost = params.ColdAccountAccessCostEIP2929 - params.WarmStorageReadCostEIP2929
 			evm.StateDB.AddAddressToAccessList(addr)
 			// Charge the remaining difference here already, to correctly calculate available
 			// gas for call
@@ -173,21 +174,34 @@ func makeCallVariantGasCallEIP2929(oldCalculator gasFunc) gasFunc {
 				return 0, ErrOutOfGas
 			}
 		}
+		// Calling an account that delegates its code (EIP-7702) also accesses the
+		// delegation target, which is charged like the access of the address.
+		var delegationCost uint64
+		if target, ok := ParseDelegation(evm.StateDB.GetCode(addr)); ok {
+			delegationCost = params.WarmStorageReadCostEIP2929
+			if !evm.StateDB.AddressInAccessList(target) {
+				evm.StateDB.AddAddressToAccessList(target)
+				delegationCost = params.ColdAccountAccessCostEIP2929
+			}
+			if !contract.UseGas(delegationCost) {
+				return 0, ErrOutOfGas
+			}
+		}
 		// Now call the old calculator, which takes into account
 		// - create new account
 		// - transfer value
 		// - memory expansion
 		// - 63/64ths rule
 		gas, err := oldCalculator(evm, contract, stack, mem, memorySize)
-		if warmAccess || err != nil {
+		if (coldCost == 0 && delegationCost == 0) || err != nil {
 			return gas, err
 		}
 		// In case of a cold access, we temporarily add the cold charge back, and also
 		// add it to the returned gas. By adding it to the return, it will be charged
 		// outside of this function, as part of the dynamic gas, and that will make it
 		// also become correctly reported to tracers.
-		contract.Gas += coldCost
-		return gas + coldCost, nil
+		contract.Gas += coldCost + delegationCost
+		return gas + coldCost + delegationCost, nil
 	}
 }
 
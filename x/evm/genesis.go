@@ -57,6 +57,14 @@ func InitGenesis(
 		}
 	}
 
+	for _, sponsorship := range data.FeeSponsorships {
+		k.SetAccountFeeSponsorship(ctx, sdk.MustAccAddressFromBech32(sponsorship.Sponsor), sponsorship.Sponsorship)
+	}
+
+	for _, sponsor := range data.FeeSponsors {
+		k.SetAccountFeeSponsor(ctx, sdk.MustAccAddressFromBech32(sponsor.Address), sdk.MustAccAddressFromBech32(sponsor.Sponsor))
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -77,7 +85,9 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 	})
 
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:        ethGenAccounts,
+		Params:          k.GetParams(ctx),
+		FeeSponsorships: k.GetFeeSponsorships(ctx),
+		FeeSponsors:     k.GetAccountFeeSponsors(ctx),
 	}
 }
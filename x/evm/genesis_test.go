@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -245,3 +246,40 @@ func TestExportGenesis(t *testing.T) {
 	require.Contains(t, genAddresses, contractAddr2.Hex(), "expected contract 2 address in exported genesis")
 	require.Contains(t, genAddresses, erc20.WEVMOSContractMainnet, "expected mainnet aevmos contract address in exported genesis")
 }
+
+func TestFeeSponsorshipsGenesis(t *testing.T) {
+	ts := SetupTest()
+	ctx := ts.network.GetContext()
+	k := ts.network.App.EvmKeeper
+	sponsor := ts.keyring.GetAccAddr(0)
+	sender := sdk.AccAddress(common.HexToAddress("0xa11ce").Bytes())
+	sponsorship := types.FeeSponsorship{
+		SpendLimit:   sdk.NewCoins(sdk.NewInt64Coin(types.GetEVMCoinDenom(), 100)),
+		MaxFeePerGas: sdkmath.NewInt(1e9),
+	}
+
+	k.SetAccountFeeSponsorship(ctx, sponsor, sponsorship)
+	k.SetAccountFeeSponsor(ctx, sender, sponsor)
+
+	genState := evm.ExportGenesis(ctx, k)
+	require.NoError(t, genState.Validate())
+	require.Equal(t, []types.GenesisFeeSponsorship{{Sponsor: sponsor.String(), Sponsorship: sponsorship}}, genState.FeeSponsorships)
+	require.Equal(t, []types.FeeSponsor{{Address: sender.String(), Sponsor: sponsor.String()}}, genState.FeeSponsors)
+
+	// the fee sponsorships and sponsors are restored on a fresh chain
+	ts = SetupTest()
+	ctx = ts.network.GetContext()
+	k = ts.network.App.EvmKeeper
+	_ = evm.InitGenesis(ctx, k, ts.network.App.AccountKeeper, types.GenesisState{
+		Params:          genState.Params,
+		FeeSponsorships: genState.FeeSponsorships,
+		FeeSponsors:     genState.FeeSponsors,
+	})
+
+	stored, found := k.GetFeeSponsorship(ctx, sponsor)
+	require.True(t, found)
+	require.Equal(t, sponsorship, stored)
+	chosen, found := k.GetAccountFeeSponsor(ctx, sender)
+	require.True(t, found)
+	require.Equal(t, sponsor, chosen)
+}
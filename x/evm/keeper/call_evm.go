@@ -75,6 +75,38 @@ func (k Keeper) CallEVMWithData(
 		gasCap = gasRes.Gas
 	}
 
+	return k.applyCall(ctx, from, contract, nonce, data, gasCap, commit)
+}
+
+// CallEVMWithDataAndGasLimit performs a smart contract method call using
+// contract data, with the given gas limit instead of the estimated one. The
+// state changes are committed if the call succeeds.
+func (k Keeper) CallEVMWithDataAndGasLimit(
+	ctx sdk.Context,
+	from common.Address,
+	contract *common.Address,
+	data []byte,
+	gasLimit uint64,
+) (*types.MsgEthereumTxResponse, error) {
+	nonce, err := k.accountKeeper.GetSequence(ctx, from.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return k.applyCall(ctx, from, contract, nonce, data, gasLimit, true)
+}
+
+// applyCall applies a zero value and zero gas price message calling the given
+// contract, and returns an error if the execution failed.
+func (k Keeper) applyCall(
+	ctx sdk.Context,
+	from common.Address,
+	contract *common.Address,
+	nonce uint64,
+	data []byte,
+	gasCap uint64,
+	commit bool,
+) (*types.MsgEthereumTxResponse, error) {
 	msg := ethtypes.NewMessage(
 		from,
 		contract,
@@ -146,3 +146,40 @@ func (suite *KeeperTestSuite) TestCallEVMWithData() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestCallEVMWithDataAndGasLimit() {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	wevmosContract := common.HexToAddress(types.WEVMOSContractMainnet)
+	testCases := []struct {
+		name     string
+		gasLimit uint64
+		expPass  bool
+	}{
+		{
+			"fail with gas limit below the intrinsic gas",
+			1000,
+			false,
+		},
+		{
+			"pass",
+			100_000,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			data, err := erc20.Pack("balanceOf", utiltx.GenerateAddress())
+			suite.Require().NoError(err)
+
+			res, err := suite.network.App.EvmKeeper.CallEVMWithDataAndGasLimit(suite.network.GetContext(), types.ModuleAddress, &wevmosContract, data, tc.gasLimit)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().LessOrEqual(res.GasUsed, tc.gasLimit)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// GetFeeSponsorship returns the fee sponsorship registered by the given
+// sponsor, if any.
+func (k Keeper) GetFeeSponsorship(ctx sdk.Context, sponsor sdk.AccAddress) (types.FeeSponsorship, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeSponsorship)
+	bz := store.Get(sponsor.Bytes())
+	if bz == nil {
+		return types.FeeSponsorship{}, false
+	}
+
+	var sponsorship types.FeeSponsorship
+	k.cdc.MustUnmarshal(bz, &sponsorship)
+	return sponsorship, true
+}
+
+// SetAccountFeeSponsorship creates or replaces the fee sponsorship of the given
+// sponsor.
+func (k Keeper) SetAccountFeeSponsorship(ctx sdk.Context, sponsor sdk.AccAddress, sponsorship types.FeeSponsorship) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeSponsorship)
+	store.Set(sponsor.Bytes(), k.cdc.MustMarshal(&sponsorship))
+}
+
+// deleteFeeSponsorship removes the fee sponsorship of the given sponsor, as
+// well as the choice of the sponsor by the accounts, which pay their own fees
+// afterwards.
+func (k Keeper) deleteFeeSponsorship(ctx sdk.Context, sponsor sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeSponsorship)
+	store.Delete(sponsor.Bytes())
+
+	// the senders are collected beforehand since the store can't be modified
+	// while iterating over it
+	sendersStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeeSponsorSendersPrefix(sponsor))
+	iterator := sendersStore.Iterator(nil, nil)
+	var senders []sdk.AccAddress
+	for ; iterator.Valid(); iterator.Next() {
+		senders = append(senders, sdk.AccAddress(iterator.Key()))
+	}
+	iterator.Close()
+
+	for _, sender := range senders {
+		k.SetAccountFeeSponsor(ctx, sender, nil)
+	}
+}
+
+// GetFeeSponsorships returns the fee sponsorships of all the sponsors.
+func (k Keeper) GetFeeSponsorships(ctx sdk.Context) []types.GenesisFeeSponsorship {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeSponsorship)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var sponsorships []types.GenesisFeeSponsorship
+	for ; iterator.Valid(); iterator.Next() {
+		var sponsorship types.FeeSponsorship
+		k.cdc.MustUnmarshal(iterator.Value(), &sponsorship)
+
+		sponsorships = append(sponsorships, types.GenesisFeeSponsorship{
+			Sponsor:     sdk.AccAddress(iterator.Key()).String(),
+			Sponsorship: sponsorship,
+		})
+	}
+	return sponsorships
+}
+
+// SetAccountFeeSponsor sets the sponsor chosen by the given account to pay the
+// fees of its EVM transactions. An empty sponsor removes the previous one.
+func (k Keeper) SetAccountFeeSponsor(ctx sdk.Context, addr, sponsor sdk.AccAddress) {
+	kvStore := ctx.KVStore(k.storeKey)
+	if prev, found := k.GetAccountFeeSponsor(ctx, addr); found {
+		kvStore.Delete(types.FeeSponsorSenderKey(prev, addr))
+	}
+
+	store := prefix.NewStore(kvStore, types.KeyPrefixFeeSponsor)
+	if sponsor.Empty() {
+		store.Delete(addr.Bytes())
+		return
+	}
+	store.Set(addr.Bytes(), sponsor.Bytes())
+	kvStore.Set(types.FeeSponsorSenderKey(sponsor, addr), []byte{1})
+}
+
+// GetAccountFeeSponsor returns the sponsor chosen by the given account to pay
+// the fees of its EVM transactions, if any.
+func (k Keeper) GetAccountFeeSponsor(ctx sdk.Context, addr sdk.AccAddress) (sdk.AccAddress, bool) {
+	if addr.Empty() {
+		return nil, false
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeSponsor)
+	bz := store.Get(addr.Bytes())
+	if len(bz) == 0 {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+// GetAccountFeeSponsors returns the sponsors chosen by the accounts to pay the
+// fees of their EVM transactions.
+func (k Keeper) GetAccountFeeSponsors(ctx sdk.Context) []types.FeeSponsor {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeSponsor)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var sponsors []types.FeeSponsor
+	for ; iterator.Valid(); iterator.Next() {
+		sponsors = append(sponsors, types.FeeSponsor{
+			Address: sdk.AccAddress(iterator.Key()).String(),
+			Sponsor: sdk.AccAddress(iterator.Value()).String(),
+		})
+	}
+	return sponsors
+}
+
+// UseFeeSponsorship checks that the transaction is in the scope of the fee
+// sponsorship of the given sponsor and deducts the fees from its spend limit.
+// The sponsorship is removed once its spend limit is exhausted.
+//
+// NOTE: the spend limit is deducted by the fees charged upfront, and it's not
+// restored by the refund of the leftover gas.
+func (k Keeper) UseFeeSponsorship(
+	ctx sdk.Context,
+	sponsor sdk.AccAddress,
+	from common.Address,
+	txData types.TxData,
+	fees sdk.Coins,
+) error {
+	sponsorship, found := k.GetFeeSponsorship(ctx, sponsor)
+	if !found {
+		return errorsmod.Wrapf(types.ErrFeeSponsorshipNotFound, "sponsor %s", sponsor)
+	}
+
+	if err := sponsorship.Allows(ctx.BlockTime(), from, txData.GetTo(), txData.GetData(), txData.GetGasFeeCap()); err != nil {
+		return err
+	}
+
+	left, hasNeg := sponsorship.SpendLimit.SafeSub(fees...)
+	if hasNeg {
+		return errorsmod.Wrapf(
+			types.ErrFeeSponsorshipNotAllowed,
+			"fees %s exceed the spend limit %s", fees, sponsorship.SpendLimit,
+		)
+	}
+
+	if left.IsZero() {
+		k.deleteFeeSponsorship(ctx, sponsor)
+		return nil
+	}
+
+	sponsorship.SpendLimit = left
+	k.SetAccountFeeSponsorship(ctx, sponsor, sponsorship)
+	return nil
+}
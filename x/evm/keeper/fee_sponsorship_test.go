@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestFeeSponsorshipMsgs() {
+	ctx := suite.network.GetContext()
+	keeper := suite.network.App.EvmKeeper
+	sponsor := suite.keyring.GetAccAddr(0)
+	sponsorship := types.FeeSponsorship{
+		SpendLimit:   sdk.NewCoins(sdk.NewInt64Coin(types.GetEVMCoinDenom(), 100)),
+		MaxFeePerGas: sdkmath.NewInt(1e9),
+		Senders:      []string{suite.keyring.GetAddr(1).Hex()},
+	}
+
+	_, err := keeper.FeeSponsorship(ctx, &types.QueryFeeSponsorshipRequest{Sponsor: sponsor.String()})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	_, err = keeper.SetFeeSponsorship(ctx, &types.MsgSetFeeSponsorship{
+		Sponsor:     sponsor.String(),
+		Sponsorship: sponsorship,
+	})
+	suite.Require().NoError(err)
+
+	res, err := keeper.FeeSponsorship(ctx, &types.QueryFeeSponsorshipRequest{Sponsor: sponsor.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sponsorship, res.Sponsorship)
+
+	_, err = keeper.SetFeeSponsor(ctx, &types.MsgSetFeeSponsor{
+		Sender:  suite.keyring.GetAccAddr(1).String(),
+		Sponsor: sponsor.String(),
+	})
+	suite.Require().NoError(err)
+
+	// the revocation clears the choice of the sponsor by the senders
+	_, err = keeper.RevokeFeeSponsorship(ctx, &types.MsgRevokeFeeSponsorship{Sponsor: sponsor.String()})
+	suite.Require().NoError(err)
+	_, found := keeper.GetFeeSponsorship(ctx, sponsor)
+	suite.Require().False(found)
+	_, found = keeper.GetAccountFeeSponsor(ctx, suite.keyring.GetAccAddr(1))
+	suite.Require().False(found)
+	suite.Require().Empty(keeper.GetAccountFeeSponsors(ctx))
+
+	_, err = keeper.RevokeFeeSponsorship(ctx, &types.MsgRevokeFeeSponsorship{Sponsor: sponsor.String()})
+	suite.Require().ErrorIs(err, types.ErrFeeSponsorshipNotFound)
+}
+
+func (suite *KeeperTestSuite) TestUseFeeSponsorship() {
+	ctx := suite.network.GetContext()
+	keeper := suite.network.App.EvmKeeper
+	denom := types.GetEVMCoinDenom()
+	sponsor := suite.keyring.GetAccAddr(0)
+	from := suite.keyring.GetAddr(1)
+	to := common.HexToAddress("0xc0de")
+	gasPrice := sdkmath.NewInt(1e9)
+	txData := &types.LegacyTx{To: to.Hex(), GasPrice: &gasPrice}
+	fees := sdk.NewCoins(sdk.NewInt64Coin(denom, 60))
+
+	err := keeper.UseFeeSponsorship(ctx, sponsor, from, txData, fees)
+	suite.Require().ErrorIs(err, types.ErrFeeSponsorshipNotFound)
+
+	_, err = keeper.SetFeeSponsorship(ctx, &types.MsgSetFeeSponsorship{
+		Sponsor: sponsor.String(),
+		Sponsorship: types.FeeSponsorship{
+			SpendLimit:   sdk.NewCoins(sdk.NewInt64Coin(denom, 120)),
+			MaxFeePerGas: gasPrice,
+			Contracts:    []string{to.Hex()},
+		},
+	})
+	suite.Require().NoError(err)
+
+	// contract creations are out of the scope of the sponsorship
+	err = keeper.UseFeeSponsorship(ctx, sponsor, from, &types.LegacyTx{GasPrice: &gasPrice}, fees)
+	suite.Require().ErrorIs(err, types.ErrFeeSponsorshipNotAllowed)
+
+	// the gas price is capped by the sponsorship
+	highGasPrice := gasPrice.AddRaw(1)
+	err = keeper.UseFeeSponsorship(ctx, sponsor, from, &types.LegacyTx{To: to.Hex(), GasPrice: &highGasPrice}, fees)
+	suite.Require().ErrorIs(err, types.ErrFeeSponsorshipNotAllowed)
+
+	suite.Require().NoError(keeper.UseFeeSponsorship(ctx, sponsor, from, txData, fees))
+	sponsorship, found := keeper.GetFeeSponsorship(ctx, sponsor)
+	suite.Require().True(found)
+	suite.Require().Equal(fees, sponsorship.SpendLimit)
+
+	err = keeper.UseFeeSponsorship(ctx, sponsor, from, txData, fees.Add(sdk.NewInt64Coin(denom, 1)))
+	suite.Require().ErrorIs(err, types.ErrFeeSponsorshipNotAllowed)
+
+	_, err = keeper.SetFeeSponsor(ctx, &types.MsgSetFeeSponsor{Sender: sdk.AccAddress(from.Bytes()).String(), Sponsor: sponsor.String()})
+	suite.Require().NoError(err)
+
+	// the sponsorship is removed once the spend limit is exhausted, as well as
+	// the choice of the sponsor by the senders
+	suite.Require().NoError(keeper.UseFeeSponsorship(ctx, sponsor, from, txData, fees))
+	_, found = keeper.GetFeeSponsorship(ctx, sponsor)
+	suite.Require().False(found)
+	_, found = keeper.GetAccountFeeSponsor(ctx, from.Bytes())
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestSetFeeSponsor() {
+	ctx := suite.network.GetContext()
+	keeper := suite.network.App.EvmKeeper
+	sender := suite.keyring.GetAccAddr(0)
+	sponsor := suite.keyring.GetAccAddr(1)
+
+	_, found := keeper.GetAccountFeeSponsor(ctx, sender)
+	suite.Require().False(found)
+
+	// the sponsor must have a fee sponsorship
+	_, err := keeper.SetFeeSponsor(ctx, &types.MsgSetFeeSponsor{Sender: sender.String(), Sponsor: sponsor.String()})
+	suite.Require().ErrorIs(err, types.ErrFeeSponsorshipNotFound)
+
+	// the sender must be in the scope of the fee sponsorship
+	sponsorship := types.FeeSponsorship{
+		SpendLimit:   sdk.NewCoins(sdk.NewInt64Coin(types.GetEVMCoinDenom(), 100)),
+		MaxFeePerGas: sdkmath.NewInt(1e9),
+		Senders:      []string{common.HexToAddress("0xa11ce").Hex()},
+	}
+	keeper.SetAccountFeeSponsorship(ctx, sponsor, sponsorship)
+	_, err = keeper.SetFeeSponsor(ctx, &types.MsgSetFeeSponsor{Sender: sender.String(), Sponsor: sponsor.String()})
+	suite.Require().ErrorIs(err, types.ErrFeeSponsorshipNotAllowed)
+
+	sponsorship.Senders = append(sponsorship.Senders, suite.keyring.GetAddr(0).Hex())
+	keeper.SetAccountFeeSponsorship(ctx, sponsor, sponsorship)
+	_, err = keeper.SetFeeSponsor(ctx, &types.MsgSetFeeSponsor{Sender: sender.String(), Sponsor: sponsor.String()})
+	suite.Require().NoError(err)
+	chosen, found := keeper.GetAccountFeeSponsor(ctx, sender)
+	suite.Require().True(found)
+	suite.Require().Equal(sponsor, chosen)
+
+	// an empty sponsor goes back to paying the own fees
+	_, err = keeper.SetFeeSponsor(ctx, &types.MsgSetFeeSponsor{Sender: sender.String()})
+	suite.Require().NoError(err)
+	_, found = keeper.GetAccountFeeSponsor(ctx, sender)
+	suite.Require().False(found)
+}
@@ -91,6 +91,13 @@ func VerifyFee(
 		)
 	}
 
+	if setCodeTx, ok := txData.(*types.SetCodeTx); ok {
+		intrinsicGas, err = types.AddAuthorizationsGas(intrinsicGas, setCodeTx.GetAuthorizations())
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to retrieve intrinsic gas of authorizations")
+		}
+	}
+
 	// intrinsic gas verification during CheckTx
 	if isCheckTx && gasLimit < intrinsicGas {
 		return nil, errorsmod.Wrapf(
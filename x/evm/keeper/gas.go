@@ -15,21 +15,32 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/evmos/evmos/v20/x/evm/types"
+	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
 )
 
-// GetEthIntrinsicGas returns the intrinsic gas cost for the transaction
+// GetEthIntrinsicGas returns the intrinsic gas cost for the transaction, including the
+// cost of the authorizations of set code transactions.
 func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *params.ChainConfig, isContractCreation bool) (uint64, error) {
 	height := big.NewInt(ctx.BlockHeight())
 	homestead := cfg.IsHomestead(height)
 	istanbul := cfg.IsIstanbul(height)
 
-	return core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
+	gas, err := core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
+	if err != nil {
+		return 0, err
+	}
+
+	if setCodeMsg, ok := msg.(types.SetCodeMessage); ok {
+		return types.AddAuthorizationsGas(gas, setCodeMsg.Authorizations)
+	}
+	return gas, nil
 }
 
 // RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
 // consumed in the transaction. Additionally, the function sets the total gas consumed to the value
 // returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
-// AnteHandler.
+// AnteHandler. If the fees were paid with an alternate fee token, the refund is converted to that
+// token at the rate used by the AnteHandler instead of the given denom.
 func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
 	// Return EVM tokens for remaining gas, exchanged at the original rate.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice())
@@ -41,9 +52,22 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 	case 1:
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
+		if feeToken, ok := types.GetFeeTokenRate(ctx); ok {
+			amount := feemarkettypes.ConvertRefundToFeeToken(sdkmath.NewIntFromBigInt(remaining), feeToken.Rate)
+			if !amount.IsPositive() {
+				return nil
+			}
+			refundedCoins = sdk.Coins{sdk.NewCoin(feeToken.Denom, amount)}
+		}
+
+		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees.
+		// The fees paid by a sponsor are refunded to the sponsor instead.
+		recipient := sdk.AccAddress(msg.From().Bytes())
+		if sponsor, ok := types.GetFeeSponsor(ctx); ok {
+			recipient = sponsor
+		}
 
-		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
-		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From().Bytes(), refundedCoins)
+		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, recipient, refundedCoins)
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
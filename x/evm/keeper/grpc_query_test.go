@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"strings"
 
 	"github.com/evmos/evmos/v20/x/evm/keeper/testdata"
 
@@ -13,12 +14,15 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 	ethlogger "github.com/evmos/evmos/v20/x/evm/core/logger"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/evmos/evmos/v20/precompiles/staking"
 	"github.com/evmos/evmos/v20/server/config"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/factory"
 	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
@@ -987,6 +991,7 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 		getPredecessors func() []*types.MsgEthereumTx
 		expPass         bool
 		expectedTrace   string
+		verifyTrace     func(data []byte)
 	}{
 		{
 			msg: "default trace",
@@ -1032,6 +1037,34 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 			expPass:       true,
 			expectedTrace: "[]",
 		},
+		{
+			msg: "prestate tracer in diff mode",
+			getRequest: func() types.QueryTraceTxRequest {
+				defaultRequest := getDefaultTraceTxRequest(suite.network)
+				defaultRequest.TraceConfig = &types.TraceConfig{
+					Tracer:           "prestateTracer",
+					TracerJsonConfig: `{"diffMode":true}`,
+				}
+				return defaultRequest
+			},
+			getPredecessors: func() []*types.MsgEthereumTx {
+				return nil
+			},
+			expPass: true,
+			verifyTrace: func(data []byte) {
+				var result struct {
+					Pre  map[common.Address]json.RawMessage `json:"pre"`
+					Post map[common.Address]json.RawMessage `json:"post"`
+				}
+				suite.Require().NoError(json.Unmarshal(data, &result))
+				// the sender and the token contract are modified by the transfer
+				suite.Require().Len(result.Pre, 2)
+				suite.Require().Len(result.Post, 2)
+				for addr := range result.Post {
+					suite.Require().Contains(result.Pre, addr)
+				}
+			},
+		},
 		{
 			msg: "default tracer with predecessors",
 			getRequest: func() types.QueryTraceTxRequest {
@@ -1206,6 +1239,11 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 			if tc.expPass {
 				suite.Require().NoError(err)
 
+				if tc.verifyTrace != nil {
+					tc.verifyTrace(res.Data)
+					return
+				}
+
 				// if data is to big, slice the result
 				if len(res.Data) > 150 {
 					suite.Require().Equal(tc.expectedTrace, string(res.Data[:150]))
@@ -1238,6 +1276,7 @@ func (suite *KeeperTestSuite) TestTraceBlock() {
 		getAdditionalTxs func() []*types.MsgEthereumTx
 		expPass          bool
 		traceResponse    string
+		verifyTrace      func(data []byte)
 	}{
 		{
 			msg: "default trace",
@@ -1282,6 +1321,33 @@ func (suite *KeeperTestSuite) TestTraceBlock() {
 			expPass:       true,
 			traceResponse: "[{\"result\":[]}]",
 		},
+		{
+			msg: "prestate tracer in diff mode",
+			getRequest: func() types.QueryTraceBlockRequest {
+				defaultReq := getDefaultTraceBlockRequest(suite.network)
+				defaultReq.TraceConfig = &types.TraceConfig{
+					Tracer:           "prestateTracer",
+					TracerJsonConfig: `{"diffMode":true}`,
+				}
+				return defaultReq
+			},
+			getAdditionalTxs: func() []*types.MsgEthereumTx {
+				return nil
+			},
+			expPass: true,
+			verifyTrace: func(data []byte) {
+				var results []struct {
+					Result struct {
+						Pre  map[common.Address]json.RawMessage `json:"pre"`
+						Post map[common.Address]json.RawMessage `json:"post"`
+					} `json:"result"`
+				}
+				suite.Require().NoError(json.Unmarshal(data, &results))
+				suite.Require().Len(results, 1)
+				suite.Require().NotEmpty(results[0].Result.Pre)
+				suite.Require().NotEmpty(results[0].Result.Post)
+			},
+		},
 		{
 			msg: "tracer with multiple transactions",
 			getRequest: func() types.QueryTraceBlockRequest {
@@ -1381,6 +1447,10 @@ func (suite *KeeperTestSuite) TestTraceBlock() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
+				if tc.verifyTrace != nil {
+					tc.verifyTrace(res.Data)
+					return
+				}
 				// if data is too big, slice the result
 				if len(res.Data) > 150 {
 					suite.Require().Equal(tc.traceResponse, string(res.Data[:150]))
@@ -1394,6 +1464,228 @@ func (suite *KeeperTestSuite) TestTraceBlock() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestTraceCall() {
+	suite.SetupTest()
+
+	sender := suite.keyring.GetAddr(0)
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	// PUSH1 0x00 SLOAD PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+	sloadCode := hexutil.Bytes{0x60, 0x00, 0x54, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	slot := common.Hash{}
+	value := common.BigToHash(big.NewInt(42))
+
+	overrides, err := json.Marshal(types.StateOverride{
+		contract: {Code: &sloadCode, StateDiff: &map[common.Hash]common.Hash{slot: value}},
+	})
+	suite.Require().NoError(err)
+
+	defaultArgs, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &contract})
+	suite.Require().NoError(err)
+
+	stakingABI, err := staking.LoadABI()
+	suite.Require().NoError(err)
+	delegateInput, err := stakingABI.Pack(
+		staking.DelegateMethod,
+		sender,
+		suite.network.GetValidators()[0].GetOperator(),
+		big.NewInt(1000),
+	)
+	suite.Require().NoError(err)
+	stakingPrecompile := common.HexToAddress(types.StakingPrecompileAddress)
+	delegateArgs, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &stakingPrecompile, Input: (*hexutil.Bytes)(&delegateInput)})
+	suite.Require().NoError(err)
+
+	type precompileFrame struct {
+		To         string `json:"to"`
+		Precompile *struct {
+			Method string                 `json:"method"`
+			Args   map[string]interface{} `json:"args"`
+			Events []vm.PrecompileEvent   `json:"events"`
+		} `json:"precompile"`
+	}
+
+	testCases := []struct {
+		name        string
+		getReq      func() *types.QueryTraceCallRequest
+		verifyTrace func(data []byte)
+		expPass     bool
+	}{
+		{
+			"fail - invalid args",
+			func() *types.QueryTraceCallRequest {
+				return &types.QueryTraceCallRequest{Args: []byte("invalid args"), GasCap: config.DefaultGasCap}
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - negative limit",
+			func() *types.QueryTraceCallRequest {
+				return &types.QueryTraceCallRequest{
+					Args:        defaultArgs,
+					GasCap:      config.DefaultGasCap,
+					TraceConfig: &types.TraceConfig{Limit: -1},
+				}
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - invalid tracer",
+			func() *types.QueryTraceCallRequest {
+				return &types.QueryTraceCallRequest{
+					Args:        defaultArgs,
+					GasCap:      config.DefaultGasCap,
+					TraceConfig: &types.TraceConfig{Tracer: "invalid_tracer"},
+				}
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - invalid state overrides",
+			func() *types.QueryTraceCallRequest {
+				return &types.QueryTraceCallRequest{
+					Args:      defaultArgs,
+					GasCap:    config.DefaultGasCap,
+					Overrides: []byte("invalid overrides"),
+				}
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - struct logger with state overrides",
+			func() *types.QueryTraceCallRequest {
+				return &types.QueryTraceCallRequest{
+					Args:      defaultArgs,
+					GasCap:    config.DefaultGasCap,
+					Overrides: overrides,
+				}
+			},
+			func(data []byte) {
+				var result ethlogger.ExecutionResult
+				suite.Require().NoError(json.Unmarshal(data, &result))
+				suite.Require().False(result.Failed)
+				suite.Require().Equal(common.Bytes2Hex(value.Bytes()), result.ReturnValue)
+				suite.Require().NotEmpty(result.StructLogs)
+			},
+			true,
+		},
+		{
+			"pass - call tracer with state and block overrides",
+			func() *types.QueryTraceCallRequest {
+				blockOverrides, err := json.Marshal(types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1000))})
+				suite.Require().NoError(err)
+				return &types.QueryTraceCallRequest{
+					Args:           defaultArgs,
+					GasCap:         config.DefaultGasCap,
+					TraceConfig:    &types.TraceConfig{Tracer: "callTracer"},
+					Overrides:      overrides,
+					BlockOverrides: blockOverrides,
+				}
+			},
+			func(data []byte) {
+				var result struct {
+					Type   string        `json:"type"`
+					From   string        `json:"from"`
+					To     string        `json:"to"`
+					Output hexutil.Bytes `json:"output"`
+				}
+				suite.Require().NoError(json.Unmarshal(data, &result))
+				suite.Require().Equal("CALL", result.Type)
+				suite.Require().Equal(strings.ToLower(sender.Hex()), result.From)
+				suite.Require().Equal(strings.ToLower(contract.Hex()), result.To)
+				suite.Require().Equal(value.Bytes(), []byte(result.Output))
+			},
+			true,
+		},
+		{
+			"pass - prestate tracer with tracer config",
+			func() *types.QueryTraceCallRequest {
+				return &types.QueryTraceCallRequest{
+					Args:        defaultArgs,
+					GasCap:      config.DefaultGasCap,
+					TraceConfig: &types.TraceConfig{Tracer: "prestateTracer", TracerJsonConfig: `{"diffMode":true}`},
+					Overrides:   overrides,
+				}
+			},
+			func(data []byte) {
+				var result struct {
+					Pre  map[common.Address]json.RawMessage `json:"pre"`
+					Post map[common.Address]json.RawMessage `json:"post"`
+				}
+				suite.Require().NoError(json.Unmarshal(data, &result))
+				suite.Require().NotEmpty(result.Pre)
+				// the contract storage is only read by the call
+				suite.Require().NotContains(result.Pre, contract)
+				suite.Require().NotContains(result.Post, contract)
+			},
+			true,
+		},
+		{
+			"pass - call tracer without precompile frames",
+			func() *types.QueryTraceCallRequest {
+				return &types.QueryTraceCallRequest{
+					Args:        delegateArgs,
+					GasCap:      config.DefaultGasCap,
+					TraceConfig: &types.TraceConfig{Tracer: "callTracer"},
+				}
+			},
+			func(data []byte) {
+				var result precompileFrame
+				suite.Require().NoError(json.Unmarshal(data, &result))
+				suite.Require().Equal(strings.ToLower(stakingPrecompile.Hex()), result.To)
+				suite.Require().Nil(result.Precompile)
+			},
+			true,
+		},
+		{
+			"pass - call tracer with precompile frames",
+			func() *types.QueryTraceCallRequest {
+				return &types.QueryTraceCallRequest{
+					Args:        delegateArgs,
+					GasCap:      config.DefaultGasCap,
+					TraceConfig: &types.TraceConfig{Tracer: "callTracer", EnablePrecompileFrames: true},
+				}
+			},
+			func(data []byte) {
+				var result precompileFrame
+				suite.Require().NoError(json.Unmarshal(data, &result))
+				suite.Require().NotNil(result.Precompile)
+				suite.Require().Equal(staking.DelegateMethod, result.Precompile.Method)
+				suite.Require().Equal(strings.ToLower(sender.Hex()), strings.ToLower(result.Precompile.Args["delegatorAddress"].(string)))
+				suite.Require().Equal(suite.network.GetValidators()[0].GetOperator(), result.Precompile.Args["validatorAddress"])
+
+				eventTypes := make([]string, 0, len(result.Precompile.Events))
+				for _, event := range result.Precompile.Events {
+					eventTypes = append(eventTypes, event.Type)
+				}
+				suite.Require().Contains(eventTypes, stakingtypes.EventTypeDelegate)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.network.GetEvmClient().TraceCall(suite.network.GetContext(), tc.getReq())
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.verifyTrace(res.Data)
+
+			// overrides must not be persisted
+			code := suite.network.App.EvmKeeper.GetCode(suite.network.GetContext(), suite.network.App.EvmKeeper.GetCodeHash(suite.network.GetContext(), contract))
+			suite.Require().Empty(code)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestNonceInQuery() {
 	suite.enableFeemarket = true
 	defer func() { suite.enableFeemarket = false }()
@@ -1661,6 +1953,310 @@ func (suite *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEthCallWithOverrides() {
+	suite.SetupTest()
+
+	sender := suite.keyring.GetAddr(0)
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	// returnOpCode returns the bytecode of a contract that pushes the given
+	// value to the stack and returns it as a 32 bytes word.
+	returnOpCode := func(op ...byte) hexutil.Bytes {
+		return append(op, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3)
+	}
+	// PUSH1 0x00 SLOAD
+	sloadCode := returnOpCode(0x60, 0x00, 0x54)
+
+	slot := common.Hash{}
+	value := common.BigToHash(big.NewInt(42))
+	balance := (*hexutil.Big)(big.NewInt(1e18))
+	blockTime := hexutil.Uint64(1700000000)
+
+	testCases := []struct {
+		name           string
+		overrides      types.StateOverride
+		blockOverrides *types.BlockOverrides
+		expRet         common.Hash
+		expPass        bool
+	}{
+		{
+			"pass - code and state diff override",
+			types.StateOverride{
+				contract: {Code: &sloadCode, StateDiff: &map[common.Hash]common.Hash{slot: value}},
+			},
+			nil,
+			value,
+			true,
+		},
+		{
+			"pass - code and full state override",
+			types.StateOverride{
+				contract: {Code: &sloadCode, State: &map[common.Hash]common.Hash{slot: value}},
+			},
+			nil,
+			value,
+			true,
+		},
+		{
+			"pass - balance override",
+			types.StateOverride{
+				// SELFBALANCE
+				contract: {Code: func() *hexutil.Bytes { c := returnOpCode(0x47); return &c }(), Balance: &balance},
+			},
+			nil,
+			common.BigToHash(balance.ToInt()),
+			true,
+		},
+		{
+			"pass - block number override",
+			types.StateOverride{
+				// NUMBER
+				contract: {Code: func() *hexutil.Bytes { c := returnOpCode(0x43); return &c }()},
+			},
+			&types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1000))},
+			common.BigToHash(big.NewInt(1000)),
+			true,
+		},
+		{
+			"pass - block time override",
+			types.StateOverride{
+				// TIMESTAMP
+				contract: {Code: func() *hexutil.Bytes { c := returnOpCode(0x42); return &c }()},
+			},
+			&types.BlockOverrides{Time: &blockTime},
+			common.BigToHash(new(big.Int).SetUint64(uint64(blockTime))),
+			true,
+		},
+		{
+			"fail - both state and state diff overrides",
+			types.StateOverride{
+				contract: {
+					Code:      &sloadCode,
+					State:     &map[common.Hash]common.Hash{slot: value},
+					StateDiff: &map[common.Hash]common.Hash{slot: value},
+				},
+			},
+			nil,
+			common.Hash{},
+			false,
+		},
+		{
+			"fail - invalid block number override",
+			nil,
+			&types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(0))},
+			common.Hash{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &contract})
+			suite.Require().NoError(err)
+
+			req := &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap}
+			if tc.overrides != nil {
+				req.Overrides, err = json.Marshal(tc.overrides)
+				suite.Require().NoError(err)
+			}
+			if tc.blockOverrides != nil {
+				req.BlockOverrides, err = json.Marshal(tc.blockOverrides)
+				suite.Require().NoError(err)
+			}
+
+			res, err := suite.network.GetEvmClient().EthCall(suite.network.GetContext(), req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+			suite.Require().Equal(tc.expRet, common.BytesToHash(res.Ret))
+
+			// overrides must not be persisted
+			code := suite.network.App.EvmKeeper.GetCode(suite.network.GetContext(), suite.network.App.EvmKeeper.GetCodeHash(suite.network.GetContext(), contract))
+			suite.Require().Empty(code)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSimulateV1() {
+	suite.SetupTest()
+
+	sender := suite.keyring.GetAddr(0)
+	counter := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	reverter := common.HexToAddress("0x1000000000000000000000000000000000000002")
+
+	// counterCode increments the value of slot 0, emits it as a LOG0 and returns it:
+	// PUSH1 0x00 SLOAD PUSH1 0x01 ADD DUP1 PUSH1 0x00 SSTORE PUSH1 0x00 MSTORE
+	// PUSH1 0x20 PUSH1 0x00 LOG0 PUSH1 0x20 PUSH1 0x00 RETURN
+	counterCode := hexutil.Bytes{
+		0x60, 0x00, 0x54, 0x60, 0x01, 0x01, 0x80, 0x60, 0x00, 0x55, 0x60, 0x00, 0x52,
+		0x60, 0x20, 0x60, 0x00, 0xa0, 0x60, 0x20, 0x60, 0x00, 0xf3,
+	}
+	// PUSH1 0x00 PUSH1 0x00 REVERT
+	revertCode := hexutil.Bytes{0x60, 0x00, 0x60, 0x00, 0xfd}
+
+	counterCall := types.TransactionArgs{From: &sender, To: &counter}
+	invalidNonce := hexutil.Uint64(1000)
+	overrides := types.StateOverride{
+		counter:  {Code: &counterCode},
+		reverter: {Code: &revertCode},
+	}
+
+	testCases := []struct {
+		name      string
+		opts      types.SimOpts
+		postCheck func(results []types.SimBlockResult)
+		expPass   bool
+	}{
+		{
+			"pass - calls see the state changes of the previous calls",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{StateOverrides: overrides, Calls: []types.TransactionArgs{counterCall, counterCall}},
+				},
+			},
+			func(results []types.SimBlockResult) {
+				suite.Require().Len(results, 1)
+				block := results[0]
+				suite.Require().Equal(uint64(suite.network.GetContext().BlockHeight()+1), uint64(block.Number))
+				suite.Require().Len(block.Calls, 2)
+				suite.Require().Len(block.Transactions, 2)
+				suite.Require().NotEqual(block.Transactions[0], block.Transactions[1])
+
+				var gasUsed uint64
+				for i, call := range block.Calls {
+					suite.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), call.Status)
+					suite.Require().Nil(call.Error)
+					suite.Require().Equal(common.BigToHash(big.NewInt(int64(i+1))), common.BytesToHash(call.ReturnValue))
+					suite.Require().Len(call.Logs, 1)
+					suite.Require().Equal(uint(i), call.Logs[0].Index)
+					suite.Require().Equal(block.Transactions[i], call.Logs[0].TxHash)
+					gasUsed += uint64(call.GasUsed)
+				}
+				suite.Require().Equal(gasUsed, uint64(block.GasUsed))
+			},
+			true,
+		},
+		{
+			"pass - state is carried over to the next block",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{StateOverrides: overrides, Calls: []types.TransactionArgs{counterCall}},
+					{
+						BlockOverrides: &types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1000))},
+						Calls:          []types.TransactionArgs{counterCall},
+					},
+				},
+			},
+			func(results []types.SimBlockResult) {
+				suite.Require().Len(results, 2)
+				suite.Require().Equal(hexutil.Uint64(1000), results[1].Number)
+				suite.Require().Greater(results[1].Timestamp, results[0].Timestamp)
+				suite.Require().Equal(common.BigToHash(big.NewInt(2)), common.BytesToHash(results[1].Calls[0].ReturnValue))
+			},
+			true,
+		},
+		{
+			"pass - reverted call",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{
+						StateOverrides: overrides,
+						Calls:          []types.TransactionArgs{{From: &sender, To: &reverter}, counterCall},
+					},
+				},
+			},
+			func(results []types.SimBlockResult) {
+				calls := results[0].Calls
+				suite.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusFailed), calls[0].Status)
+				suite.Require().NotNil(calls[0].Error)
+				suite.Require().Equal(3, calls[0].Error.Code)
+				suite.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), calls[1].Status)
+			},
+			true,
+		},
+		{
+			"fail - empty input",
+			types.SimOpts{},
+			nil,
+			false,
+		},
+		{
+			"fail - too many blocks",
+			types.SimOpts{BlockStateCalls: make([]types.SimBlock, types.MaxSimulateBlocks+1)},
+			nil,
+			false,
+		},
+		{
+			"fail - too many calls",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{Calls: make([]types.TransactionArgs, types.MaxSimulateCalls/2)},
+					{Calls: make([]types.TransactionArgs, types.MaxSimulateCalls/2+1)},
+				},
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - block numbers not in order",
+			types.SimOpts{
+				BlockStateCalls: []types.SimBlock{
+					{BlockOverrides: &types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1000))}},
+					{BlockOverrides: &types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1000))}},
+				},
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - invalid nonce with validation",
+			types.SimOpts{
+				Validation: true,
+				BlockStateCalls: []types.SimBlock{
+					{Calls: []types.TransactionArgs{{From: &sender, To: &counter, Nonce: &invalidNonce}}},
+				},
+			},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			opts, err := json.Marshal(tc.opts)
+			suite.Require().NoError(err)
+
+			req := &types.SimulateV1Request{Opts: opts, GasCap: config.DefaultGasCap}
+			res, err := suite.network.GetEvmClient().SimulateV1(suite.network.GetContext(), req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			var results []types.SimBlockResult
+			suite.Require().NoError(json.Unmarshal(res.Result, &results))
+			tc.postCheck(results)
+
+			// simulated state changes must not be persisted
+			code := suite.network.App.EvmKeeper.GetCode(suite.network.GetContext(), suite.network.App.EvmKeeper.GetCodeHash(suite.network.GetContext(), counter))
+			suite.Require().Empty(code)
+		})
+	}
+
+	// the calls can't be simulated without a gas cap
+	opts, err := json.Marshal(types.SimOpts{
+		BlockStateCalls: []types.SimBlock{{StateOverrides: overrides, Calls: []types.TransactionArgs{counterCall}}},
+	})
+	suite.Require().NoError(err)
+	_, err = suite.network.GetEvmClient().SimulateV1(suite.network.GetContext(), &types.SimulateV1Request{Opts: opts})
+	suite.Require().ErrorContains(err, "gas cap cannot be zero")
+}
+
 func (suite *KeeperTestSuite) TestEmptyRequest() {
 	suite.SetupTest()
 	k := suite.network.App.EvmKeeper
@@ -1717,6 +2313,12 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 				return k.EstimateGas(suite.network.GetContext(), nil)
 			},
 		},
+		{
+			"SimulateV1 method",
+			func() (interface{}, error) {
+				return k.SimulateV1(suite.network.GetContext(), nil)
+			},
+		},
 		{
 			"TraceTx method",
 			func() (interface{}, error) {
@@ -1729,6 +2331,12 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 				return k.TraceBlock(suite.network.GetContext(), nil)
 			},
 		},
+		{
+			"TraceCall method",
+			func() (interface{}, error) {
+				return k.TraceCall(suite.network.GetContext(), nil)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"errors"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v20/x/evm/keeper"
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// ReceiptRecordHook records the receipt of the processed transaction.
+type ReceiptRecordHook struct {
+	Receipt *ethtypes.Receipt
+}
+
+func (h *ReceiptRecordHook) PostTxProcessing(_ sdk.Context, _ core.Message, receipt *ethtypes.Receipt) error {
+	h.Receipt = receipt
+	return nil
+}
+
+// FailureHook always fails.
+type FailureHook struct{}
+
+func (FailureHook) PostTxProcessing(sdk.Context, core.Message, *ethtypes.Receipt) error {
+	return errors.New("post tx processing failed")
+}
+
+func (suite *KeeperTestSuite) TestEvmHooks() {
+	suite.enableFeemarket = true
+	defer func() { suite.enableFeemarket = false }()
+	recorder := &ReceiptRecordHook{}
+
+	testCases := []struct {
+		name     string
+		hooks    types.EvmHooks
+		expError bool
+	}{
+		{"pass - receipt recorded", recorder, false},
+		{"pass - multiple hooks", keeper.NewMultiEvmHooks(recorder, recorder), false},
+		{"fail - tx reverted", FailureHook{}, true},
+		{"fail - tx reverted by any hook", keeper.NewMultiEvmHooks(recorder, FailureHook{}), true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			recorder.Receipt = nil
+			suite.network.App.EvmKeeper.SetHooks(tc.hooks)
+
+			ctx := suite.network.GetContext()
+			recipient := suite.keyring.GetAddr(1)
+			amount := big.NewInt(1e18)
+			balance := suite.network.App.EvmKeeper.GetBalance(ctx, recipient)
+
+			tx, err := suite.factory.GenerateSignedEthTx(suite.keyring.GetPrivKey(0), types.EvmTxArgs{
+				To:     &recipient,
+				Amount: amount,
+			})
+			suite.Require().NoError(err)
+			msg := tx.GetMsgs()[0].(*types.MsgEthereumTx)
+
+			res, err := suite.network.App.EvmKeeper.EthereumTx(ctx, msg)
+			suite.Require().NoError(err)
+
+			if tc.expError {
+				// the state changes of the transaction are reverted
+				suite.Require().True(res.Failed())
+				suite.Require().Contains(res.VmError, types.ErrPostTxProcessing.Error())
+				suite.Require().Equal(balance, suite.network.App.EvmKeeper.GetBalance(ctx, recipient))
+				return
+			}
+
+			suite.Require().False(res.Failed())
+			suite.Require().Equal(new(big.Int).Add(balance, amount), suite.network.App.EvmKeeper.GetBalance(ctx, recipient))
+
+			receipt := recorder.Receipt
+			suite.Require().NotNil(receipt)
+			suite.Require().Equal(ethtypes.ReceiptStatusSuccessful, receipt.Status)
+			suite.Require().Equal(msg.TxHash(), receipt.TxHash)
+			suite.Require().Equal(res.GasUsed, receipt.GasUsed)
+			suite.Require().Equal(msg.AsTransaction().Type(), receipt.Type)
+		})
+	}
+}
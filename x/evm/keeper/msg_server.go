@@ -17,6 +17,7 @@ import (
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/hashicorp/go-metrics"
 
 	"github.com/evmos/evmos/v20/x/evm/types"
@@ -33,10 +34,14 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 
 	sender := msg.From
 	tx := msg.AsTransaction()
+	txData, err := types.UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to unpack tx data")
+	}
 	txIndex := k.GetTxIndexTransient(ctx)
 
 	labels := []metrics.Label{
-		telemetry.NewLabel("tx_type", fmt.Sprintf("%d", tx.Type())),
+		telemetry.NewLabel("tx_type", fmt.Sprintf("%d", txData.TxType())),
 	}
 	if tx.To() == nil {
 		labels = append(labels, telemetry.NewLabel("execution", "create"))
@@ -44,7 +49,7 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		labels = append(labels, telemetry.NewLabel("execution", "call"))
 	}
 
-	response, err := k.ApplyTransaction(ctx, tx)
+	response, err := k.ApplyEthereumTx(ctx, msg)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to apply transaction")
 	}
@@ -147,3 +152,67 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetFeeSponsorship implements the gRPC MsgServer interface. It creates or
+// replaces the fee sponsorship of the sponsor.
+func (k *Keeper) SetFeeSponsorship(goCtx context.Context, req *types.MsgSetFeeSponsorship) (*types.MsgSetFeeSponsorshipResponse, error) {
+	sponsor, err := sdk.AccAddressFromBech32(req.Sponsor)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sponsor address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetAccountFeeSponsorship(ctx, sponsor, req.Sponsorship)
+
+	return &types.MsgSetFeeSponsorshipResponse{}, nil
+}
+
+// RevokeFeeSponsorship implements the gRPC MsgServer interface. It removes the
+// fee sponsorship of the sponsor.
+func (k *Keeper) RevokeFeeSponsorship(goCtx context.Context, req *types.MsgRevokeFeeSponsorship) (*types.MsgRevokeFeeSponsorshipResponse, error) {
+	sponsor, err := sdk.AccAddressFromBech32(req.Sponsor)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sponsor address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetFeeSponsorship(ctx, sponsor); !found {
+		return nil, errorsmod.Wrapf(types.ErrFeeSponsorshipNotFound, "sponsor %s", req.Sponsor)
+	}
+	k.deleteFeeSponsorship(ctx, sponsor)
+
+	return &types.MsgRevokeFeeSponsorshipResponse{}, nil
+}
+
+// SetFeeSponsor implements the gRPC MsgServer interface. It sets the sponsor
+// paying the fees of the EVM transactions of the sender, which must be in the
+// scope of the senders of the fee sponsorship of the sponsor. An empty sponsor
+// removes the previous one, so that the sender pays its own fees.
+func (k *Keeper) SetFeeSponsor(goCtx context.Context, req *types.MsgSetFeeSponsor) (*types.MsgSetFeeSponsorResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	var sponsor sdk.AccAddress
+	if req.Sponsor != "" {
+		sponsor, err = sdk.AccAddressFromBech32(req.Sponsor)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "invalid sponsor address")
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !sponsor.Empty() {
+		sponsorship, found := k.GetFeeSponsorship(ctx, sponsor)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrFeeSponsorshipNotFound, "sponsor %s", req.Sponsor)
+		}
+		if !sponsorship.AllowsSender(common.BytesToAddress(sender)) {
+			return nil, errorsmod.Wrapf(types.ErrFeeSponsorshipNotAllowed, "sender %s is not sponsored", req.Sender)
+		}
+	}
+	k.SetAccountFeeSponsor(ctx, sender, sponsor)
+
+	return &types.MsgSetFeeSponsorResponse{}, nil
+}
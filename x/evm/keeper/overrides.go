@@ -0,0 +1,114 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"encoding/json"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+// ApplyStateOverrides applies the given account overrides on top of the state
+// held by the StateDB. The changes are only journaled in the StateDB, so they
+// are discarded unless the StateDB is committed.
+func ApplyStateOverrides(stateDB *statedb.StateDB, overrides types.StateOverride) error {
+	if err := overrides.Validate(); err != nil {
+		return err
+	}
+
+	for addr, account := range overrides {
+		if account.Nonce != nil {
+			stateDB.SetNonce(addr, uint64(*account.Nonce))
+		}
+		if account.Code != nil {
+			stateDB.SetCode(addr, *account.Code)
+		}
+		if account.Balance != nil && *account.Balance != nil {
+			stateDB.SetBalance(addr, (*account.Balance).ToInt())
+		}
+		if account.State != nil {
+			stateDB.SetStorage(addr, *account.State)
+		}
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				stateDB.SetState(addr, key, value)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ApplyBlockOverrides returns a copy of the context with the overridden block
+// header fields and updates the EVM config with the overridden coinbase and base fee.
+func ApplyBlockOverrides(ctx sdk.Context, cfg *statedb.EVMConfig, overrides types.BlockOverrides) (sdk.Context, error) {
+	if err := overrides.Validate(); err != nil {
+		return ctx, err
+	}
+
+	if overrides.Number != nil {
+		ctx = ctx.WithBlockHeight(overrides.Number.ToInt().Int64())
+	}
+	if overrides.Time != nil {
+		ctx = ctx.WithBlockTime(time.Unix(int64(*overrides.Time), 0).UTC()) //nolint:gosec // G115 -- checked on Validate
+	}
+	if overrides.Coinbase != nil {
+		cfg.CoinBase = *overrides.Coinbase
+	}
+	if overrides.BaseFee != nil {
+		cfg.BaseFee = overrides.BaseFee.ToInt()
+	}
+
+	return ctx, nil
+}
+
+// applyCallOverrides decodes the JSON-encoded block and state overrides of an
+// eth_call request and applies them on a cached copy of the given context,
+// which is returned together with the updated EVM config. The overrides are never
+// persisted since the returned context is not written back.
+func (k *Keeper) applyCallOverrides(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	stateOverridesBz, blockOverridesBz []byte,
+) (sdk.Context, error) {
+	if len(blockOverridesBz) > 0 {
+		var blockOverrides types.BlockOverrides
+		if err := json.Unmarshal(blockOverridesBz, &blockOverrides); err != nil {
+			return ctx, errorsmod.Wrap(err, "failed to decode block overrides")
+		}
+
+		var err error
+		if ctx, err = ApplyBlockOverrides(ctx, cfg, blockOverrides); err != nil {
+			return ctx, err
+		}
+	}
+
+	if len(stateOverridesBz) == 0 {
+		return ctx, nil
+	}
+
+	var stateOverrides types.StateOverride
+	if err := json.Unmarshal(stateOverridesBz, &stateOverrides); err != nil {
+		return ctx, errorsmod.Wrap(err, "failed to decode state overrides")
+	}
+
+	ctx, _ = ctx.CacheContext()
+	stateDB := statedb.New(ctx, k, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	if err := ApplyStateOverrides(stateDB, stateOverrides); err != nil {
+		return ctx, err
+	}
+
+	// write the overrides to the cached context so that they are visible for the
+	// message execution, which creates its own StateDB
+	if err := stateDB.Commit(); err != nil {
+		return ctx, errorsmod.Wrap(err, "failed to apply state overrides")
+	}
+
+	return ctx, nil
+}
@@ -96,6 +96,9 @@ func (suite *KeeperTestSuite) SetupTest() {
 		chainConfig.MergeNetsplitBlock = &maxInt
 		chainConfig.ShanghaiBlock = &maxInt
 		chainConfig.CancunBlock = &maxInt
+	} else {
+		pragueBlock := sdkmath.ZeroInt()
+		chainConfig.PragueBlock = &pragueBlock
 	}
 	// get the denom and decimals set on chain initialization
 	// because we'll need to set them again when resetting the chain config
@@ -0,0 +1,247 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmostypes "github.com/evmos/evmos/v20/types"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
+	"github.com/evmos/evmos/v20/x/evm/statedb"
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+const (
+	// simulateTimestampIncrement is the default number of seconds between
+	// simulated blocks when the block time is not overridden.
+	simulateTimestampIncrement = 1
+	// errCodeVMError is the JSON-RPC error code of a simulated call that failed
+	// with an EVM error other than a revert.
+	errCodeVMError = -32015
+)
+
+// simulateV1 executes the simulated blocks of an eth_simulateV1 request on top of
+// the state of the given context. Each block is executed on a single StateDB that
+// is finalised after every call, so that each call sees the state changes of the
+// previous ones. The changes are never persisted.
+func (k *Keeper) simulateV1(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	opts types.SimOpts,
+	gasCap uint64,
+) ([]types.SimBlockResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	// the gas cap is shared by all the calls, so it can't be left unlimited
+	if gasCap == 0 {
+		return nil, errors.New("gas cap cannot be zero")
+	}
+
+	// the simulated blocks share a cached context that is never written back
+	ctx, _ = ctx.CacheContext()
+
+	var (
+		number    = ctx.BlockHeight()
+		timestamp = uint64(ctx.BlockTime().Unix()) //nolint:gosec // G115 -- block time is never negative
+		results   = make([]types.SimBlockResult, 0, len(opts.BlockStateCalls))
+	)
+
+	for i, block := range opts.BlockStateCalls {
+		var overrides types.BlockOverrides
+		if block.BlockOverrides != nil {
+			overrides = *block.BlockOverrides
+		}
+
+		if overrides.Number == nil {
+			overrides.Number = (*hexutil.Big)(big.NewInt(number + 1))
+		} else if n := overrides.Number.ToInt(); !n.IsInt64() || n.Int64() <= number {
+			return nil, fmt.Errorf("block numbers must be in order: %s <= %d", n, number)
+		}
+
+		if overrides.Time == nil {
+			t := hexutil.Uint64(timestamp + simulateTimestampIncrement)
+			overrides.Time = &t
+		} else if uint64(*overrides.Time) <= timestamp {
+			return nil, fmt.Errorf("block timestamps must be in order: %d <= %d", uint64(*overrides.Time), timestamp)
+		}
+
+		// copy the config so that the overrides don't leak to the next block
+		blockCfg := *cfg
+		blockCtx, err := ApplyBlockOverrides(ctx, &blockCfg, overrides)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "block %d", i)
+		}
+
+		result, err := k.simulateBlock(blockCtx, &blockCfg, block, opts.Validation, &gasCap)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "block %d", i)
+		}
+
+		results = append(results, result)
+		number = blockCtx.BlockHeight()
+		timestamp = uint64(*overrides.Time)
+	}
+
+	return results, nil
+}
+
+// simulateBlock applies the state overrides and executes the calls of a single
+// simulated block on the same StateDB. The remaining gas cap is shared by all
+// the calls of the request.
+func (k *Keeper) simulateBlock(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	block types.SimBlock,
+	validation bool,
+	gasCap *uint64,
+) (types.SimBlockResult, error) {
+	stateDB := statedb.New(ctx, k, statedb.NewEmptyTxConfig(common.Hash{}))
+	if err := ApplyStateOverrides(stateDB, block.StateOverrides); err != nil {
+		return types.SimBlockResult{}, err
+	}
+	if err := stateDB.Finalise(); err != nil {
+		return types.SimBlockResult{}, errorsmod.Wrap(err, "failed to apply state overrides")
+	}
+
+	result := types.SimBlockResult{
+		Number:       hexutil.Uint64(ctx.BlockHeight()),      //nolint:gosec // G115 -- block height is always positive
+		Timestamp:    hexutil.Uint64(ctx.BlockTime().Unix()), //nolint:gosec // G115 -- block time is never negative
+		GasLimit:     hexutil.Uint64(evmostypes.BlockGasLimit(ctx)),
+		Miner:        cfg.CoinBase,
+		Transactions: make([]common.Hash, 0, len(block.Calls)),
+		Calls:        make([]types.SimCallResult, 0, len(block.Calls)),
+	}
+	if cfg.BaseFee != nil {
+		result.BaseFeePerGas = (*hexutil.Big)(cfg.BaseFee)
+	}
+
+	var (
+		gasUsed  uint64
+		logIndex uint
+	)
+
+	for i, args := range block.Calls {
+		from := args.GetFrom()
+
+		nonce := stateDB.GetNonce(from)
+		if args.Nonce == nil {
+			args.Nonce = (*hexutil.Uint64)(&nonce)
+		} else if validation && uint64(*args.Nonce) != nonce {
+			return result, fmt.Errorf("call %d: invalid nonce for %s: expected %d, got %d", i, from, nonce, uint64(*args.Nonce))
+		}
+
+		if *gasCap == 0 {
+			return result, fmt.Errorf("call %d: gas cap reached", i)
+		}
+
+		remaining := uint64(result.GasLimit) - gasUsed
+		if result.GasLimit == 0 {
+			remaining = *gasCap
+		}
+		if args.Gas == nil {
+			args.Gas = (*hexutil.Uint64)(&remaining)
+		} else if result.GasLimit != 0 && uint64(*args.Gas) > remaining {
+			return result, fmt.Errorf("call %d: block gas limit reached: %d > %d", i, uint64(*args.Gas), remaining)
+		}
+
+		msg, err := args.ToMessage(*gasCap, cfg.BaseFee)
+		if err != nil {
+			return result, errorsmod.Wrapf(err, "call %d", i)
+		}
+
+		if validation {
+			if err := validateSimulatedMessage(stateDB, msg, cfg.BaseFee); err != nil {
+				return result, errorsmod.Wrapf(err, "call %d", i)
+			}
+		}
+
+		txHash := args.ToTransaction().TxHash()
+		stateDB.SetTxConfig(statedb.NewTxConfig(common.Hash{}, txHash, uint(i), logIndex))
+
+		// pass false since the StateDB is finalised below
+		res, err := k.ApplyMessageWithStateDB(ctx, msg, nil, false, cfg, stateDB)
+		if err != nil {
+			return result, errorsmod.Wrapf(err, "call %d", i)
+		}
+
+		if validation {
+			fee := new(big.Int).Mul(new(big.Int).SetUint64(res.GasUsed), msg.GasPrice())
+			stateDB.SubBalance(from, fee)
+		}
+
+		// the nonce of calls is incremented on the ante handler, while contract
+		// creations are already handled by ApplyMessageWithStateDB
+		if msg.To() != nil {
+			stateDB.SetNonce(from, msg.Nonce()+1)
+		}
+
+		if err := stateDB.Finalise(); err != nil {
+			return result, errorsmod.Wrapf(err, "call %d: failed to finalise state", i)
+		}
+
+		gasUsed += res.GasUsed
+		if res.GasUsed >= *gasCap {
+			*gasCap = 0
+		} else {
+			*gasCap -= res.GasUsed
+		}
+
+		logs := types.LogsToEthereum(res.Logs)
+		for _, log := range logs {
+			log.BlockNumber = uint64(result.Number)
+		}
+		logIndex += uint(len(logs))
+
+		callResult := types.SimCallResult{
+			ReturnValue: res.Ret,
+			Logs:        logs,
+			GasUsed:     hexutil.Uint64(res.GasUsed),
+			Status:      hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+		}
+		if res.Failed() {
+			callResult.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+			callResult.Error = &types.SimCallError{Code: errCodeVMError, Message: res.VmError}
+			if res.VmError == vm.ErrExecutionReverted.Error() {
+				revertErr := types.NewExecErrorWithReason(res.Ret)
+				callResult.Error = &types.SimCallError{
+					Code:    revertErr.ErrorCode(),
+					Message: revertErr.Error(),
+					Data:    revertErr.ErrorData().(string),
+				}
+			}
+		}
+
+		result.Transactions = append(result.Transactions, txHash)
+		result.Calls = append(result.Calls, callResult)
+	}
+
+	result.GasUsed = hexutil.Uint64(gasUsed)
+	return result, nil
+}
+
+// validateSimulatedMessage performs the checks done by the ante handler on a
+// simulated message when validation is enabled: the fee cap must cover the base
+// fee and the sender must be able to pay for the gas limit and the value.
+func validateSimulatedMessage(stateDB *statedb.StateDB, msg ethtypes.Message, baseFee *big.Int) error {
+	if baseFee != nil && msg.GasFeeCap().Cmp(baseFee) < 0 {
+		return fmt.Errorf("max fee per gas less than block base fee: %s < %s", msg.GasFeeCap(), baseFee)
+	}
+
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(msg.Gas()), msg.GasFeeCap())
+	cost.Add(cost, msg.Value())
+	if balance := stateDB.GetBalance(msg.From()); balance.Cmp(cost) < 0 {
+		return fmt.Errorf("insufficient funds for gas * price + value: address %s have %s want %s", msg.From(), balance, cost)
+	}
+
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 	evmoscore "github.com/evmos/evmos/v20/x/evm/core/core"
 	"github.com/evmos/evmos/v20/x/evm/core/vm"
@@ -146,21 +147,55 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 //
 // For relevant discussion see: https://github.com/cosmos/cosmos-sdk/discussions/9072
 func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*types.MsgEthereumTxResponse, error) {
+	asMessage := func(signer ethtypes.Signer, baseFee *big.Int) (core.Message, error) {
+		return tx.AsMessage(signer, baseFee)
+	}
+	return k.applyTransaction(ctx, tx.Hash(), tx.Type(), asMessage)
+}
+
+// ApplyEthereumTx is the same as ApplyTransaction, but applies the transaction of
+// the MsgEthereumTx. Unlike ApplyTransaction, it supports the transaction types
+// that go-ethereum can't represent, such as EIP-7702 set code transactions.
+func (k *Keeper) ApplyEthereumTx(ctx sdk.Context, tx *types.MsgEthereumTx) (*types.MsgEthereumTxResponse, error) {
+	txData, err := types.UnpackTxData(tx.Data)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to unpack tx data")
+	}
+	return k.applyTransaction(ctx, tx.TxHash(), txData.TxType(), tx.AsMessage)
+}
+
+// asMessageFn returns the core.Message of a transaction.
+type asMessageFn func(signer ethtypes.Signer, baseFee *big.Int) (core.Message, error)
+
+func (k *Keeper) applyTransaction(
+	ctx sdk.Context,
+	txHash common.Hash,
+	txType uint8,
+	asMessage asMessageFn,
+) (*types.MsgEthereumTxResponse, error) {
 	var bloom *big.Int
 
 	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress))
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to load evm config")
 	}
-	txConfig := k.TxConfig(ctx, tx.Hash())
+	txConfig := k.TxConfig(ctx, txHash)
 
 	// get the signer according to the chain rules from the config and block height
 	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
-	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	msg, err := asMessage(signer, cfg.BaseFee)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
 	}
 
+	// The authorizations of set code transactions persist even if the execution is reverted,
+	// so they're committed on their own before creating the cache context.
+	if setCodeMsg, ok := msg.(types.SetCodeMessage); ok {
+		if msg, err = k.commitAuthorizations(ctx, cfg, txConfig, setCodeMsg); err != nil {
+			return nil, err
+		}
+	}
+
 	// Create a cache context to revert state. The cache context is only committed when both tx and hooks executed successfully.
 	// Didn't use `Snapshot` because the context stack has exponential complexity on certain operations,
 	// thus restricted to be used only inside `ApplyMessage`.
@@ -177,16 +212,31 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 
 	logs := types.LogsToEthereum(res.Logs)
 
+	if !res.Failed() {
+		receipt := k.newReceipt(ctx, msg, res, logs, txType, txConfig)
+
+		// Only call hooks if the tx executed successfully.
+		if err = k.PostTxProcessing(tmpCtx, msg, receipt); err != nil {
+			// If the hooks return an error, revert the whole tx.
+			res.VmError = errorsmod.Wrap(types.ErrPostTxProcessing, err.Error()).Error()
+			k.Logger(ctx).Error("tx post processing failed", "error", err)
+
+			// the logs of the reverted tx are cleared
+			logs = nil
+		} else {
+			commit()
+			// the hooks can alter the logs
+			logs = receipt.Logs
+		}
+		res.Logs = types.NewLogsFromEth(logs)
+	}
+
 	// Compute block bloom filter
 	if len(logs) > 0 {
 		bloom = k.GetBlockBloomTransient(ctx)
 		bloom.Or(bloom, big.NewInt(0).SetBytes(ethtypes.LogsBloom(logs)))
 	}
 
-	if !res.Failed() {
-		commit()
-	}
-
 	evmDenom := types.GetEVMCoinDenom()
 
 	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
@@ -212,6 +262,45 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	return res, nil
 }
 
+// newReceipt returns the receipt of a successfully executed transaction
+// passed to the EVM hooks.
+func (k *Keeper) newReceipt(
+	ctx sdk.Context,
+	msg core.Message,
+	res *types.MsgEthereumTxResponse,
+	logs []*ethtypes.Log,
+	txType uint8,
+	txConfig statedb.TxConfig,
+) *ethtypes.Receipt {
+	cumulativeGasUsed := res.GasUsed
+	if ctx.BlockGasMeter() != nil {
+		limit := ctx.BlockGasMeter().Limit()
+		cumulativeGasUsed += ctx.BlockGasMeter().GasConsumed()
+		if cumulativeGasUsed > limit {
+			cumulativeGasUsed = limit
+		}
+	}
+
+	var contractAddr common.Address
+	if msg.To() == nil {
+		contractAddr = crypto.CreateAddress(msg.From(), msg.Nonce())
+	}
+
+	return &ethtypes.Receipt{
+		Type:              txType,
+		Status:            ethtypes.ReceiptStatusSuccessful,
+		CumulativeGasUsed: cumulativeGasUsed,
+		Bloom:             ethtypes.BytesToBloom(ethtypes.LogsBloom(logs)),
+		Logs:              logs,
+		TxHash:            txConfig.TxHash,
+		ContractAddress:   contractAddr,
+		GasUsed:           res.GasUsed,
+		BlockHash:         txConfig.BlockHash,
+		BlockNumber:       big.NewInt(ctx.BlockHeight()),
+		TransactionIndex:  txConfig.TxIndex,
+	}
+}
+
 // ApplyMessage calls ApplyMessageWithConfig with an empty TxConfig.
 func (k *Keeper) ApplyMessage(ctx sdk.Context, msg core.Message, tracer vm.EVMLogger, commit bool) (*types.MsgEthereumTxResponse, error) {
 	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress))
@@ -268,13 +357,31 @@ func (k *Keeper) ApplyMessageWithConfig(
 	commit bool,
 	cfg *statedb.EVMConfig,
 	txConfig statedb.TxConfig,
+) (*types.MsgEthereumTxResponse, error) {
+	stateDB := statedb.New(ctx, k, txConfig)
+	return k.ApplyMessageWithStateDB(ctx, msg, tracer, commit, cfg, stateDB)
+}
+
+// ApplyMessageWithStateDB is the same as ApplyMessageWithConfig, but executes the message
+// on the given StateDB instead of creating a new one. It allows the caller to apply
+// several messages on the same StateDB (see StateDB.Finalise), e.g. for `eth_simulateV1`.
+//
+// The StateDB must have been created from the given context and its TxConfig is used for
+// the logs and the hash of the response.
+func (k *Keeper) ApplyMessageWithStateDB(
+	ctx sdk.Context,
+	msg core.Message,
+	tracer vm.EVMLogger,
+	commit bool,
+	cfg *statedb.EVMConfig,
+	stateDB *statedb.StateDB,
 ) (*types.MsgEthereumTxResponse, error) {
 	var (
 		ret   []byte // return bytes from evm execution
 		vmErr error  // vm errors do not effect consensus and are therefore not assigned to err
 	)
 
-	stateDB := statedb.New(ctx, k, txConfig)
+	txConfig := stateDB.TxConfig()
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
 	leftoverGas := msg.Gas()
@@ -311,6 +418,23 @@ func (k *Keeper) ApplyMessageWithConfig(
 		stateDB.PrepareAccessList(msg.From(), msg.To(), evm.ActivePrecompiles(rules), msg.AccessList())
 	}
 
+	// the authorizations of set code transactions are applied before the execution,
+	// so that the called accounts can already delegate their code
+	if setCodeMsg, ok := msg.(types.SetCodeMessage); ok {
+		if !types.GetChainConfig().IsPrague(evm.Context.BlockNumber) {
+			return nil, errorsmod.Wrapf(types.ErrSetCodeTxNotActivated, "block %s", evm.Context.BlockNumber)
+		}
+
+		applied := setCodeMsg.Applied
+		if applied == nil {
+			applied = applyAuthorizations(stateDB, cfg.ChainConfig.ChainID, setCodeMsg.Authorizations)
+		}
+		for _, authority := range applied.Authorities {
+			stateDB.AddAddressToAccessList(authority)
+		}
+		stateDB.AddRefund(applied.Refund)
+	}
+
 	if contractCreation {
 		// take over the nonce management from evm:
 		// - reset sender's nonce to msg.Nonce() before calling evm.
@@ -381,3 +505,79 @@ func (k *Keeper) ApplyMessageWithConfig(
 		Hash:    txConfig.TxHash.Hex(),
 	}, nil
 }
+
+// commitAuthorizations applies the authorizations of a set code transaction on
+// the given context and commits them, so that they aren't reverted with the
+// execution of the transaction. It returns the message to execute, which carries
+// the outcome of the authorizations.
+func (k *Keeper) commitAuthorizations(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	msg types.SetCodeMessage,
+) (types.SetCodeMessage, error) {
+	if !types.GetChainConfig().IsPrague(big.NewInt(ctx.BlockHeight())) {
+		return msg, errorsmod.Wrapf(types.ErrSetCodeTxNotActivated, "block %d", ctx.BlockHeight())
+	}
+
+	stateDB := statedb.New(ctx, k, txConfig)
+	msg.Applied = applyAuthorizations(stateDB, cfg.ChainConfig.ChainID, msg.Authorizations)
+	if err := stateDB.Commit(); err != nil {
+		return msg, errorsmod.Wrap(err, "failed to commit authorizations")
+	}
+	return msg, nil
+}
+
+// applyAuthorizations sets the code of the authorities of an EIP-7702
+// authorization list to the delegation designator of the authorized contracts.
+// The zero address clears the delegation. The invalid authorizations are skipped
+// and don't fail the transaction.
+func applyAuthorizations(stateDB *statedb.StateDB, chainID *big.Int, authorizations types.AuthorizationList) *types.AppliedAuthorizations {
+	applied := &types.AppliedAuthorizations{}
+	for _, auth := range authorizations {
+		authority, err := validateAuthorization(stateDB, chainID, auth)
+		if authority != (common.Address{}) {
+			applied.Authorities = append(applied.Authorities, authority)
+		}
+		if err != nil {
+			continue
+		}
+
+		// the intrinsic gas charges all the authorities as new accounts
+		if stateDB.Exist(authority) {
+			applied.Refund += types.PerEmptyAccountCost - types.PerAuthBaseCost
+		}
+
+		stateDB.SetDelegation(authority, auth.GetAddress())
+		stateDB.SetNonce(authority, auth.Nonce+1)
+	}
+	return applied
+}
+
+// validateAuthorization checks the authorization against the state and returns
+// its authority. The authority is returned, and should be added to the access
+// list, even if its account can't delegate its code.
+func validateAuthorization(stateDB *statedb.StateDB, chainID *big.Int, auth types.SetCodeAuthorization) (common.Address, error) {
+	if authChainID := auth.GetChainID(); authChainID.Sign() != 0 && authChainID.Cmp(chainID) != 0 {
+		return common.Address{}, errorsmod.Wrapf(types.ErrInvalidAuthorization, "chain ID %s doesn't match %s", authChainID, chainID)
+	}
+
+	if auth.Nonce+1 < auth.Nonce {
+		return common.Address{}, errorsmod.Wrap(types.ErrInvalidAuthorization, "nonce overflow")
+	}
+
+	authority, err := auth.Authority()
+	if err != nil {
+		return common.Address{}, errorsmod.Wrap(types.ErrInvalidAuthorization, err.Error())
+	}
+
+	if _, delegated := stateDB.GetDelegation(authority); stateDB.GetCodeSize(authority) != 0 && !delegated {
+		return authority, errorsmod.Wrapf(types.ErrInvalidAuthorization, "authority %s has code", authority)
+	}
+
+	if nonce := stateDB.GetNonce(authority); nonce != auth.Nonce {
+		return authority, errorsmod.Wrapf(types.ErrInvalidAuthorization, "nonce %d doesn't match %d", auth.Nonce, nonce)
+	}
+
+	return authority, nil
+}
@@ -19,13 +19,16 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/evmos/evmos/v20/crypto/ethsecp256k1"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/factory"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/grpc"
 	testkeyring "github.com/evmos/evmos/v20/testutil/integration/evmos/keyring"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
 	"github.com/evmos/evmos/v20/testutil/integration/evmos/utils"
 	utiltx "github.com/evmos/evmos/v20/testutil/tx"
+	"github.com/evmos/evmos/v20/x/evm/core/vm"
 	"github.com/evmos/evmos/v20/x/evm/keeper"
 	"github.com/evmos/evmos/v20/x/evm/types"
 	feemarkettypes "github.com/evmos/evmos/v20/x/feemarket/types"
@@ -597,6 +600,148 @@ func (suite *KeeperTestSuite) TestApplyMessage() {
 	suite.Require().Equal(expectedGasUsed, res.GasUsed)
 }
 
+func (suite *KeeperTestSuite) TestApplyMessageSetCode() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+
+	sender := suite.keyring.GetAddr(0)
+	recipient := suite.keyring.GetAddr(1)
+	authority := suite.keyring.GetKey(1)
+	target := common.HexToAddress("0xc0de")
+	authorityNonce := suite.network.App.EvmKeeper.GetNonce(ctx, authority.Addr)
+
+	authorityKey, err := authority.Priv.(*ethsecp256k1.PrivKey).ToECDSA()
+	suite.Require().NoError(err)
+	signAuth := func(nonce uint64) types.SetCodeAuthorization {
+		auth := types.SetCodeAuthorization{
+			ChainID: sdkmath.ZeroInt(),
+			Address: target.Hex(),
+			Nonce:   nonce,
+		}
+		sig, err := crypto.Sign(auth.SigHash().Bytes(), authorityKey)
+		suite.Require().NoError(err)
+		auth.R, auth.S, auth.V = sig[:32], sig[32:64], big.NewInt(int64(sig[64])).Bytes()
+		return auth
+	}
+
+	// the second authorization is skipped since the authority nonce was
+	// already bumped by the first one
+	auths := types.AuthorizationList{signAuth(authorityNonce), signAuth(authorityNonce)}
+	gasLimit, err := types.AddAuthorizationsGas(params.TxGas, auths)
+	suite.Require().NoError(err)
+
+	msg := types.SetCodeMessage{
+		Message: gethtypes.NewMessage(
+			sender, &recipient, suite.network.App.EvmKeeper.GetNonce(ctx, sender), big.NewInt(0), gasLimit,
+			big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, false,
+		),
+		Authorizations: auths,
+	}
+
+	res, err := suite.network.App.EvmKeeper.ApplyMessage(ctx, msg, nil, true)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed())
+
+	// the authority exists, so each applied authorization is partially refunded
+	refund := types.PerEmptyAccountCost - types.PerAuthBaseCost
+	suite.Require().Equal(gasLimit-refund, res.GasUsed)
+
+	code := suite.network.App.EvmKeeper.GetCode(ctx, common.BytesToHash(suite.network.App.EvmKeeper.GetAccount(ctx, authority.Addr).CodeHash))
+	suite.Require().Equal(vm.AddressToDelegation(target), code)
+	suite.Require().Equal(authorityNonce+1, suite.network.App.EvmKeeper.GetNonce(ctx, authority.Addr))
+}
+
+func (suite *KeeperTestSuite) TestApplyEthereumTxSetCodeReverted() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+
+	sender := suite.keyring.GetKey(0)
+	authority := suite.keyring.GetKey(1)
+	target := common.HexToAddress("0xc0de")
+	authorityNonce := suite.network.App.EvmKeeper.GetNonce(ctx, authority.Addr)
+
+	authorityKey, err := authority.Priv.(*ethsecp256k1.PrivKey).ToECDSA()
+	suite.Require().NoError(err)
+	senderKey, err := sender.Priv.(*ethsecp256k1.PrivKey).ToECDSA()
+	suite.Require().NoError(err)
+
+	auth := types.SetCodeAuthorization{
+		ChainID: sdkmath.ZeroInt(),
+		Address: target.Hex(),
+		Nonce:   authorityNonce,
+	}
+	sig, err := crypto.Sign(auth.SigHash().Bytes(), authorityKey)
+	suite.Require().NoError(err)
+	auth.R, auth.S, auth.V = sig[:32], sig[32:64], big.NewInt(int64(sig[64])).Bytes()
+
+	gasLimit, err := types.AddAuthorizationsGas(params.TxGas, types.AuthorizationList{auth})
+	suite.Require().NoError(err)
+
+	// the transfer exceeds the balance of the sender, so the execution fails
+	chainID := sdkmath.NewIntFromBigInt(suite.network.GetEIP155ChainID())
+	zero := sdkmath.ZeroInt()
+	amount := sdkmath.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 200))
+	txData := &types.SetCodeTx{
+		ChainID:        &chainID,
+		Nonce:          suite.network.App.EvmKeeper.GetNonce(ctx, sender.Addr),
+		GasTipCap:      &zero,
+		GasFeeCap:      &zero,
+		GasLimit:       gasLimit,
+		To:             suite.keyring.GetAddr(1).Hex(),
+		Amount:         &amount,
+		Authorizations: types.AuthorizationList{auth},
+	}
+	sig, err = crypto.Sign(txData.SigHash().Bytes(), senderKey)
+	suite.Require().NoError(err)
+	txData.SetSignatureValues(nil, big.NewInt(int64(sig[64])), new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64]))
+
+	anyTxData, err := types.PackTxData(txData)
+	suite.Require().NoError(err)
+	msg := &types.MsgEthereumTx{Data: anyTxData, Hash: txData.Hash().Hex()}
+
+	res, err := suite.network.App.EvmKeeper.ApplyEthereumTx(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Failed())
+
+	// the authorization isn't reverted with the execution
+	code := suite.network.App.EvmKeeper.GetCode(ctx, common.BytesToHash(suite.network.App.EvmKeeper.GetAccount(ctx, authority.Addr).CodeHash))
+	suite.Require().Equal(vm.AddressToDelegation(target), code)
+	suite.Require().Equal(authorityNonce+1, suite.network.App.EvmKeeper.GetNonce(ctx, authority.Addr))
+}
+
+func (suite *KeeperTestSuite) TestApplyMessageSetCodeNotActivated() {
+	suite.SetupTest()
+	ctx := suite.network.GetContext()
+
+	// the Prague fork isn't activated by default
+	setChainConfig := func(chainConfig *types.ChainConfig) {
+		denom := types.GetEVMCoinDenom()       //nolint:staticcheck
+		decimals := types.GetEVMCoinDecimals() //nolint:staticcheck
+		configurator := types.NewEVMConfigurator()
+		configurator.ResetTestConfig()
+		err := configurator.
+			WithChainConfig(chainConfig).
+			WithEVMCoinInfo(denom, uint8(decimals)).
+			Configure()
+		suite.Require().NoError(err)
+	}
+	activeConfig := *types.GetChainConfig()
+	setChainConfig(types.DefaultChainConfig(suite.network.GetChainID()))
+	defer setChainConfig(&activeConfig)
+
+	sender := suite.keyring.GetAddr(0)
+	recipient := suite.keyring.GetAddr(1)
+	msg := types.SetCodeMessage{
+		Message: gethtypes.NewMessage(
+			sender, &recipient, suite.network.App.EvmKeeper.GetNonce(ctx, sender), big.NewInt(0), params.TxGas,
+			big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, false,
+		),
+	}
+
+	_, err := suite.network.App.EvmKeeper.ApplyMessage(ctx, msg, nil, true)
+	suite.Require().ErrorIs(err, types.ErrSetCodeTxNotActivated)
+}
+
 func (suite *KeeperTestSuite) TestApplyMessageWithConfig() {
 	suite.enableFeemarket = true
 	defer func() { suite.enableFeemarket = false }()
@@ -8,18 +8,26 @@ import (
 	"maps"
 	"slices"
 
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
 	"github.com/ethereum/go-ethereum/common"
+	authzprecompile "github.com/evmos/evmos/v20/precompiles/authz"
 	bankprecompile "github.com/evmos/evmos/v20/precompiles/bank"
 	"github.com/evmos/evmos/v20/precompiles/bech32"
+	dispatchprecompile "github.com/evmos/evmos/v20/precompiles/dispatch"
 	distprecompile "github.com/evmos/evmos/v20/precompiles/distribution"
+	feegrantprecompile "github.com/evmos/evmos/v20/precompiles/feegrant"
 	govprecompile "github.com/evmos/evmos/v20/precompiles/gov"
 	ics20precompile "github.com/evmos/evmos/v20/precompiles/ics20"
 	"github.com/evmos/evmos/v20/precompiles/p256"
+	slashingprecompile "github.com/evmos/evmos/v20/precompiles/slashing"
 	stakingprecompile "github.com/evmos/evmos/v20/precompiles/staking"
 	vestingprecompile "github.com/evmos/evmos/v20/precompiles/vesting"
 	erc20Keeper "github.com/evmos/evmos/v20/x/erc20/keeper"
@@ -44,6 +52,12 @@ func NewAvailableStaticPrecompiles(
 	transferKeeper transferkeeper.Keeper,
 	channelKeeper channelkeeper.Keeper,
 	govKeeper govkeeper.Keeper,
+	slashingKeeper slashingkeeper.Keeper,
+	feegrantKeeper feegrantkeeper.Keeper,
+	evmKeeper *Keeper,
+	msgRouter baseapp.MessageRouter,
+	authzLimiter authzprecompile.AuthzLimiter,
+	cdc codec.Codec,
 ) map[common.Address]vm.PrecompiledContract {
 	// Clone the mapping from the latest EVM fork.
 	precompiles := maps.Clone(vm.PrecompiledContractsBerlin)
@@ -90,11 +104,31 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate bank precompile: %w", err))
 	}
 
-	govPrecompile, err := govprecompile.NewPrecompile(govKeeper, authzKeeper)
+	govPrecompile, err := govprecompile.NewPrecompile(govKeeper, authzKeeper, cdc)
 	if err != nil {
 		panic(fmt.Errorf("failed to instantiate gov precompile: %w", err))
 	}
 
+	slashingPrecompile, err := slashingprecompile.NewPrecompile(slashingKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate slashing precompile: %w", err))
+	}
+
+	authzPrecompile, err := authzprecompile.NewPrecompile(authzKeeper, authzLimiter, cdc)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate authz precompile: %w", err))
+	}
+
+	feegrantPrecompile, err := feegrantprecompile.NewPrecompile(feegrantKeeper, authzKeeper, cdc)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate feegrant precompile: %w", err))
+	}
+
+	dispatchPrecompile, err := dispatchprecompile.NewPrecompile(evmKeeper, msgRouter, authzKeeper, authzLimiter, cdc)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate dispatch precompile: %w", err))
+	}
+
 	// Stateless precompiles
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
@@ -106,6 +140,10 @@ func NewAvailableStaticPrecompiles(
 	precompiles[vestingPrecompile.Address()] = vestingPrecompile
 	precompiles[bankPrecompile.Address()] = bankPrecompile
 	precompiles[govPrecompile.Address()] = govPrecompile
+	precompiles[slashingPrecompile.Address()] = slashingPrecompile
+	precompiles[authzPrecompile.Address()] = authzPrecompile
+	precompiles[feegrantPrecompile.Address()] = feegrantPrecompile
+	precompiles[dispatchPrecompile.Address()] = dispatchPrecompile
 	return precompiles
 }
 
@@ -78,6 +78,18 @@ func New(ctx sdk.Context, keeper Keeper, txConfig TxConfig) *StateDB {
 	}
 }
 
+// TxConfig returns the configuration of the current transaction.
+func (s *StateDB) TxConfig() TxConfig {
+	return s.txConfig
+}
+
+// SetTxConfig sets the configuration of the transaction to be executed next.
+// It's used together with Finalise when the same StateDB executes several
+// transactions.
+func (s *StateDB) SetTxConfig(txConfig TxConfig) {
+	s.txConfig = txConfig
+}
+
 // Keeper returns the underlying `Keeper`
 func (s *StateDB) Keeper() Keeper {
 	return s.keeper
@@ -197,6 +209,12 @@ func (s *StateDB) GetCode(addr common.Address) []byte {
 	return nil
 }
 
+// GetDelegation returns the contract the account delegates its code to, if its
+// code is an EIP-7702 delegation designator.
+func (s *StateDB) GetDelegation(addr common.Address) (common.Address, bool) {
+	return vm.ParseDelegation(s.GetCode(addr))
+}
+
 // GetCodeSize returns the code size of account.
 func (s *StateDB) GetCodeSize(addr common.Address) int {
 	stateObject := s.getStateObject(addr)
@@ -373,6 +391,15 @@ func (s *StateDB) SubBalance(addr common.Address, amount *big.Int) {
 	}
 }
 
+// SetBalance sets the balance of the account associated with addr. The amount
+// is adjusted to the precision supported by the EVM coin.
+func (s *StateDB) SetBalance(addr common.Address, amount *big.Int) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetBalance(types.AdjustExtraDecimalsBigInt(amount))
+	}
+}
+
 // SetNonce sets the nonce of account.
 func (s *StateDB) SetNonce(addr common.Address, nonce uint64) {
 	stateObject := s.getOrNewStateObject(addr)
@@ -389,6 +416,16 @@ func (s *StateDB) SetCode(addr common.Address, code []byte) {
 	}
 }
 
+// SetDelegation sets the code of the account to the EIP-7702 delegation designator
+// of the given contract. The zero address clears the code of the account.
+func (s *StateDB) SetDelegation(addr, target common.Address) {
+	if target == (common.Address{}) {
+		s.SetCode(addr, nil)
+		return
+	}
+	s.SetCode(addr, vm.AddressToDelegation(target))
+}
+
 // SetState sets the contract state.
 func (s *StateDB) SetState(addr common.Address, key, value common.Hash) {
 	stateObject := s.getOrNewStateObject(addr)
@@ -397,6 +434,31 @@ func (s *StateDB) SetState(addr common.Address, key, value common.Hash) {
 	}
 }
 
+// SetStorage replaces the entire storage of the account associated with addr
+// with the given one. Every slot that is present in the committed state but
+// missing from the given storage is cleared.
+func (s *StateDB) SetStorage(addr common.Address, storage Storage) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject == nil {
+		return
+	}
+
+	var committedKeys []common.Hash
+	s.keeper.ForEachStorage(s.ctx, addr, func(key, _ common.Hash) bool {
+		committedKeys = append(committedKeys, key)
+		return true
+	})
+
+	for _, key := range committedKeys {
+		if _, ok := storage[key]; !ok {
+			stateObject.SetState(key, common.Hash{})
+		}
+	}
+	for _, key := range storage.SortedKeys() {
+		stateObject.SetState(key, storage[key])
+	}
+}
+
 // Suicide marks the given account as suicided.
 // This clears the account balance.
 //
@@ -514,6 +576,26 @@ func (s *StateDB) Commit() error {
 	return s.commitWithCtx(s.ctx)
 }
 
+// Finalise writes the dirty states of the current transaction to the context
+// and resets the transaction scoped fields (journal, refund, logs, access list
+// and precompile calls), so that the StateDB can execute the next transaction
+// on top of the resulting state.
+func (s *StateDB) Finalise() error {
+	if err := s.Commit(); err != nil {
+		return err
+	}
+
+	s.cacheCtx, s.writeCache = sdk.Context{}, nil
+	s.journal = newJournal()
+	s.validRevisions = s.validRevisions[:0]
+	s.stateObjects = make(map[common.Address]*stateObject)
+	s.refund = 0
+	s.logs = nil
+	s.accessList = newAccessList()
+	s.precompileCallsCounter = 0
+	return nil
+}
+
 // CommitWithCacheCtx writes the dirty states to keeper using the cacheCtx.
 // This function is used before any precompile call to make sure the cacheCtx
 // is updated with the latest changes within the tx (StateDB's journal entries).
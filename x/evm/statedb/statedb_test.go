@@ -246,6 +246,61 @@ func (suite *StateDBTestSuite) TestState() {
 	}
 }
 
+func (suite *StateDBTestSuite) TestSetStorage() {
+	key1 := common.BigToHash(big.NewInt(1))
+	key2 := common.BigToHash(big.NewInt(2))
+	value1 := common.BigToHash(big.NewInt(1))
+	value2 := common.BigToHash(big.NewInt(2))
+
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetState(address, key1, value1)
+	suite.Require().NoError(db.Commit())
+
+	// replace the committed storage, key1 must be cleared
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetStorage(address, statedb.Storage{key2: value2})
+	suite.Require().Equal(common.Hash{}, db.GetState(address, key1))
+	suite.Require().Equal(value2, db.GetState(address, key2))
+	suite.Require().NoError(db.Commit())
+
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	suite.Require().Equal(common.Hash{}, db.GetCommittedState(address, key1))
+	suite.Require().Equal(value2, db.GetCommittedState(address, key2))
+}
+
+func (suite *StateDBTestSuite) TestFinalise() {
+	key := common.BigToHash(big.NewInt(1))
+	value := common.BigToHash(big.NewInt(1))
+	txConfig := statedb.NewTxConfig(common.Hash{}, common.BigToHash(big.NewInt(1)), 1, 1)
+
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetState(address, key, value)
+	db.SetCode(address2, []byte("hello world"))
+	db.AddRefund(10)
+	db.AddLog(&ethtypes.Log{Address: address})
+	db.AddAddressToAccessList(address)
+	suite.Require().NoError(db.Finalise())
+
+	// the state of the previous transaction is committed
+	suite.Require().Equal(value, db.GetCommittedState(address, key))
+	suite.Require().Equal(value, keeper.accounts[address].states[key])
+
+	// the transaction scoped fields are reset
+	suite.Require().Zero(db.GetRefund())
+	suite.Require().Empty(db.Logs())
+	suite.Require().False(db.AddressInAccessList(address))
+
+	db.SetTxConfig(txConfig)
+	suite.Require().Equal(txConfig, db.TxConfig())
+
+	// suicided accounts don't exist on the next transaction
+	suite.Require().True(db.Suicide(address2))
+	suite.Require().NoError(db.Finalise())
+	suite.Require().False(db.Exist(address2))
+}
+
 func (suite *StateDBTestSuite) TestCode() {
 	code := []byte("hello world")
 	codeHash := crypto.Keccak256Hash(code)
@@ -287,6 +342,46 @@ func (suite *StateDBTestSuite) TestCode() {
 	}
 }
 
+func (suite *StateDBTestSuite) TestDelegation() {
+	testCases := []struct {
+		name         string
+		malleate     func(*statedb.StateDB)
+		expCode      []byte
+		expDelegated bool
+	}{
+		{"no code", func(*statedb.StateDB) {}, nil, false},
+		{"contract code", func(db *statedb.StateDB) {
+			db.SetCode(address, []byte("hello world"))
+		}, []byte("hello world"), false},
+		{"set delegation", func(db *statedb.StateDB) {
+			db.SetDelegation(address, address2)
+		}, vm.AddressToDelegation(address2), true},
+		{"clear delegation", func(db *statedb.StateDB) {
+			db.SetDelegation(address, address2)
+			db.SetDelegation(address, common.Address{})
+		}, nil, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			keeper := NewMockKeeper()
+			db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+			tc.malleate(db)
+			suite.Require().NoError(db.Commit())
+
+			db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+			// the code of the account is the delegation designator
+			suite.Require().Equal(tc.expCode, db.GetCode(address))
+
+			target, delegated := db.GetDelegation(address)
+			suite.Require().Equal(tc.expDelegated, delegated)
+			if delegated {
+				suite.Require().Equal(address2, target)
+			}
+		})
+	}
+}
+
 func (suite *StateDBTestSuite) TestRevertSnapshot() {
 	v1 := common.BigToHash(big.NewInt(1))
 	v2 := common.BigToHash(big.NewInt(2))
@@ -59,6 +59,18 @@ func (cc ChainConfig) EthereumConfig(chainID *big.Int) *geth.ChainConfig {
 	}
 }
 
+// IsPrague returns whether the given block is on or after the Prague fork, which
+// enables the EIP-7702 set code transactions. Go-ethereum doesn't support the
+// fork, so it isn't part of the EthereumConfig.
+func (cc ChainConfig) IsPrague(num *big.Int) bool {
+	block := getBlockValue(cc.PragueBlock)
+	return block != nil && num != nil && block.Cmp(num) <= 0
+}
+
+// DefaultChainConfig returns the default chain configuration, with all the forks
+// supported by go-ethereum already activated. The Prague fork isn't activated by
+// default, since enabling the set code transactions on a running chain requires
+// a coordinated upgrade.
 func DefaultChainConfig(chainID string) *ChainConfig {
 	if chainID == "" {
 		chainID = testChainID
@@ -111,6 +123,24 @@ func DefaultChainConfig(chainID string) *ChainConfig {
 	return cfg
 }
 
+// ActivatePrague activates the Prague fork at the given block on the chain
+// config used in the EVM, unless the fork is already activated. The chain
+// config isn't persisted, so the fork is activated when the upgrade enabling
+// the set code transactions is applied and each time the node starts after it.
+func ActivatePrague(block int64) error {
+	cc := GetChainConfig()
+	if cc == nil {
+		return errors.New("chainConfig not set")
+	}
+	if cc.PragueBlock != nil {
+		return nil
+	}
+
+	pragueBlock := sdkmath.NewInt(block)
+	cc.PragueBlock = &pragueBlock
+	return cc.Validate()
+}
+
 // setChainConfig allows to set the `chainConfig` variable modifying the
 // default values. The method is private because it should only be called once
 // in the EVMConfigurator.
@@ -195,6 +225,12 @@ func (cc ChainConfig) Validate() error {
 	if err := validateBlock(cc.CancunBlock); err != nil {
 		return errorsmod.Wrap(err, "CancunBlock")
 	}
+	if err := validateBlock(cc.PragueBlock); err != nil {
+		return errorsmod.Wrap(err, "PragueBlock")
+	}
+	if cc.PragueBlock != nil && (cc.CancunBlock == nil || cc.PragueBlock.LT(*cc.CancunBlock)) {
+		return errorsmod.Wrapf(ErrInvalidChainConfig, "prague block %s must be on or after the cancun block", cc.PragueBlock)
+	}
 	// NOTE: chain ID is not needed to check config order
 	if err := cc.EthereumConfig(nil).CheckConfigForkOrder(); err != nil {
 		return errorsmod.Wrap(err, "invalid config fork order")
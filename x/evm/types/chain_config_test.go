@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"math/big"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -17,6 +18,32 @@ func newIntPtr(i int64) *sdkmath.Int {
 	return &v
 }
 
+func TestChainConfigIsPrague(t *testing.T) {
+	config := types.DefaultChainConfig("")
+	require.False(t, config.IsPrague(big.NewInt(100)))
+
+	config.PragueBlock = newIntPtr(10)
+	require.False(t, config.IsPrague(big.NewInt(9)))
+	require.True(t, config.IsPrague(big.NewInt(10)))
+	require.True(t, config.IsPrague(big.NewInt(11)))
+}
+
+func TestActivatePrague(t *testing.T) {
+	configurator := types.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	defer configurator.ResetTestConfig()
+	require.NoError(t, configurator.WithChainConfig(types.DefaultChainConfig("")).Configure())
+	require.False(t, types.GetChainConfig().IsPrague(big.NewInt(100)))
+
+	require.NoError(t, types.ActivatePrague(10))
+	require.False(t, types.GetChainConfig().IsPrague(big.NewInt(9)))
+	require.True(t, types.GetChainConfig().IsPrague(big.NewInt(10)))
+
+	// the fork is not moved once activated
+	require.NoError(t, types.ActivatePrague(20))
+	require.True(t, types.GetChainConfig().IsPrague(big.NewInt(10)))
+}
+
 func TestChainConfigValidate(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -368,6 +395,106 @@ func TestChainConfigValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid PragueBlock",
+			types.ChainConfig{
+				HomesteadBlock:      newIntPtr(0),
+				DAOForkBlock:        newIntPtr(0),
+				EIP150Block:         newIntPtr(0),
+				EIP150Hash:          defaultEIP150Hash,
+				EIP155Block:         newIntPtr(0),
+				EIP158Block:         newIntPtr(0),
+				ByzantiumBlock:      newIntPtr(0),
+				ConstantinopleBlock: newIntPtr(0),
+				PetersburgBlock:     newIntPtr(0),
+				IstanbulBlock:       newIntPtr(0),
+				MuirGlacierBlock:    newIntPtr(0),
+				BerlinBlock:         newIntPtr(0),
+				LondonBlock:         newIntPtr(0),
+				ArrowGlacierBlock:   newIntPtr(0),
+				GrayGlacierBlock:    newIntPtr(0),
+				MergeNetsplitBlock:  newIntPtr(0),
+				ShanghaiBlock:       newIntPtr(0),
+				CancunBlock:         newIntPtr(10),
+				PragueBlock:         newIntPtr(10),
+			},
+			false,
+		},
+		{
+			"invalid PragueBlock",
+			types.ChainConfig{
+				HomesteadBlock:      newIntPtr(0),
+				DAOForkBlock:        newIntPtr(0),
+				EIP150Block:         newIntPtr(0),
+				EIP150Hash:          defaultEIP150Hash,
+				EIP155Block:         newIntPtr(0),
+				EIP158Block:         newIntPtr(0),
+				ByzantiumBlock:      newIntPtr(0),
+				ConstantinopleBlock: newIntPtr(0),
+				PetersburgBlock:     newIntPtr(0),
+				IstanbulBlock:       newIntPtr(0),
+				MuirGlacierBlock:    newIntPtr(0),
+				BerlinBlock:         newIntPtr(0),
+				LondonBlock:         newIntPtr(0),
+				ArrowGlacierBlock:   newIntPtr(0),
+				GrayGlacierBlock:    newIntPtr(0),
+				MergeNetsplitBlock:  newIntPtr(0),
+				ShanghaiBlock:       newIntPtr(0),
+				CancunBlock:         newIntPtr(0),
+				PragueBlock:         newIntPtr(-1),
+			},
+			true,
+		},
+		{
+			"PragueBlock before CancunBlock",
+			types.ChainConfig{
+				HomesteadBlock:      newIntPtr(0),
+				DAOForkBlock:        newIntPtr(0),
+				EIP150Block:         newIntPtr(0),
+				EIP150Hash:          defaultEIP150Hash,
+				EIP155Block:         newIntPtr(0),
+				EIP158Block:         newIntPtr(0),
+				ByzantiumBlock:      newIntPtr(0),
+				ConstantinopleBlock: newIntPtr(0),
+				PetersburgBlock:     newIntPtr(0),
+				IstanbulBlock:       newIntPtr(0),
+				MuirGlacierBlock:    newIntPtr(0),
+				BerlinBlock:         newIntPtr(0),
+				LondonBlock:         newIntPtr(0),
+				ArrowGlacierBlock:   newIntPtr(0),
+				GrayGlacierBlock:    newIntPtr(0),
+				MergeNetsplitBlock:  newIntPtr(0),
+				ShanghaiBlock:       newIntPtr(0),
+				CancunBlock:         newIntPtr(10),
+				PragueBlock:         newIntPtr(5),
+			},
+			true,
+		},
+		{
+			"PragueBlock without CancunBlock",
+			types.ChainConfig{
+				HomesteadBlock:      newIntPtr(0),
+				DAOForkBlock:        newIntPtr(0),
+				EIP150Block:         newIntPtr(0),
+				EIP150Hash:          defaultEIP150Hash,
+				EIP155Block:         newIntPtr(0),
+				EIP158Block:         newIntPtr(0),
+				ByzantiumBlock:      newIntPtr(0),
+				ConstantinopleBlock: newIntPtr(0),
+				PetersburgBlock:     newIntPtr(0),
+				IstanbulBlock:       newIntPtr(0),
+				MuirGlacierBlock:    newIntPtr(0),
+				BerlinBlock:         newIntPtr(0),
+				LondonBlock:         newIntPtr(0),
+				ArrowGlacierBlock:   newIntPtr(0),
+				GrayGlacierBlock:    newIntPtr(0),
+				MergeNetsplitBlock:  newIntPtr(0),
+				ShanghaiBlock:       newIntPtr(0),
+				CancunBlock:         nil,
+				PragueBlock:         newIntPtr(5),
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -25,7 +25,10 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "ethermint/MsgUpdateParams"
+	updateParamsName         = "ethermint/MsgUpdateParams"
+	setFeeSponsorshipName    = "ethermint/MsgSetFeeSponsorship"
+	revokeFeeSponsorshipName = "ethermint/MsgRevokeFeeSponsorship"
+	setFeeSponsorName        = "ethermint/MsgSetFeeSponsor"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -44,11 +47,15 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgSetFeeSponsorship{},
+		&MsgRevokeFeeSponsorship{},
+		&MsgSetFeeSponsor{},
 	)
 	registry.RegisterInterface(
 		"ethermint.evm.v1.TxData",
 		(*TxData)(nil),
 		&DynamicFeeTx{},
+		&SetCodeTx{},
 		&AccessListTx{},
 		&LegacyTx{},
 	)
@@ -91,4 +98,7 @@ func UnpackTxData(anyTxData *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgSetFeeSponsorship{}, setFeeSponsorshipName, nil)
+	cdc.RegisterConcrete(&MsgRevokeFeeSponsorship{}, revokeFeeSponsorshipName, nil)
+	cdc.RegisterConcrete(&MsgSetFeeSponsor{}, setFeeSponsorName, nil)
 }
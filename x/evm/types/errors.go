@@ -32,6 +32,11 @@ const (
 	codeErrInactivePrecompile
 	codeErrABIPack
 	codeErrABIUnpack
+	codeErrInvalidAuthorization
+	codeErrFeeSponsorshipNotFound
+	codeErrFeeSponsorshipNotAllowed
+	codeErrPostTxProcessing
+	codeErrSetCodeTxNotActivated
 )
 
 var (
@@ -88,6 +93,21 @@ var (
 
 	// ErrABIUnpack returns an error if the contract ABI unpacking fails
 	ErrABIUnpack = errorsmod.Register(ModuleName, codeErrABIUnpack, "contract ABI unpack failed")
+
+	// ErrInvalidAuthorization returns an error if an EIP-7702 authorization is invalid
+	ErrInvalidAuthorization = errorsmod.Register(ModuleName, codeErrInvalidAuthorization, "invalid set code authorization")
+
+	// ErrFeeSponsorshipNotFound returns an error if the sponsor doesn't have a fee sponsorship
+	ErrFeeSponsorshipNotFound = errorsmod.Register(ModuleName, codeErrFeeSponsorshipNotFound, "fee sponsorship not found")
+
+	// ErrFeeSponsorshipNotAllowed returns an error if a transaction can't be sponsored by the fee sponsorship
+	ErrFeeSponsorshipNotAllowed = errorsmod.Register(ModuleName, codeErrFeeSponsorshipNotAllowed, "fee sponsorship not allowed")
+
+	// ErrPostTxProcessing returns an error if the post processing hooks of a transaction failed
+	ErrPostTxProcessing = errorsmod.Register(ModuleName, codeErrPostTxProcessing, "failed to execute post processing")
+
+	// ErrSetCodeTxNotActivated returns an error if an EIP-7702 set code transaction is sent before the Prague fork
+	ErrSetCodeTxNotActivated = errorsmod.Register(ModuleName, codeErrSetCodeTxNotActivated, "set code transactions are not activated")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
@@ -6,18 +6,24 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -71,6 +77,12 @@ type Params struct {
 	// active_static_precompiles defines the slice of hex addresses of the precompiled
 	// contracts that are active
 	ActiveStaticPrecompiles []string `protobuf:"bytes,10,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
+	// allowed_dispatch_msgs defines the type URLs of the Cosmos messages that can
+	// be dispatched by the dispatch precompiled contract
+	AllowedDispatchMsgs []string `protobuf:"bytes,11,rep,name=allowed_dispatch_msgs,json=allowedDispatchMsgs,proto3" json:"allowed_dispatch_msgs,omitempty"`
+	// max_callback_gas defines the gas limit of the contract calls made by the
+	// IBC callbacks middleware
+	MaxCallbackGas uint64 `protobuf:"varint,12,opt,name=max_callback_gas,json=maxCallbackGas,proto3" json:"max_callback_gas,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -141,6 +153,20 @@ func (m *Params) GetActiveStaticPrecompiles() []string {
 	return nil
 }
 
+func (m *Params) GetAllowedDispatchMsgs() []string {
+	if m != nil {
+		return m.AllowedDispatchMsgs
+	}
+	return nil
+}
+
+func (m *Params) GetMaxCallbackGas() uint64 {
+	if m != nil {
+		return m.MaxCallbackGas
+	}
+	return 0
+}
+
 // AccessControl defines the permission policy of the EVM
 // for creating and calling contracts
 type AccessControl struct {
@@ -303,6 +329,9 @@ type ChainConfig struct {
 	Denom string `protobuf:"bytes,25,opt,name=denom,proto3" json:"denom,omitempty"`
 	// decimals is the real decimal precision of the denomination used on the EVM
 	Decimals uint64 `protobuf:"varint,26,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// prague_block switch block (nil = no fork, 0 = already on prague). It activates
+	// the EIP-7702 set code transactions.
+	PragueBlock *cosmossdk_io_math.Int `protobuf:"bytes,27,opt,name=prague_block,json=pragueBlock,proto3,customtype=cosmossdk.io/math.Int" json:"prague_block,omitempty" yaml:"prague_block"`
 }
 
 func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
@@ -728,6 +757,9 @@ type TraceConfig struct {
 	EnableReturnData bool `protobuf:"varint,12,opt,name=enable_return_data,json=enableReturnData,proto3" json:"enableReturnData"`
 	// tracer_json_config configures the tracer using a JSON string
 	TracerJsonConfig string `protobuf:"bytes,13,opt,name=tracer_json_config,json=tracerJsonConfig,proto3" json:"tracerConfig"`
+	// enable_precompile_frames switches the capture of the decoded method, arguments
+	// and Cosmos events of the precompile calls on the callTracer frames
+	EnablePrecompileFrames bool `protobuf:"varint,14,opt,name=enable_precompile_frames,json=enablePrecompileFrames,proto3" json:"enablePrecompileFrames"`
 }
 
 func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
@@ -840,6 +872,101 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 	return ""
 }
 
+func (m *TraceConfig) GetEnablePrecompileFrames() bool {
+	if m != nil {
+		return m.EnablePrecompileFrames
+	}
+	return false
+}
+
+// FeeSponsorship defines an allowance granted by a sponsor account to pay the
+// fees of the EVM transactions within its scope. An empty list in the scope
+// doesn't restrict the transactions on that field.
+type FeeSponsorship struct {
+	// spend_limit is the remaining amount of fees the sponsor is willing to pay.
+	// It must be positive, the sponsorship is removed once it's exhausted.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// expiration is the time after which the sponsorship can't be used anymore
+	Expiration *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// contracts defines the hex addresses of the called contracts
+	Contracts []string `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	// senders defines the hex addresses of the transaction senders
+	Senders []string `protobuf:"bytes,4,rep,name=senders,proto3" json:"senders,omitempty"`
+	// selectors defines the hex encoded 4-byte function selectors of the calls
+	Selectors []string `protobuf:"bytes,5,rep,name=selectors,proto3" json:"selectors,omitempty"`
+	// max_fee_per_gas is the highest fee per gas, in the EVM denom with 18
+	// decimals, of the sponsored transactions
+	MaxFeePerGas cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=max_fee_per_gas,json=maxFeePerGas,proto3,customtype=cosmossdk.io/math.Int" json:"max_fee_per_gas"`
+}
+
+func (m *FeeSponsorship) Reset()         { *m = FeeSponsorship{} }
+func (m *FeeSponsorship) String() string { return proto.CompactTextString(m) }
+func (*FeeSponsorship) ProtoMessage()    {}
+func (*FeeSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{10}
+}
+func (m *FeeSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSponsorship.Merge(m, src)
+}
+func (m *FeeSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSponsorship proto.InternalMessageInfo
+
+func (m *FeeSponsorship) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *FeeSponsorship) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *FeeSponsorship) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *FeeSponsorship) GetSenders() []string {
+	if m != nil {
+		return m.Senders
+	}
+	return nil
+}
+
+func (m *FeeSponsorship) GetSelectors() []string {
+	if m != nil {
+		return m.Selectors
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ethermint.evm.v1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
@@ -852,133 +979,155 @@ func init() {
 	proto.RegisterType((*TxResult)(nil), "ethermint.evm.v1.TxResult")
 	proto.RegisterType((*AccessTuple)(nil), "ethermint.evm.v1.AccessTuple")
 	proto.RegisterType((*TraceConfig)(nil), "ethermint.evm.v1.TraceConfig")
+	proto.RegisterType((*FeeSponsorship)(nil), "ethermint.evm.v1.FeeSponsorship")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1932 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xcd, 0x6e, 0x23, 0xc7,
-	0x11, 0x16, 0xa5, 0x91, 0x34, 0x6c, 0x52, 0xe4, 0xa8, 0xf5, 0xb3, 0x5c, 0xae, 0xa3, 0x51, 0x26,
-	0x41, 0xa0, 0x2c, 0x1c, 0x69, 0x57, 0x6b, 0x25, 0xc2, 0x3a, 0x7f, 0xa2, 0x96, 0x4e, 0xc4, 0x68,
-	0xd7, 0x42, 0x53, 0x8e, 0xe1, 0x20, 0xc1, 0xa0, 0x39, 0xd3, 0x26, 0xc7, 0x9a, 0x99, 0x26, 0xa6,
-	0x9b, 0x5c, 0x32, 0x4f, 0x60, 0xec, 0xc9, 0x79, 0x80, 0x0d, 0x0c, 0xe4, 0x92, 0xa3, 0x1f, 0x21,
-	0x47, 0xc3, 0x27, 0x1f, 0x83, 0x00, 0x19, 0x04, 0xdc, 0x83, 0x01, 0x1d, 0xf5, 0x04, 0x41, 0xff,
-	0xf0, 0x57, 0x5a, 0x45, 0xb9, 0x50, 0x5d, 0xd5, 0x55, 0xdf, 0x57, 0x55, 0x5d, 0x3d, 0xdd, 0x2d,
-	0x50, 0x26, 0xbc, 0x45, 0x92, 0x28, 0x88, 0xf9, 0x1e, 0xe9, 0x46, 0x7b, 0xdd, 0xc7, 0xe2, 0xcf,
-	0x6e, 0x3b, 0xa1, 0x9c, 0x42, 0x6b, 0x34, 0xb7, 0x2b, 0x94, 0xdd, 0xc7, 0xe5, 0x55, 0x1c, 0x05,
-	0x31, 0xdd, 0x93, 0xbf, 0xca, 0xa8, 0xbc, 0xde, 0xa4, 0x4d, 0x2a, 0x87, 0x7b, 0x62, 0xa4, 0xb4,
-	0xce, 0x5f, 0x17, 0xc0, 0xd2, 0x19, 0x4e, 0x70, 0xc4, 0xe0, 0x11, 0x00, 0xa4, 0xc7, 0x13, 0xec,
-	0x92, 0xa0, 0xcd, 0x4a, 0xc6, 0xf6, 0xc2, 0x4e, 0xb6, 0xe2, 0x0c, 0x52, 0x3b, 0x5b, 0x15, 0xda,
-	0xea, 0xc9, 0x19, 0xbb, 0x4a, 0xed, 0xd5, 0x3e, 0x8e, 0xc2, 0xa7, 0xce, 0xd8, 0xd0, 0x41, 0x59,
-	0x29, 0x54, 0x83, 0x36, 0x83, 0xfb, 0x60, 0x03, 0x87, 0x21, 0x7d, 0xe9, 0x76, 0x62, 0x01, 0x4f,
-	0x3c, 0x4e, 0x7c, 0x97, 0xf7, 0x58, 0x69, 0x69, 0x3b, 0xb3, 0x63, 0xa2, 0x35, 0x39, 0xf9, 0xd1,
-	0x78, 0xee, 0xbc, 0x27, 0x7c, 0xf2, 0xa4, 0x1b, 0xb9, 0x5e, 0x0b, 0xc7, 0x31, 0x09, 0x59, 0xc9,
-	0x94, 0xc4, 0xc5, 0x41, 0x6a, 0xe7, 0xaa, 0xbf, 0x7f, 0x7e, 0xac, 0xd5, 0x28, 0x47, 0xba, 0xd1,
-	0x50, 0x80, 0x7f, 0x02, 0x05, 0xec, 0x79, 0x84, 0x31, 0xd7, 0xa3, 0x31, 0x4f, 0x68, 0x58, 0xca,
-	0x6e, 0x67, 0x76, 0x72, 0xfb, 0xf6, 0xee, 0x6c, 0x25, 0x76, 0x8f, 0xa4, 0xdd, 0xb1, 0x32, 0xab,
-	0x6c, 0x7c, 0x9d, 0xda, 0x73, 0x83, 0xd4, 0x5e, 0x99, 0x52, 0xa3, 0x15, 0x3c, 0x29, 0xc2, 0xa7,
-	0xe0, 0x3e, 0xf6, 0x78, 0xd0, 0x25, 0x2e, 0xe3, 0x98, 0x07, 0x9e, 0xdb, 0x4e, 0x88, 0x47, 0xa3,
-	0x76, 0x10, 0x12, 0x56, 0x02, 0x22, 0x3e, 0x74, 0x4f, 0x19, 0xd4, 0xe5, 0xfc, 0xd9, 0x78, 0xfa,
-	0xe9, 0xbd, 0x57, 0xdf, 0x7d, 0xf5, 0x10, 0x92, 0x6e, 0x44, 0xd9, 0x5e, 0x4f, 0x2e, 0x95, 0x2a,
-	0x6f, 0xcd, 0x30, 0x33, 0xd6, 0x7c, 0xcd, 0x30, 0xe7, 0xad, 0x85, 0x9a, 0x61, 0x2e, 0x58, 0x46,
-	0xcd, 0x30, 0x17, 0xad, 0xa5, 0x9a, 0x61, 0x2e, 0x5b, 0x26, 0xca, 0x8a, 0x1a, 0xf8, 0x24, 0xa6,
-	0x11, 0xca, 0x7b, 0x2d, 0x1c, 0xc4, 0x22, 0xb3, 0x4f, 0x83, 0xa6, 0xf3, 0x97, 0x0c, 0x98, 0x0e,
-	0x16, 0x1e, 0x81, 0x25, 0x2f, 0x21, 0x98, 0x93, 0x52, 0x46, 0x26, 0xfd, 0x83, 0xff, 0x91, 0xf4,
-	0x79, 0xbf, 0x4d, 0x2a, 0x86, 0x48, 0x1c, 0x69, 0x47, 0xf8, 0x0b, 0x60, 0x78, 0x38, 0x0c, 0x4b,
-	0xf3, 0xff, 0x2f, 0x80, 0x74, 0x73, 0xfe, 0x9d, 0x01, 0xab, 0xd7, 0x2c, 0xa0, 0x07, 0x72, 0x7a,
-	0x51, 0x78, 0xbf, 0xad, 0x82, 0x2b, 0xec, 0xbf, 0xf3, 0x36, 0x6c, 0x09, 0xfa, 0xc3, 0x41, 0x6a,
-	0x83, 0xb1, 0x7c, 0x95, 0xda, 0x50, 0xf5, 0xd7, 0x04, 0x90, 0x83, 0x00, 0x1e, 0x59, 0x40, 0x0f,
-	0xac, 0x4d, 0xaf, 0xbc, 0x1b, 0x06, 0x8c, 0x97, 0xe6, 0x65, 0xd3, 0x3c, 0x19, 0xa4, 0xf6, 0x74,
-	0x60, 0xa7, 0x01, 0xe3, 0x57, 0xa9, 0x5d, 0x9e, 0x42, 0x9d, 0xf4, 0x74, 0xd0, 0x2a, 0x9e, 0x75,
-	0x70, 0xbe, 0x29, 0x82, 0xdc, 0xb1, 0x58, 0x84, 0x63, 0xb9, 0x06, 0xf0, 0x8f, 0xa0, 0xd8, 0xa2,
-	0x11, 0x61, 0x9c, 0x60, 0xdf, 0x6d, 0x84, 0xd4, 0xbb, 0x90, 0xd9, 0x65, 0x2b, 0x4f, 0xfe, 0x95,
-	0xda, 0x1b, 0x1e, 0x65, 0x11, 0x65, 0xcc, 0xbf, 0xd8, 0x0d, 0xe8, 0x5e, 0x84, 0x79, 0x6b, 0xf7,
-	0x24, 0x16, 0xa4, 0x9b, 0x8a, 0x74, 0xc6, 0xd3, 0x41, 0x85, 0x91, 0xa6, 0x22, 0x14, 0xb0, 0x05,
-	0x0a, 0x3e, 0xa6, 0xee, 0xa7, 0x34, 0xb9, 0xd0, 0xe0, 0xf3, 0x12, 0xbc, 0xf2, 0x56, 0xf0, 0x41,
-	0x6a, 0xe7, 0x9f, 0x1d, 0x7d, 0xf8, 0x01, 0x4d, 0x2e, 0x24, 0xc4, 0x55, 0x6a, 0x6f, 0x28, 0xb2,
-	0x69, 0x20, 0x07, 0xe5, 0x7d, 0x4c, 0x47, 0x66, 0xf0, 0x63, 0x60, 0x8d, 0x0c, 0x58, 0xa7, 0xdd,
-	0xa6, 0x09, 0x2f, 0x2d, 0x88, 0x9d, 0x59, 0xf9, 0xc9, 0x20, 0xb5, 0x0b, 0x1a, 0xb2, 0xae, 0x66,
-	0xae, 0x52, 0xfb, 0xde, 0x0c, 0xa8, 0xf6, 0x71, 0x50, 0x41, 0xc3, 0x6a, 0x53, 0xd8, 0x00, 0x79,
-	0x12, 0xb4, 0x1f, 0x1f, 0x3c, 0xd2, 0x09, 0x18, 0x32, 0x81, 0x5f, 0xdd, 0x96, 0x40, 0xae, 0x7a,
-	0x72, 0xf6, 0xf8, 0xe0, 0xd1, 0x30, 0xfe, 0x35, 0xfd, 0x5d, 0x99, 0x40, 0x71, 0x50, 0x4e, 0x89,
-	0x2a, 0xf8, 0x13, 0xa0, 0x45, 0xb7, 0x85, 0x59, 0xab, 0xb4, 0x28, 0x29, 0x76, 0x44, 0x03, 0x29,
-	0xa4, 0xdf, 0x62, 0xd6, 0x1a, 0x57, 0xbd, 0xd1, 0xff, 0x33, 0x8e, 0x79, 0xd0, 0x89, 0x86, 0x58,
-	0x40, 0x39, 0x0b, 0xab, 0x51, 0xb8, 0x07, 0x3a, 0xdc, 0xa5, 0xbb, 0x86, 0x7b, 0x70, 0x53, 0xb8,
-	0x07, 0xd3, 0xe1, 0x2a, 0x9b, 0x11, 0xc7, 0xa1, 0xe6, 0x58, 0xbe, 0x2b, 0xc7, 0xe1, 0x4d, 0x1c,
-	0x87, 0xd3, 0x1c, 0xca, 0x46, 0xf4, 0xe5, 0x4c, 0x9e, 0x25, 0xf3, 0xce, 0x7d, 0x79, 0xad, 0x42,
-	0x85, 0x91, 0x46, 0xa1, 0x5f, 0x80, 0x75, 0x8f, 0xc6, 0x8c, 0x0b, 0x5d, 0x4c, 0xdb, 0x21, 0xd1,
-	0x14, 0x59, 0x49, 0x71, 0x78, 0x1b, 0xc5, 0x03, 0x45, 0x71, 0x93, 0xbb, 0x83, 0xd6, 0xa6, 0xd5,
-	0x8a, 0xcc, 0x05, 0x56, 0x9b, 0x70, 0x92, 0xb0, 0x46, 0x27, 0x69, 0x6a, 0x22, 0x20, 0x89, 0xde,
-	0xbb, 0x8d, 0x48, 0x77, 0xe8, 0xac, 0xab, 0x83, 0x8a, 0x63, 0x95, 0x22, 0xf8, 0x04, 0x14, 0x02,
-	0xc1, 0xda, 0xe8, 0x84, 0x1a, 0x3e, 0x27, 0xe1, 0xf7, 0x6f, 0x83, 0xd7, 0xbb, 0x6a, 0xda, 0xd1,
-	0x41, 0x2b, 0x43, 0x85, 0x82, 0xf6, 0x01, 0x8c, 0x3a, 0x41, 0xe2, 0x36, 0x43, 0xec, 0x05, 0x24,
-	0xd1, 0xf0, 0x79, 0x09, 0xff, 0xd3, 0xdb, 0xe0, 0xef, 0x2b, 0xf8, 0xeb, 0xce, 0x0e, 0xb2, 0x84,
-	0xf2, 0x37, 0x4a, 0xa7, 0x58, 0xea, 0x20, 0xdf, 0x20, 0x49, 0x18, 0xc4, 0x1a, 0x7f, 0x45, 0xe2,
-	0x3f, 0xba, 0x0d, 0x5f, 0x77, 0xd0, 0xa4, 0x9b, 0x83, 0x72, 0x4a, 0x1c, 0x81, 0x86, 0x34, 0xf6,
-	0xe9, 0x10, 0x74, 0xf5, 0xce, 0xa0, 0x93, 0x6e, 0x0e, 0xca, 0x29, 0x51, 0x81, 0x36, 0xc1, 0x1a,
-	0x4e, 0x12, 0xfa, 0x72, 0xa6, 0x20, 0x50, 0x62, 0xff, 0xec, 0x36, 0xec, 0xe1, 0x77, 0xfa, 0xba,
-	0xb7, 0xf8, 0x4e, 0x0b, 0xed, 0x54, 0x49, 0x7c, 0x00, 0x9b, 0x09, 0xee, 0xcf, 0xf0, 0xac, 0xdf,
-	0xb9, 0xf0, 0xd7, 0x9d, 0x1d, 0x64, 0x09, 0xe5, 0x14, 0xcb, 0x67, 0x60, 0x3d, 0x22, 0x49, 0x93,
-	0xb8, 0x31, 0xe1, 0xac, 0x1d, 0x06, 0x5c, 0xf3, 0x6c, 0xdc, 0x79, 0x1f, 0xdc, 0xe4, 0xee, 0x20,
-	0x28, 0xd5, 0x2f, 0xb4, 0x76, 0xd4, 0xa5, 0xac, 0x85, 0xe3, 0x66, 0x0b, 0x07, 0x9a, 0x65, 0xf3,
-	0xce, 0x5d, 0x3a, 0xed, 0xe8, 0xa0, 0x95, 0xa1, 0x62, 0xb4, 0xd4, 0x1e, 0x8e, 0xbd, 0xce, 0x70,
-	0xa9, 0xef, 0xdd, 0x79, 0xa9, 0x27, 0xdd, 0x1c, 0x94, 0x53, 0xa2, 0x02, 0xbd, 0x0f, 0x4c, 0x75,
-	0x5b, 0x09, 0xfc, 0x52, 0x69, 0x3b, 0xb3, 0x63, 0xa0, 0x65, 0x29, 0x9f, 0xf8, 0x70, 0x1d, 0x2c,
-	0xca, 0xfb, 0x4c, 0xe9, 0xbe, 0x20, 0x42, 0x4a, 0x80, 0x65, 0x60, 0xfa, 0xc4, 0x0b, 0x22, 0x1c,
-	0xb2, 0x52, 0x59, 0x3a, 0x8c, 0xe4, 0x9a, 0x61, 0x16, 0xac, 0x62, 0xcd, 0x30, 0x8b, 0x96, 0x55,
-	0x33, 0x4c, 0xcb, 0x5a, 0xad, 0x19, 0xe6, 0x9a, 0xb5, 0x8e, 0x56, 0xfa, 0x34, 0xa4, 0x6e, 0xf7,
-	0x89, 0x8a, 0x00, 0xe5, 0xc8, 0x4b, 0xcc, 0xf4, 0x57, 0x0b, 0x15, 0x3c, 0xcc, 0x71, 0xd8, 0x67,
-	0xba, 0xaa, 0xc8, 0x52, 0xb5, 0x9e, 0x38, 0x03, 0xf7, 0xc0, 0xa2, 0xb8, 0xa5, 0x11, 0x68, 0x81,
-	0x85, 0x0b, 0xd2, 0x57, 0x27, 0x37, 0x12, 0x43, 0x11, 0x62, 0x17, 0x87, 0x1d, 0xa2, 0x0e, 0x5c,
-	0xa4, 0x04, 0xe7, 0x0c, 0x14, 0xcf, 0x13, 0x1c, 0x33, 0x71, 0xc3, 0xa3, 0xf1, 0x29, 0x6d, 0x32,
-	0x08, 0x81, 0x21, 0x0f, 0x1d, 0xe5, 0x2b, 0xc7, 0xf0, 0xc7, 0xc0, 0x08, 0x69, 0x93, 0xc9, 0xab,
-	0x47, 0x6e, 0x7f, 0xe3, 0xfa, 0x3d, 0xe7, 0x94, 0x36, 0x91, 0x34, 0x71, 0xbe, 0x99, 0x07, 0x0b,
-	0xa7, 0xb4, 0x09, 0x4b, 0x60, 0x19, 0xfb, 0x7e, 0x42, 0x18, 0xd3, 0x48, 0x43, 0x11, 0x6e, 0x82,
-	0x25, 0x4e, 0xdb, 0x81, 0xa7, 0xe0, 0xb2, 0x48, 0x4b, 0x82, 0xd8, 0xc7, 0x1c, 0xcb, 0x53, 0x3a,
-	0x8f, 0xe4, 0x58, 0x5c, 0x98, 0x65, 0x66, 0x6e, 0xdc, 0x89, 0x1a, 0x24, 0x91, 0x87, 0xad, 0x51,
-	0x29, 0x5e, 0xa6, 0x76, 0x4e, 0xea, 0x5f, 0x48, 0x35, 0x9a, 0x14, 0xe0, 0xbb, 0x60, 0x99, 0xf7,
-	0x26, 0x0f, 0xce, 0xb5, 0xcb, 0xd4, 0x2e, 0xf2, 0x71, 0x9a, 0xe2, 0x5c, 0x44, 0x4b, 0xbc, 0x27,
-	0xcf, 0xc7, 0x3d, 0x60, 0xf2, 0x9e, 0x1b, 0xc4, 0x3e, 0xe9, 0xc9, 0xb3, 0xd1, 0xa8, 0xac, 0x5f,
-	0xa6, 0xb6, 0x35, 0x61, 0x7e, 0x22, 0xe6, 0xd0, 0x32, 0xef, 0xc9, 0x01, 0x7c, 0x17, 0x00, 0x15,
-	0x92, 0x64, 0x50, 0x47, 0xdd, 0xca, 0x65, 0x6a, 0x67, 0xa5, 0x56, 0x62, 0x8f, 0x87, 0xd0, 0x01,
-	0x8b, 0x0a, 0xdb, 0x94, 0xd8, 0xf9, 0xcb, 0xd4, 0x36, 0x43, 0xda, 0x54, 0x98, 0x6a, 0x4a, 0x94,
-	0x2a, 0x21, 0x11, 0xed, 0x12, 0x5f, 0x9e, 0x37, 0x26, 0x1a, 0x8a, 0xce, 0x17, 0xf3, 0xc0, 0x3c,
-	0xef, 0x21, 0xc2, 0x3a, 0x21, 0x87, 0x1f, 0x00, 0x4b, 0xde, 0xe6, 0xb0, 0xc7, 0xdd, 0xa9, 0xd2,
-	0x56, 0x1e, 0x8c, 0x4f, 0x87, 0x59, 0x0b, 0x07, 0x15, 0x87, 0xaa, 0x23, 0x5d, 0xff, 0x75, 0xb0,
-	0xd8, 0x08, 0x29, 0x8d, 0x64, 0x27, 0xe4, 0x91, 0x12, 0xe0, 0xc7, 0xb2, 0x6a, 0x72, 0x95, 0x17,
-	0xe4, 0x4d, 0xf9, 0xfb, 0xd7, 0x57, 0x79, 0xa6, 0x55, 0x2a, 0x0f, 0xc4, 0x3d, 0xf9, 0x2a, 0xb5,
-	0x0b, 0x8a, 0x5b, 0xfb, 0x3b, 0x7f, 0xff, 0xee, 0xab, 0x87, 0x19, 0x51, 0x60, 0xd9, 0x4f, 0x16,
-	0x58, 0x48, 0x08, 0x97, 0x2b, 0x97, 0x47, 0x62, 0x28, 0xf6, 0x45, 0x42, 0xba, 0x24, 0xe1, 0xc4,
-	0x97, 0x2b, 0x64, 0xa2, 0x91, 0x2c, 0x36, 0x59, 0x13, 0x33, 0xb7, 0xc3, 0x88, 0xaf, 0x96, 0x03,
-	0x2d, 0x37, 0x31, 0xfb, 0x88, 0x11, 0xff, 0xa9, 0xf1, 0xf9, 0x97, 0xf6, 0x9c, 0x83, 0x41, 0x4e,
-	0x5f, 0xa2, 0x3b, 0xed, 0x90, 0xdc, 0xd2, 0x66, 0xfb, 0x20, 0xcf, 0x38, 0x4d, 0x70, 0x93, 0xb8,
-	0x17, 0xa4, 0xaf, 0x9b, 0x4d, 0xb5, 0x8e, 0xd6, 0xff, 0x8e, 0xf4, 0x19, 0x9a, 0x14, 0x34, 0xc5,
-	0x97, 0x06, 0xc8, 0x9d, 0x27, 0xd8, 0x23, 0xfa, 0x4a, 0x2c, 0x1a, 0x56, 0x88, 0x89, 0xa6, 0xd0,
-	0x92, 0xe0, 0xe6, 0x41, 0x44, 0x68, 0x87, 0xeb, 0x4d, 0x35, 0x14, 0x85, 0x47, 0x42, 0x48, 0x8f,
-	0x78, 0xb2, 0x96, 0x06, 0xd2, 0x12, 0x3c, 0x00, 0x2b, 0x7e, 0xc0, 0x70, 0x23, 0x94, 0xaf, 0x2d,
-	0xef, 0x42, 0xa5, 0x5f, 0xb1, 0x2e, 0x53, 0x3b, 0xaf, 0x27, 0xea, 0x42, 0x8f, 0xa6, 0x24, 0xf8,
-	0x3e, 0x28, 0x8e, 0xdd, 0x64, 0xb4, 0xea, 0x91, 0x59, 0x81, 0x97, 0xa9, 0x5d, 0x18, 0x99, 0xca,
-	0x19, 0x34, 0x23, 0xab, 0x6f, 0x53, 0xa3, 0xd3, 0x94, 0x1d, 0x68, 0x22, 0x25, 0x08, 0x6d, 0x18,
-	0x44, 0x01, 0x97, 0x1d, 0xb7, 0x88, 0x94, 0x00, 0xdf, 0x07, 0x59, 0xda, 0x25, 0x49, 0x12, 0xf8,
-	0xf2, 0xf1, 0x27, 0xda, 0xe0, 0x7b, 0xd7, 0xdb, 0x60, 0xe2, 0xb9, 0x80, 0xc6, 0xf6, 0x22, 0x39,
-	0x12, 0xcb, 0x20, 0x23, 0x12, 0xd1, 0xa4, 0x2f, 0x2f, 0x1d, 0x3a, 0x39, 0x35, 0xf1, 0x5c, 0xea,
-	0xd1, 0x94, 0x04, 0x2b, 0x00, 0x6a, 0xb7, 0x84, 0xf0, 0x4e, 0x12, 0xbb, 0xf2, 0x23, 0x90, 0x97,
-	0xbe, 0x72, 0x2b, 0xaa, 0x59, 0x24, 0x27, 0x9f, 0x61, 0x8e, 0xd1, 0x35, 0x0d, 0xfc, 0x25, 0x80,
-	0x6a, 0x4d, 0xdc, 0xcf, 0x18, 0x1d, 0x3e, 0x27, 0xf5, 0xad, 0x41, 0xf2, 0xab, 0x59, 0x1d, 0xb3,
-	0xa5, 0xa4, 0x1a, 0xa3, 0x3a, 0x8b, 0x9a, 0x61, 0x1a, 0xd6, 0xa2, 0x7e, 0x9d, 0x0e, 0xeb, 0xa7,
-	0xb3, 0x40, 0x6b, 0x43, 0x79, 0x22, 0xbc, 0x87, 0xff, 0xc8, 0x80, 0x89, 0xb7, 0x1c, 0xfc, 0x39,
-	0x28, 0x1f, 0x1d, 0x1f, 0x57, 0xeb, 0x75, 0xf7, 0xfc, 0x93, 0xb3, 0xaa, 0x7b, 0x56, 0x45, 0xcf,
-	0x4f, 0xea, 0xf5, 0x93, 0x0f, 0x5f, 0x9c, 0x56, 0xeb, 0x75, 0x6b, 0xae, 0xfc, 0xce, 0xab, 0xd7,
-	0xdb, 0xa5, 0xb1, 0xfd, 0x99, 0xa8, 0x27, 0x63, 0x01, 0x8d, 0x43, 0xd1, 0xa9, 0xef, 0x81, 0xcd,
-	0x49, 0x6f, 0x54, 0xad, 0x9f, 0xa3, 0x93, 0xe3, 0xf3, 0xea, 0x33, 0x2b, 0x53, 0x2e, 0xbd, 0x7a,
-	0xbd, 0xbd, 0x3e, 0xf6, 0x44, 0x84, 0xf1, 0x24, 0xf0, 0xc4, 0x4e, 0x39, 0x04, 0xa5, 0x9b, 0x39,
-	0xab, 0xcf, 0xac, 0xf9, 0x72, 0xf9, 0xd5, 0xeb, 0xed, 0xcd, 0x9b, 0x18, 0x89, 0x5f, 0x36, 0x3e,
-	0xff, 0xdb, 0xd6, 0x5c, 0xe5, 0xd7, 0x5f, 0x0f, 0xb6, 0x32, 0xdf, 0x0e, 0xb6, 0x32, 0xff, 0x19,
-	0x6c, 0x65, 0xbe, 0x78, 0xb3, 0x35, 0xf7, 0xed, 0x9b, 0xad, 0xb9, 0x7f, 0xbe, 0xd9, 0x9a, 0xfb,
-	0xc3, 0x8f, 0x9a, 0x01, 0x6f, 0x75, 0x1a, 0xbb, 0x1e, 0x8d, 0xf6, 0xd4, 0xe3, 0x5e, 0xfd, 0x76,
-	0xf7, 0x1f, 0xe9, 0x67, 0xbe, 0x78, 0xab, 0xb2, 0xc6, 0x92, 0xfc, 0xb7, 0xca, 0x93, 0xff, 0x06,
-	0x00, 0x00, 0xff, 0xff, 0x1d, 0xfd, 0x0a, 0x4c, 0xaf, 0x11, 0x00, 0x00,
+	// 2268 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0xcd, 0x6f, 0xe3, 0xc6,
+	0x15, 0xb7, 0x6c, 0xda, 0x96, 0x47, 0xb2, 0x4c, 0x8f, 0x3f, 0x96, 0xab, 0x4d, 0x4c, 0x97, 0x2d,
+	0x0a, 0x77, 0x91, 0x48, 0x6b, 0x6f, 0xdc, 0x2e, 0x36, 0xfd, 0x88, 0x65, 0xcb, 0xa9, 0x5d, 0x6f,
+	0x62, 0x8c, 0x9c, 0x06, 0x29, 0x5a, 0x10, 0x23, 0x72, 0x96, 0x62, 0x4c, 0x72, 0x08, 0x0e, 0xa5,
+	0x48, 0x3d, 0xf6, 0x14, 0xec, 0x69, 0x7b, 0x2d, 0xb0, 0x68, 0x81, 0x5e, 0x8a, 0x9e, 0xf6, 0x4f,
+	0xe8, 0x31, 0xe8, 0x29, 0xc7, 0xa2, 0x40, 0x99, 0xc2, 0x7b, 0x58, 0xc0, 0x47, 0x17, 0xe8, 0xa9,
+	0x87, 0x62, 0x3e, 0xf4, 0x65, 0x7b, 0x5d, 0xf7, 0x22, 0xf1, 0xbd, 0x79, 0xef, 0xf7, 0x9b, 0x79,
+	0xef, 0xcd, 0x27, 0x28, 0x93, 0xb4, 0x45, 0x92, 0xd0, 0x8f, 0xd2, 0x2a, 0xe9, 0x84, 0xd5, 0xce,
+	0x26, 0xff, 0xab, 0xc4, 0x09, 0x4d, 0x29, 0xd4, 0x07, 0x6d, 0x15, 0xae, 0xec, 0x6c, 0x96, 0x17,
+	0x71, 0xe8, 0x47, 0xb4, 0x2a, 0x7e, 0xa5, 0x51, 0x79, 0xcd, 0xa1, 0x2c, 0xa4, 0xac, 0xda, 0xc4,
+	0x8c, 0x54, 0x3b, 0x9b, 0x4d, 0x92, 0xe2, 0xcd, 0xaa, 0x43, 0xfd, 0x48, 0xb5, 0x2f, 0x7b, 0xd4,
+	0xa3, 0xe2, 0xb3, 0xca, 0xbf, 0x94, 0xd6, 0xf4, 0x28, 0xf5, 0x02, 0x52, 0x15, 0x52, 0xb3, 0xfd,
+	0xb4, 0x9a, 0xfa, 0x21, 0x61, 0x29, 0x0e, 0x63, 0x69, 0x60, 0xfd, 0x67, 0x0a, 0xcc, 0x1c, 0xe3,
+	0x04, 0x87, 0x0c, 0xee, 0x00, 0x40, 0xba, 0x69, 0x82, 0x6d, 0xe2, 0xc7, 0xcc, 0xd0, 0xd6, 0xa7,
+	0x36, 0xe6, 0x6a, 0xd6, 0x59, 0x66, 0xce, 0xd5, 0xb9, 0xb6, 0x7e, 0x70, 0xcc, 0x2e, 0x32, 0x73,
+	0xb1, 0x87, 0xc3, 0xe0, 0xb1, 0x35, 0x34, 0xb4, 0xd0, 0x9c, 0x10, 0xea, 0x7e, 0xcc, 0xe0, 0x16,
+	0x58, 0xc1, 0x41, 0x40, 0xbf, 0xb0, 0xdb, 0x11, 0x87, 0x27, 0x4e, 0x4a, 0x5c, 0x3b, 0xed, 0x32,
+	0x63, 0x66, 0x3d, 0xb7, 0x91, 0x47, 0x4b, 0xa2, 0xf1, 0x93, 0x61, 0xdb, 0x49, 0x97, 0xfb, 0x14,
+	0x49, 0x27, 0xb4, 0x9d, 0x16, 0x8e, 0x22, 0x12, 0x30, 0x23, 0x2f, 0x88, 0x17, 0xce, 0x32, 0xb3,
+	0x50, 0xff, 0xf9, 0x93, 0x5d, 0xa5, 0x46, 0x05, 0xd2, 0x09, 0xfb, 0x02, 0xfc, 0x15, 0x28, 0x61,
+	0xc7, 0x21, 0x8c, 0xd9, 0x0e, 0x8d, 0xd2, 0x84, 0x06, 0xc6, 0xdc, 0x7a, 0x6e, 0xa3, 0xb0, 0x65,
+	0x56, 0x2e, 0x87, 0xb2, 0xb2, 0x23, 0xec, 0x76, 0xa5, 0x59, 0x6d, 0xe5, 0xab, 0xcc, 0x9c, 0x38,
+	0xcb, 0xcc, 0xf9, 0x31, 0x35, 0x9a, 0xc7, 0xa3, 0x22, 0x7c, 0x0c, 0xee, 0x62, 0x27, 0xf5, 0x3b,
+	0xc4, 0x66, 0x29, 0x4e, 0x7d, 0xc7, 0x8e, 0x13, 0xe2, 0xd0, 0x30, 0xf6, 0x03, 0xc2, 0x0c, 0xc0,
+	0xfb, 0x87, 0xee, 0x48, 0x83, 0x86, 0x68, 0x3f, 0x1e, 0x36, 0x0f, 0x42, 0x40, 0x5c, 0xdb, 0xf5,
+	0x59, 0x8c, 0x53, 0xa7, 0x65, 0x87, 0xcc, 0x63, 0x46, 0x41, 0xf8, 0x2d, 0xa9, 0xc6, 0x3d, 0xd5,
+	0xf6, 0x84, 0x79, 0x0c, 0x6e, 0x00, 0x3d, 0xc4, 0x5d, 0xdb, 0xc1, 0x41, 0xd0, 0xc4, 0xce, 0xa9,
+	0xed, 0x61, 0x66, 0x14, 0xd7, 0x73, 0x1b, 0x1a, 0x2a, 0x85, 0xb8, 0xbb, 0xab, 0xd4, 0x1f, 0x62,
+	0xf6, 0xf8, 0xce, 0xb3, 0xd7, 0x2f, 0xef, 0x43, 0xd2, 0xe1, 0x95, 0xd0, 0x15, 0x95, 0x24, 0x93,
+	0x77, 0xa8, 0xe5, 0x73, 0xfa, 0xe4, 0xa1, 0x96, 0x9f, 0xd4, 0xa7, 0x0e, 0xb5, 0xfc, 0x94, 0xae,
+	0x1d, 0x6a, 0xf9, 0x69, 0x7d, 0xe6, 0x50, 0xcb, 0xcf, 0xea, 0x79, 0x34, 0xc7, 0x23, 0xec, 0x92,
+	0x88, 0x86, 0xa8, 0xe8, 0xb4, 0xb0, 0x1f, 0xf1, 0xb8, 0x3d, 0xf5, 0x3d, 0xeb, 0xb7, 0x39, 0x30,
+	0x1e, 0x0a, 0xb8, 0x03, 0x66, 0x9c, 0x84, 0xe0, 0x94, 0x18, 0x39, 0x11, 0xd2, 0x6f, 0xff, 0x8f,
+	0x90, 0x9e, 0xf4, 0x62, 0x52, 0xd3, 0x78, 0x58, 0x91, 0x72, 0x84, 0x3f, 0x02, 0x1a, 0x1f, 0x8a,
+	0x31, 0xf9, 0xff, 0x02, 0x08, 0x37, 0xeb, 0x1f, 0x39, 0xb0, 0x78, 0xc5, 0x02, 0x3a, 0xa0, 0xa0,
+	0x52, 0x9e, 0xf6, 0x62, 0xd9, 0xb9, 0xd2, 0xd6, 0x5b, 0x6f, 0xc2, 0x16, 0xa0, 0xdf, 0x39, 0xcb,
+	0x4c, 0x30, 0x94, 0x2f, 0x32, 0x13, 0xca, 0xea, 0x1d, 0x01, 0xb2, 0x10, 0xc0, 0x03, 0x0b, 0xe8,
+	0x80, 0xa5, 0xf1, 0xba, 0xb2, 0x03, 0x9f, 0xa5, 0xc6, 0xa4, 0x28, 0xc9, 0x87, 0x67, 0x99, 0x39,
+	0xde, 0xb1, 0x23, 0x9f, 0xa5, 0x17, 0x99, 0x59, 0x1e, 0x43, 0x1d, 0xf5, 0xb4, 0xd0, 0x22, 0xbe,
+	0xec, 0x60, 0xfd, 0x5e, 0x07, 0x85, 0x5d, 0x9e, 0x84, 0x5d, 0x91, 0x03, 0xf8, 0x4b, 0xb0, 0xd0,
+	0xa2, 0x7c, 0x56, 0x12, 0xec, 0xda, 0xcd, 0x80, 0x3a, 0xa7, 0x62, 0x74, 0x73, 0xb5, 0x87, 0x7f,
+	0xcf, 0xcc, 0x15, 0x39, 0xed, 0x99, 0x7b, 0x5a, 0xf1, 0x69, 0x35, 0xc4, 0x69, 0xab, 0x72, 0x10,
+	0x71, 0xd2, 0x55, 0x49, 0x7a, 0xc9, 0xd3, 0x42, 0xa5, 0x81, 0xa6, 0xc6, 0x15, 0xb0, 0x05, 0x4a,
+	0x2e, 0xa6, 0xf6, 0x53, 0x9a, 0x9c, 0x2a, 0xf0, 0x49, 0x01, 0x5e, 0x7b, 0x23, 0xf8, 0x59, 0x66,
+	0x16, 0xf7, 0x76, 0x3e, 0xde, 0xa7, 0xc9, 0xa9, 0x80, 0xb8, 0xc8, 0xcc, 0x15, 0x49, 0x36, 0x0e,
+	0x64, 0xa1, 0xa2, 0x8b, 0xe9, 0xc0, 0x0c, 0x7e, 0x0a, 0xf4, 0x81, 0x01, 0x6b, 0xc7, 0x31, 0x4d,
+	0x52, 0x63, 0x8a, 0xcf, 0xfb, 0xda, 0xbb, 0x67, 0x99, 0x59, 0x52, 0x90, 0x0d, 0xd9, 0x72, 0x91,
+	0x99, 0x77, 0x2e, 0x81, 0x2a, 0x1f, 0x0b, 0x95, 0x14, 0xac, 0x32, 0x85, 0x4d, 0x50, 0x24, 0x7e,
+	0xbc, 0xb9, 0xfd, 0x40, 0x0d, 0x40, 0x13, 0x03, 0xf8, 0xc9, 0x4d, 0x03, 0x28, 0xd4, 0x0f, 0x8e,
+	0x37, 0xb7, 0x1f, 0xf4, 0xfb, 0xbf, 0x24, 0xa9, 0x46, 0x51, 0x2c, 0x54, 0x90, 0xa2, 0xec, 0xfc,
+	0x01, 0x50, 0xa2, 0xdd, 0xc2, 0xac, 0x65, 0x4c, 0x0b, 0x8a, 0x0d, 0x5e, 0x40, 0x12, 0xe9, 0xa7,
+	0x98, 0xb5, 0x86, 0x51, 0x6f, 0xf6, 0x7e, 0x8d, 0xa3, 0xd4, 0x6f, 0x87, 0x7d, 0x2c, 0x20, 0x9d,
+	0xb9, 0xd5, 0xa0, 0xbb, 0xdb, 0xaa, 0xbb, 0x33, 0xb7, 0xed, 0xee, 0xf6, 0x75, 0xdd, 0xdd, 0x1e,
+	0xef, 0xae, 0xb4, 0x19, 0x70, 0x3c, 0x52, 0x1c, 0xb3, 0xb7, 0xe5, 0x78, 0x74, 0x1d, 0xc7, 0xa3,
+	0x71, 0x0e, 0x69, 0xc3, 0xeb, 0xf2, 0xd2, 0x38, 0x8d, 0xfc, 0xad, 0xeb, 0xf2, 0x4a, 0x84, 0x4a,
+	0x03, 0x8d, 0x44, 0x3f, 0x05, 0xcb, 0x0e, 0x8d, 0x58, 0xca, 0x75, 0x11, 0x8d, 0x03, 0xa2, 0x28,
+	0xe6, 0x04, 0xc5, 0xa3, 0x9b, 0x28, 0xee, 0x49, 0x8a, 0xeb, 0xdc, 0x2d, 0xb4, 0x34, 0xae, 0x96,
+	0x64, 0x36, 0xd0, 0x63, 0x92, 0x92, 0x84, 0x35, 0xdb, 0x89, 0xa7, 0x88, 0x80, 0x20, 0x7a, 0xef,
+	0x26, 0x22, 0x55, 0xa1, 0x97, 0x5d, 0x2d, 0xb4, 0x30, 0x54, 0x49, 0x82, 0xcf, 0x40, 0xc9, 0xe7,
+	0xac, 0xcd, 0x76, 0xa0, 0xe0, 0x0b, 0x02, 0x7e, 0xeb, 0x26, 0x78, 0x35, 0xab, 0xc6, 0x1d, 0x2d,
+	0x34, 0xdf, 0x57, 0x48, 0x68, 0x17, 0xc0, 0xb0, 0xed, 0x27, 0xb6, 0x17, 0x60, 0xc7, 0x27, 0x89,
+	0x82, 0x2f, 0x0a, 0xf8, 0xef, 0xdf, 0x04, 0x7f, 0x57, 0xc2, 0x5f, 0x75, 0xb6, 0x90, 0xce, 0x95,
+	0x1f, 0x4a, 0x9d, 0x64, 0x69, 0x80, 0x62, 0x93, 0x24, 0x81, 0x1f, 0x29, 0xfc, 0x79, 0x81, 0xff,
+	0xe0, 0x26, 0x7c, 0x55, 0x41, 0xa3, 0x6e, 0x16, 0x2a, 0x48, 0x71, 0x00, 0x1a, 0xd0, 0xc8, 0xa5,
+	0x7d, 0xd0, 0xc5, 0x5b, 0x83, 0x8e, 0xba, 0x59, 0xa8, 0x20, 0x45, 0x09, 0xea, 0x81, 0x25, 0x9c,
+	0x24, 0xf4, 0x8b, 0x4b, 0x01, 0x81, 0x02, 0xfb, 0x07, 0x37, 0x61, 0xf7, 0xd7, 0xe9, 0xab, 0xde,
+	0x7c, 0x9d, 0xe6, 0xda, 0xb1, 0x90, 0xb8, 0x00, 0x7a, 0x09, 0xee, 0x5d, 0xe2, 0x59, 0xbe, 0x75,
+	0xe0, 0xaf, 0x3a, 0x5b, 0x48, 0xe7, 0xca, 0x31, 0x96, 0xcf, 0xc1, 0x72, 0x48, 0x12, 0x8f, 0xd8,
+	0x11, 0x49, 0x59, 0x1c, 0xf8, 0xa9, 0xe2, 0x59, 0xb9, 0xf5, 0x3c, 0xb8, 0xce, 0xdd, 0x42, 0x50,
+	0xa8, 0x3f, 0x52, 0xda, 0x41, 0x95, 0xb2, 0x16, 0x8e, 0xbc, 0x16, 0xf6, 0x15, 0xcb, 0xea, 0xad,
+	0xab, 0x74, 0xdc, 0xd1, 0x42, 0xf3, 0x7d, 0xc5, 0x20, 0xd5, 0x0e, 0x8e, 0x9c, 0x76, 0x3f, 0xd5,
+	0x77, 0x6e, 0x9d, 0xea, 0x51, 0x37, 0x0b, 0x15, 0xa4, 0x28, 0x41, 0xef, 0x82, 0xbc, 0x3c, 0xad,
+	0xf8, 0xae, 0x61, 0x88, 0xf3, 0xd0, 0xac, 0x90, 0x0f, 0x5c, 0xb8, 0x0c, 0xa6, 0xc5, 0x79, 0xc6,
+	0xb8, 0xcb, 0x89, 0x90, 0x14, 0x60, 0x19, 0xe4, 0x5d, 0xe2, 0xf8, 0x21, 0x0e, 0x98, 0x51, 0x16,
+	0x0e, 0x03, 0x99, 0xf7, 0x30, 0x4e, 0xb0, 0xd7, 0xee, 0x2f, 0x34, 0xf7, 0x6e, 0xdd, 0xc3, 0x51,
+	0x37, 0x0b, 0x15, 0xa4, 0x28, 0x7a, 0x78, 0xa8, 0xe5, 0x4b, 0xfa, 0xc2, 0xa1, 0x96, 0x5f, 0xd0,
+	0xf5, 0x43, 0x2d, 0xaf, 0xeb, 0x8b, 0x87, 0x5a, 0x7e, 0x49, 0x5f, 0x46, 0xf3, 0x3d, 0x1a, 0x50,
+	0xbb, 0xf3, 0x50, 0x3a, 0xa1, 0x02, 0xf9, 0x02, 0x33, 0xb5, 0x14, 0xa2, 0x92, 0x83, 0x53, 0x1c,
+	0xf4, 0x98, 0x4a, 0x15, 0xd2, 0x65, 0x02, 0x47, 0x36, 0xd6, 0x2a, 0x98, 0xe6, 0x07, 0x4b, 0x02,
+	0x75, 0x30, 0x75, 0x4a, 0x7a, 0xf2, 0x38, 0x80, 0xf8, 0x27, 0x1f, 0x77, 0x07, 0x07, 0x6d, 0x22,
+	0x77, 0x71, 0x24, 0x05, 0xeb, 0x18, 0x2c, 0x9c, 0x24, 0x38, 0x62, 0xfc, 0x50, 0x4a, 0xa3, 0x23,
+	0xea, 0x31, 0x08, 0x81, 0x26, 0x76, 0x32, 0xe9, 0x2b, 0xbe, 0xe1, 0xf7, 0x80, 0x16, 0x50, 0x8f,
+	0x89, 0xf3, 0x4c, 0x61, 0x6b, 0xe5, 0xea, 0xe1, 0xe9, 0x88, 0x7a, 0x48, 0x98, 0x58, 0x7f, 0x9d,
+	0x04, 0x53, 0x47, 0xd4, 0x83, 0x06, 0x98, 0xc5, 0xae, 0x9b, 0x10, 0xc6, 0x14, 0x52, 0x5f, 0x84,
+	0xab, 0x60, 0x26, 0xa5, 0xb1, 0xef, 0x48, 0xb8, 0x39, 0xa4, 0x24, 0x4e, 0xec, 0xe2, 0x14, 0x8b,
+	0xad, 0xbf, 0x88, 0xc4, 0x37, 0x3f, 0xe3, 0x8b, 0x91, 0xd9, 0x51, 0x3b, 0x6c, 0x92, 0x44, 0xec,
+	0xe0, 0x5a, 0x6d, 0xe1, 0x3c, 0x33, 0x0b, 0x42, 0xff, 0x91, 0x50, 0xa3, 0x51, 0x01, 0xbe, 0x03,
+	0x66, 0xd3, 0xee, 0xe8, 0x6e, 0xbc, 0x74, 0x9e, 0x99, 0x0b, 0xe9, 0x70, 0x98, 0x7c, 0xb3, 0x45,
+	0x33, 0x69, 0x97, 0xff, 0xc3, 0x2a, 0xc8, 0xa7, 0x5d, 0xdb, 0x8f, 0x5c, 0xd2, 0x15, 0x1b, 0xae,
+	0x56, 0x5b, 0x3e, 0xcf, 0x4c, 0x7d, 0xc4, 0xfc, 0x80, 0xb7, 0xa1, 0xd9, 0xb4, 0x2b, 0x3e, 0xe0,
+	0x3b, 0x00, 0xc8, 0x2e, 0x09, 0x06, 0xb9, 0x7f, 0xce, 0x9f, 0x67, 0xe6, 0x9c, 0xd0, 0x0a, 0xec,
+	0xe1, 0x27, 0xb4, 0xc0, 0xb4, 0xc4, 0xce, 0x0b, 0xec, 0xe2, 0x79, 0x66, 0xe6, 0x03, 0xea, 0x49,
+	0x4c, 0xd9, 0xc4, 0x43, 0x95, 0x90, 0x90, 0x76, 0x88, 0x2b, 0x36, 0xb1, 0x3c, 0xea, 0x8b, 0xd6,
+	0xf3, 0x49, 0x90, 0x3f, 0xe9, 0x22, 0xc2, 0xda, 0x41, 0x0a, 0xf7, 0x81, 0x2e, 0x8e, 0x88, 0xd8,
+	0x49, 0xed, 0xb1, 0xd0, 0xd6, 0xee, 0x0d, 0xb7, 0x9c, 0xcb, 0x16, 0x16, 0x5a, 0xe8, 0xab, 0x76,
+	0x54, 0xfc, 0x97, 0xc1, 0x74, 0x33, 0xa0, 0x34, 0x14, 0x95, 0x50, 0x44, 0x52, 0x80, 0x9f, 0x8a,
+	0xa8, 0x89, 0x2c, 0x4f, 0x89, 0xe3, 0xf7, 0xb7, 0xae, 0x66, 0xf9, 0x52, 0xa9, 0xd4, 0xee, 0xf1,
+	0xc3, 0xf7, 0x45, 0x66, 0x96, 0x24, 0xb7, 0xf2, 0xb7, 0xfe, 0xf4, 0xfa, 0xe5, 0xfd, 0x1c, 0x0f,
+	0xb0, 0xa8, 0x27, 0x1d, 0x4c, 0x25, 0x24, 0x15, 0x99, 0x2b, 0x22, 0xfe, 0xc9, 0x27, 0x5b, 0x42,
+	0x3a, 0x24, 0x49, 0x89, 0x2b, 0x32, 0x94, 0x47, 0x03, 0x99, 0xcf, 0x5c, 0x0f, 0x33, 0xbb, 0xcd,
+	0x88, 0x2b, 0xd3, 0x81, 0x66, 0x3d, 0xcc, 0x3e, 0x61, 0xc4, 0x7d, 0xac, 0x7d, 0xf9, 0x07, 0x73,
+	0xc2, 0xc2, 0xa0, 0xa0, 0x4e, 0xe6, 0xed, 0x38, 0x20, 0x37, 0x94, 0xd9, 0x16, 0x28, 0xb2, 0x94,
+	0x26, 0xd8, 0x23, 0xf6, 0x29, 0xe9, 0xa9, 0x62, 0x93, 0xa5, 0xa3, 0xf4, 0x3f, 0x23, 0x3d, 0x86,
+	0x46, 0x05, 0x45, 0xf1, 0x6f, 0x0d, 0x14, 0x4e, 0x12, 0xec, 0x10, 0x75, 0xce, 0xe6, 0x05, 0xcb,
+	0xc5, 0x44, 0x51, 0x28, 0x89, 0x73, 0xf3, 0x5b, 0x31, 0x6d, 0xa7, 0x6a, 0x52, 0xf5, 0x45, 0xee,
+	0x91, 0x10, 0xd2, 0x25, 0x8e, 0x88, 0xa5, 0x86, 0x94, 0x04, 0xb7, 0xc1, 0xbc, 0xeb, 0x33, 0xdc,
+	0x0c, 0xc4, 0x05, 0xd1, 0x39, 0x95, 0xc3, 0xaf, 0xe9, 0xe7, 0x99, 0x59, 0x54, 0x0d, 0x0d, 0xae,
+	0x47, 0x63, 0x12, 0x7c, 0x1f, 0x2c, 0x0c, 0xdd, 0x44, 0x6f, 0xe5, 0xbd, 0xb8, 0x06, 0xcf, 0x33,
+	0xb3, 0x34, 0x30, 0x15, 0x2d, 0xe8, 0x92, 0x2c, 0x17, 0xbc, 0x66, 0xdb, 0x13, 0x15, 0x98, 0x47,
+	0x52, 0xe0, 0xda, 0xc0, 0x0f, 0xfd, 0x54, 0x54, 0xdc, 0x34, 0x92, 0x02, 0x7c, 0x1f, 0xcc, 0xd1,
+	0x0e, 0x49, 0x12, 0xdf, 0x15, 0xf7, 0x55, 0x5e, 0x06, 0x6f, 0x5f, 0x2d, 0x83, 0x91, 0x3b, 0x08,
+	0x1a, 0xda, 0xf3, 0xc1, 0x91, 0x48, 0x74, 0x32, 0x24, 0x21, 0x4d, 0x7a, 0x46, 0x61, 0x38, 0x38,
+	0xd9, 0xf0, 0x44, 0xe8, 0xd1, 0x98, 0x04, 0x6b, 0x00, 0x2a, 0xb7, 0x84, 0xa4, 0xed, 0x24, 0xb2,
+	0xc5, 0x22, 0x50, 0x14, 0xbe, 0x62, 0x2a, 0xca, 0x56, 0x24, 0x1a, 0xf7, 0x70, 0x8a, 0xd1, 0x15,
+	0x0d, 0xfc, 0x31, 0x80, 0x32, 0x27, 0xf6, 0xe7, 0x8c, 0xf6, 0xef, 0xa8, 0xea, 0x28, 0x22, 0xf8,
+	0x65, 0xab, 0xea, 0xb3, 0x2e, 0xa5, 0x43, 0x46, 0xfb, 0x37, 0xa9, 0x13, 0x60, 0xa8, 0x3e, 0x0c,
+	0x2f, 0xec, 0xf6, 0xd3, 0x04, 0x87, 0x84, 0x19, 0x25, 0xd1, 0x93, 0xf2, 0x79, 0x66, 0xae, 0x4a,
+	0x9b, 0xe1, 0xa5, 0x7d, 0x5f, 0x58, 0xa0, 0x37, 0xe8, 0x0f, 0xb5, 0xbc, 0xa6, 0x4f, 0xab, 0x8b,
+	0x74, 0x3f, 0x2b, 0x2a, 0x36, 0x68, 0xa9, 0x2f, 0x8f, 0x0c, 0xda, 0xfa, 0xd7, 0x24, 0x28, 0xed,
+	0x13, 0xd2, 0x88, 0x69, 0xc4, 0x68, 0xc2, 0x5a, 0x7e, 0x0c, 0x7f, 0x93, 0x03, 0x05, 0x16, 0x93,
+	0xc8, 0xb5, 0x65, 0xba, 0x72, 0x62, 0x05, 0xbe, 0x5b, 0x91, 0x3b, 0x4f, 0xa5, 0x89, 0x19, 0xa9,
+	0xa8, 0x47, 0x9d, 0xca, 0x2e, 0xf5, 0xa3, 0xda, 0x3e, 0x9f, 0x93, 0x7f, 0xfe, 0xc6, 0xdc, 0xf0,
+	0xfc, 0xb4, 0xd5, 0x6e, 0x56, 0x1c, 0x1a, 0x56, 0xa5, 0xb1, 0xfa, 0x7b, 0x97, 0xb9, 0xa7, 0x55,
+	0x7e, 0x83, 0x65, 0xc2, 0x81, 0xfd, 0xee, 0xf5, 0xcb, 0xfb, 0xc5, 0x80, 0x78, 0xd8, 0xe9, 0xd9,
+	0xfc, 0x59, 0x88, 0xc9, 0xe9, 0x0b, 0x04, 0xeb, 0x91, 0x28, 0x8b, 0x0f, 0xf8, 0x03, 0x4f, 0xec,
+	0x27, 0x98, 0xcf, 0x7c, 0x75, 0x3b, 0x2f, 0x57, 0xe4, 0x0b, 0x51, 0xa5, 0xff, 0x42, 0x54, 0x39,
+	0xe9, 0xbf, 0x10, 0xd5, 0xb4, 0xe7, 0xdf, 0x98, 0x39, 0x34, 0xe2, 0x03, 0xdf, 0x02, 0x73, 0xfd,
+	0x65, 0x88, 0xaf, 0x2f, 0x7c, 0xd9, 0x1f, 0x2a, 0xf8, 0x44, 0x62, 0x24, 0x72, 0x49, 0xa2, 0x5e,
+	0x8f, 0x50, 0x5f, 0xe4, 0x7e, 0x8c, 0x04, 0xc4, 0x49, 0x69, 0xc2, 0x8c, 0x69, 0xe9, 0x37, 0x50,
+	0xc0, 0x3d, 0xb0, 0xc0, 0x9f, 0x3f, 0x9e, 0x12, 0x62, 0xc7, 0x24, 0x11, 0xaf, 0x1f, 0xf2, 0xce,
+	0xf4, 0x36, 0x0f, 0xc2, 0x1b, 0x37, 0x68, 0x54, 0x0c, 0x71, 0x77, 0x9f, 0x90, 0x63, 0x92, 0x7c,
+	0x88, 0xd9, 0xfd, 0xbf, 0xe4, 0xc0, 0xc8, 0x65, 0x1f, 0xfe, 0x10, 0x94, 0x77, 0x76, 0x77, 0xeb,
+	0x8d, 0x86, 0x7d, 0xf2, 0xd9, 0x71, 0xdd, 0x3e, 0xae, 0xa3, 0x27, 0x07, 0x8d, 0xc6, 0xc1, 0xc7,
+	0x1f, 0x1d, 0xd5, 0x1b, 0x0d, 0x7d, 0xa2, 0xfc, 0xd6, 0xb3, 0x17, 0xeb, 0xc6, 0xd0, 0xfe, 0x98,
+	0xcf, 0x0d, 0xc6, 0x7c, 0x1a, 0x05, 0x7c, 0xd5, 0x79, 0x0f, 0xac, 0x8e, 0x7a, 0xa3, 0x7a, 0xe3,
+	0x04, 0x1d, 0xec, 0x9e, 0xd4, 0xf7, 0xf4, 0x5c, 0xd9, 0x78, 0xf6, 0x62, 0x7d, 0x79, 0xe8, 0x89,
+	0x08, 0x4b, 0x13, 0x9f, 0xbf, 0x66, 0xc1, 0x47, 0xc0, 0xb8, 0x9e, 0xb3, 0xbe, 0xa7, 0x4f, 0x96,
+	0xcb, 0xcf, 0x5e, 0xac, 0xaf, 0x5e, 0xc7, 0x48, 0xdc, 0xb2, 0xf6, 0xe5, 0x1f, 0xd7, 0x26, 0x6a,
+	0x1f, 0xfc, 0xe2, 0xbb, 0x23, 0xe9, 0x96, 0xaf, 0x3c, 0xf2, 0xb7, 0xb3, 0xf5, 0x40, 0xbd, 0xf7,
+	0x88, 0x94, 0x7f, 0x75, 0xb6, 0x96, 0xfb, 0xfa, 0x6c, 0x2d, 0xf7, 0xcf, 0xb3, 0xb5, 0xdc, 0xf3,
+	0x57, 0x6b, 0x13, 0x5f, 0xbf, 0x5a, 0x9b, 0xf8, 0xdb, 0xab, 0xb5, 0x89, 0xe6, 0x8c, 0x48, 0xe3,
+	0xc3, 0xff, 0x0e, 0x00, 0xcb, 0xa3, 0x32, 0xa6, 0x6f, 0x14, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1001,6 +1150,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxCallbackGas != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxCallbackGas))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.AllowedDispatchMsgs) > 0 {
+		for iNdEx := len(m.AllowedDispatchMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDispatchMsgs[iNdEx])
+			copy(dAtA[i:], m.AllowedDispatchMsgs[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.AllowedDispatchMsgs[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ActiveStaticPrecompiles) > 0 {
 		for iNdEx := len(m.ActiveStaticPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ActiveStaticPrecompiles[iNdEx])
@@ -1151,6 +1314,20 @@ func (m *ChainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PragueBlock != nil {
+		{
+			size := m.PragueBlock.Size()
+			i -= size
+			if _, err := m.PragueBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
 	if m.Decimals != 0 {
 		i = encodeVarintEvm(dAtA, i, uint64(m.Decimals))
 		i--
@@ -1704,6 +1881,16 @@ func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EnablePrecompileFrames {
+		i--
+		if m.EnablePrecompileFrames {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
 	if len(m.TracerJsonConfig) > 0 {
 		i -= len(m.TracerJsonConfig)
 		copy(dAtA[i:], m.TracerJsonConfig)
@@ -1800,6 +1987,90 @@ func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxFeePerGas.Size()
+		i -= size
+		if _, err := m.MaxFeePerGas.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Selectors) > 0 {
+		for iNdEx := len(m.Selectors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Selectors[iNdEx])
+			copy(dAtA[i:], m.Selectors[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.Selectors[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Senders) > 0 {
+		for iNdEx := len(m.Senders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Senders[iNdEx])
+			copy(dAtA[i:], m.Senders[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.Senders[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contracts[iNdEx])
+			copy(dAtA[i:], m.Contracts[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.Contracts[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Expiration != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintEvm(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvm(v)
 	base := offset
@@ -1840,6 +2111,15 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovEvm(uint64(l))
 		}
 	}
+	if len(m.AllowedDispatchMsgs) > 0 {
+		for _, s := range m.AllowedDispatchMsgs {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.MaxCallbackGas != 0 {
+		n += 1 + sovEvm(uint64(m.MaxCallbackGas))
+	}
 	return n
 }
 
@@ -1965,6 +2245,10 @@ func (m *ChainConfig) Size() (n int) {
 	if m.Decimals != 0 {
 		n += 2 + sovEvm(uint64(m.Decimals))
 	}
+	if m.PragueBlock != nil {
+		l = m.PragueBlock.Size()
+		n += 2 + l + sovEvm(uint64(l))
+	}
 	return n
 }
 
@@ -2138,6 +2422,48 @@ func (m *TraceConfig) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvm(uint64(l))
 	}
+	if m.EnablePrecompileFrames {
+		n += 2
+	}
+	return n
+}
+
+func (m *FeeSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.Senders) > 0 {
+		for _, s := range m.Senders {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.Selectors) > 0 {
+		for _, s := range m.Selectors {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	l = m.MaxFeePerGas.Size()
+	n += 1 + l + sovEvm(uint64(l))
 	return n
 }
 
@@ -2325,6 +2651,57 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ActiveStaticPrecompiles = append(m.ActiveStaticPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDispatchMsgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDispatchMsgs = append(m.AllowedDispatchMsgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallbackGas", wireType)
+			}
+			m.MaxCallbackGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCallbackGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -3326,6 +3703,42 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PragueBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.PragueBlock = &v
+			if err := m.PragueBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -4501,6 +4914,276 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.TracerJsonConfig = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnablePrecompileFrames", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnablePrecompileFrames = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Senders = append(m.Senders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selectors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selectors = append(m.Selectors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeePerGas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFeePerGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
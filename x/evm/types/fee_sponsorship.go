@@ -0,0 +1,137 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/evmos/evmos/v20/types"
+)
+
+// selectorLength is the length in bytes of a function selector
+const selectorLength = 4
+
+// feeSponsorKey is the context key of the sponsor paying the fees of the
+// transaction.
+type feeSponsorKey struct{}
+
+// Validate performs a basic validation of the fee sponsorship.
+func (fs FeeSponsorship) Validate() error {
+	if err := fs.SpendLimit.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid spend limit")
+	}
+
+	if fs.SpendLimit.Empty() {
+		return fmt.Errorf("spend limit cannot be empty")
+	}
+
+	if fs.MaxFeePerGas.IsNil() || !fs.MaxFeePerGas.IsPositive() {
+		return fmt.Errorf("max fee per gas must be positive: %s", fs.MaxFeePerGas)
+	}
+
+	for _, contract := range fs.Contracts {
+		if err := types.ValidateAddress(contract); err != nil {
+			return errorsmod.Wrap(err, "invalid contract")
+		}
+	}
+
+	for _, sender := range fs.Senders {
+		if err := types.ValidateAddress(sender); err != nil {
+			return errorsmod.Wrap(err, "invalid sender")
+		}
+	}
+
+	for _, selector := range fs.Selectors {
+		bz, err := hexutil.Decode(selector)
+		if err != nil || len(bz) != selectorLength {
+			return fmt.Errorf("invalid function selector %s: must be %d hex encoded bytes", selector, selectorLength)
+		}
+	}
+
+	return nil
+}
+
+// Allows returns an error if the sponsorship is expired at the given block
+// time, if the fee cap of the transaction is higher than the max fee per gas,
+// or if the transaction from the given sender to the given address with the
+// given input is out of the scope of the sponsorship. Contract creations are
+// only allowed when the scope doesn't restrict the contracts nor the function
+// selectors.
+func (fs FeeSponsorship) Allows(
+	blockTime time.Time,
+	from common.Address,
+	to *common.Address,
+	input []byte,
+	gasFeeCap *big.Int,
+) error {
+	if fs.Expiration != nil && !blockTime.Before(*fs.Expiration) {
+		return errorsmod.Wrapf(ErrFeeSponsorshipNotAllowed, "sponsorship expired at %s", fs.Expiration)
+	}
+
+	if gasFeeCap == nil || gasFeeCap.Cmp(fs.MaxFeePerGas.BigInt()) > 0 {
+		return errorsmod.Wrapf(ErrFeeSponsorshipNotAllowed, "fee cap %s exceeds the max fee per gas %s", gasFeeCap, fs.MaxFeePerGas)
+	}
+
+	if !fs.AllowsSender(from) {
+		return errorsmod.Wrapf(ErrFeeSponsorshipNotAllowed, "sender %s is not sponsored", from)
+	}
+
+	if len(fs.Contracts) > 0 && (to == nil || !containsAddress(fs.Contracts, *to)) {
+		return errorsmod.Wrapf(ErrFeeSponsorshipNotAllowed, "contract %s is not sponsored", to)
+	}
+
+	if len(fs.Selectors) > 0 {
+		if to == nil || len(input) < selectorLength || !containsSelector(fs.Selectors, input[:selectorLength]) {
+			return errorsmod.Wrap(ErrFeeSponsorshipNotAllowed, "function selector is not sponsored")
+		}
+	}
+
+	return nil
+}
+
+// AllowsSender returns true if the transactions of the given sender are in the
+// scope of the sponsorship.
+func (fs FeeSponsorship) AllowsSender(from common.Address) bool {
+	return len(fs.Senders) == 0 || containsAddress(fs.Senders, from)
+}
+
+// WithFeeSponsor returns a copy of the context carrying the sponsor paying the
+// fees of the transaction. It's set by the AnteHandler so that the leftover gas
+// is refunded to the sponsor.
+func WithFeeSponsor(ctx sdk.Context, sponsor sdk.AccAddress) sdk.Context {
+	return ctx.WithValue(feeSponsorKey{}, sponsor)
+}
+
+// GetFeeSponsor returns the sponsor paying the fees of the transaction, if the
+// fees are not paid by the sender.
+func GetFeeSponsor(ctx sdk.Context) (sdk.AccAddress, bool) {
+	sponsor, ok := ctx.Value(feeSponsorKey{}).(sdk.AccAddress)
+	return sponsor, ok
+}
+
+func containsAddress(addresses []string, address common.Address) bool {
+	for _, addr := range addresses {
+		if common.HexToAddress(addr) == address {
+			return true
+		}
+	}
+	return false
+}
+
+func containsSelector(selectors []string, selector []byte) bool {
+	encoded := hexutil.Encode(selector)
+	for _, s := range selectors {
+		if strings.EqualFold(s, encoded) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func TestFeeSponsorshipValidate(t *testing.T) {
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100))
+	maxFeePerGas := sdkmath.NewInt(1e9)
+
+	testCases := []struct {
+		name        string
+		sponsorship types.FeeSponsorship
+		expErr      string
+	}{
+		{"pass - unscoped", types.FeeSponsorship{SpendLimit: spendLimit, MaxFeePerGas: maxFeePerGas}, ""},
+		{
+			"pass - scoped",
+			types.FeeSponsorship{
+				SpendLimit:   spendLimit,
+				MaxFeePerGas: maxFeePerGas,
+				Contracts:    []string{common.HexToAddress("0xc0de").Hex()},
+				Senders:      []string{common.HexToAddress("0xa11ce").Hex()},
+				Selectors:    []string{"0xa9059cbb"},
+			},
+			"",
+		},
+		{
+			"fail - invalid spend limit",
+			types.FeeSponsorship{SpendLimit: sdk.Coins{{Denom: "aevmos", Amount: sdkmath.ZeroInt()}}, MaxFeePerGas: maxFeePerGas},
+			"invalid spend limit",
+		},
+		{"fail - empty spend limit", types.FeeSponsorship{MaxFeePerGas: maxFeePerGas}, "spend limit cannot be empty"},
+		{"fail - empty max fee per gas", types.FeeSponsorship{SpendLimit: spendLimit}, "max fee per gas must be positive"},
+		{
+			"fail - zero max fee per gas",
+			types.FeeSponsorship{SpendLimit: spendLimit, MaxFeePerGas: sdkmath.ZeroInt()},
+			"max fee per gas must be positive",
+		},
+		{
+			"fail - invalid contract",
+			types.FeeSponsorship{SpendLimit: spendLimit, MaxFeePerGas: maxFeePerGas, Contracts: []string{"0x123"}},
+			"invalid contract",
+		},
+		{
+			"fail - invalid sender",
+			types.FeeSponsorship{SpendLimit: spendLimit, MaxFeePerGas: maxFeePerGas, Senders: []string{"evmos1"}},
+			"invalid sender",
+		},
+		{
+			"fail - invalid selector",
+			types.FeeSponsorship{SpendLimit: spendLimit, MaxFeePerGas: maxFeePerGas, Selectors: []string{"0xa9059c"}},
+			"invalid function selector",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.sponsorship.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestFeeSponsorshipAllows(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	expiration := now.Add(time.Hour)
+	sender := common.HexToAddress("0xa11ce")
+	contract := common.HexToAddress("0xc0de")
+	transfer := hexutil.MustDecode("0xa9059cbb0000")
+
+	maxFeePerGas := big.NewInt(1e9)
+
+	sponsorship := types.FeeSponsorship{
+		Expiration:   &expiration,
+		Contracts:    []string{contract.Hex()},
+		Senders:      []string{sender.Hex()},
+		Selectors:    []string{"0xA9059CBB"},
+		MaxFeePerGas: sdkmath.NewIntFromBigInt(maxFeePerGas),
+	}
+
+	testCases := []struct {
+		name      string
+		blockTime time.Time
+		from      common.Address
+		to        *common.Address
+		input     []byte
+		gasFeeCap *big.Int
+		expErr    string
+	}{
+		{"pass", now, sender, &contract, transfer, maxFeePerGas, ""},
+		{"fail - expired", expiration, sender, &contract, transfer, maxFeePerGas, "sponsorship expired"},
+		{"fail - fee cap", now, sender, &contract, transfer, big.NewInt(1e9 + 1), "exceeds the max fee per gas"},
+		{"fail - sender", now, contract, &contract, transfer, maxFeePerGas, "is not sponsored"},
+		{"fail - contract", now, sender, &sender, transfer, maxFeePerGas, "is not sponsored"},
+		{"fail - contract creation", now, sender, nil, transfer, maxFeePerGas, "is not sponsored"},
+		{"fail - selector", now, sender, &contract, []byte{0xa9, 0x05, 0x9c, 0xbc}, maxFeePerGas, "function selector is not sponsored"},
+		{"fail - short input", now, sender, &contract, transfer[:3], maxFeePerGas, "function selector is not sponsored"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := sponsorship.Allows(tc.blockTime, tc.from, tc.to, tc.input, tc.gasFeeCap)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, types.ErrFeeSponsorshipNotAllowed)
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+
+	// an unscoped sponsorship allows contract creations
+	unscoped := types.FeeSponsorship{MaxFeePerGas: sdkmath.NewIntFromBigInt(maxFeePerGas)}
+	require.NoError(t, unscoped.Allows(now, sender, nil, nil, maxFeePerGas))
+}
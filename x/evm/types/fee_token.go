@@ -0,0 +1,35 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// feeTokenKey is the context key of the fee token used to pay the fees of the
+// transaction.
+type feeTokenKey struct{}
+
+// FeeTokenRate defines an alternate token accepted by the fee market to pay the
+// fees of an EVM transaction, along with its conversion rate to the EVM denom.
+type FeeTokenRate struct {
+	Denom string
+	// Rate is the amount of the EVM denom, using 18 decimals, paid by each
+	// base unit of the token.
+	Rate sdkmath.LegacyDec
+}
+
+// WithFeeTokenRate returns a copy of the context carrying the fee token used to
+// pay the fees of the transaction. It's set by the AnteHandler so that the
+// leftover gas is refunded in the same token.
+func WithFeeTokenRate(ctx sdk.Context, feeToken FeeTokenRate) sdk.Context {
+	return ctx.WithValue(feeTokenKey{}, feeToken)
+}
+
+// GetFeeTokenRate returns the fee token used to pay the fees of the transaction,
+// if the fees are not paid in the EVM denom.
+func GetFeeTokenRate(ctx sdk.Context) (FeeTokenRate, bool) {
+	feeToken, ok := ctx.Value(feeTokenKey{}).(FeeTokenRate)
+	return feeToken, ok
+}
@@ -5,6 +5,8 @@ package types
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/evmos/evmos/v20/types"
 )
 
@@ -16,6 +18,25 @@ func (ga GenesisAccount) Validate() error {
 	return ga.Storage.Validate()
 }
 
+// Validate performs a basic validation of a GenesisFeeSponsorship fields.
+func (gfs GenesisFeeSponsorship) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(gfs.Sponsor); err != nil {
+		return fmt.Errorf("invalid sponsor address: %w", err)
+	}
+	return gfs.Sponsorship.Validate()
+}
+
+// Validate performs a basic validation of a FeeSponsor fields.
+func (fs FeeSponsor) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(fs.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(fs.Sponsor); err != nil {
+		return fmt.Errorf("invalid sponsor address: %w", err)
+	}
+	return nil
+}
+
 // DefaultGenesisState sets default evm genesis state with empty accounts and default params and
 // chain config values.
 func DefaultGenesisState() *GenesisState {
@@ -47,5 +68,30 @@ func (gs GenesisState) Validate() error {
 		seenAccounts[acc.Address] = true
 	}
 
+	seenSponsors := make(map[string]bool)
+	for _, sponsorship := range gs.FeeSponsorships {
+		if seenSponsors[sponsorship.Sponsor] {
+			return fmt.Errorf("duplicated fee sponsorship of %s", sponsorship.Sponsor)
+		}
+		if err := sponsorship.Validate(); err != nil {
+			return fmt.Errorf("invalid fee sponsorship of %s: %w", sponsorship.Sponsor, err)
+		}
+		seenSponsors[sponsorship.Sponsor] = true
+	}
+
+	seenSenders := make(map[string]bool)
+	for _, sponsor := range gs.FeeSponsors {
+		if seenSenders[sponsor.Address] {
+			return fmt.Errorf("duplicated fee sponsor of %s", sponsor.Address)
+		}
+		if err := sponsor.Validate(); err != nil {
+			return fmt.Errorf("invalid fee sponsor of %s: %w", sponsor.Address, err)
+		}
+		if !seenSponsors[sponsor.Sponsor] {
+			return fmt.Errorf("fee sponsor of %s has no fee sponsorship: %s", sponsor.Address, sponsor.Sponsor)
+		}
+		seenSenders[sponsor.Address] = true
+	}
+
 	return gs.Params.Validate()
 }
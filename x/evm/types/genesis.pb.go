@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -30,6 +31,12 @@ type GenesisState struct {
 	Accounts []GenesisAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// fee_sponsorships defines the allowances granted by the sponsors to pay the
+	// fees of EVM transactions.
+	FeeSponsorships []GenesisFeeSponsorship `protobuf:"bytes,3,rep,name=fee_sponsorships,json=feeSponsorships,proto3" json:"fee_sponsorships"`
+	// fee_sponsors defines the sponsors chosen by the accounts to pay the fees of
+	// their EVM transactions.
+	FeeSponsors []FeeSponsor `protobuf:"bytes,4,rep,name=fee_sponsors,json=feeSponsors,proto3" json:"fee_sponsors"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +86,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetFeeSponsorships() []GenesisFeeSponsorship {
+	if m != nil {
+		return m.FeeSponsorships
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeSponsors() []FeeSponsor {
+	if m != nil {
+		return m.FeeSponsors
+	}
+	return nil
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -145,36 +166,159 @@ func (m *GenesisAccount) GetStorage() Storage {
 	return nil
 }
 
+// GenesisFeeSponsorship defines the fee sponsorship of a sponsor to be
+// initialized in the genesis state.
+type GenesisFeeSponsorship struct {
+	// sponsor is the address of the account paying the fees.
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// sponsorship defines the allowance and scope of the sponsorship.
+	Sponsorship FeeSponsorship `protobuf:"bytes,2,opt,name=sponsorship,proto3" json:"sponsorship"`
+}
+
+func (m *GenesisFeeSponsorship) Reset()         { *m = GenesisFeeSponsorship{} }
+func (m *GenesisFeeSponsorship) String() string { return proto.CompactTextString(m) }
+func (*GenesisFeeSponsorship) ProtoMessage()    {}
+func (*GenesisFeeSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bcdec50cc9d156d, []int{2}
+}
+func (m *GenesisFeeSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisFeeSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisFeeSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisFeeSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisFeeSponsorship.Merge(m, src)
+}
+func (m *GenesisFeeSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisFeeSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisFeeSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisFeeSponsorship proto.InternalMessageInfo
+
+func (m *GenesisFeeSponsorship) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *GenesisFeeSponsorship) GetSponsorship() FeeSponsorship {
+	if m != nil {
+		return m.Sponsorship
+	}
+	return FeeSponsorship{}
+}
+
+// FeeSponsor defines the sponsor chosen by an account to pay the fees of its
+// EVM transactions.
+type FeeSponsor struct {
+	// address is the address of the account sending the EVM transactions.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// sponsor is the address of the account paying the fees.
+	Sponsor string `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+}
+
+func (m *FeeSponsor) Reset()         { *m = FeeSponsor{} }
+func (m *FeeSponsor) String() string { return proto.CompactTextString(m) }
+func (*FeeSponsor) ProtoMessage()    {}
+func (*FeeSponsor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bcdec50cc9d156d, []int{3}
+}
+func (m *FeeSponsor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSponsor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSponsor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSponsor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSponsor.Merge(m, src)
+}
+func (m *FeeSponsor) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSponsor) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSponsor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSponsor proto.InternalMessageInfo
+
+func (m *FeeSponsor) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FeeSponsor) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ethermint.evm.v1.GenesisState")
 	proto.RegisterType((*GenesisAccount)(nil), "ethermint.evm.v1.GenesisAccount")
+	proto.RegisterType((*GenesisFeeSponsorship)(nil), "ethermint.evm.v1.GenesisFeeSponsorship")
+	proto.RegisterType((*FeeSponsor)(nil), "ethermint.evm.v1.FeeSponsor")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/genesis.proto", fileDescriptor_9bcdec50cc9d156d) }
 
 var fileDescriptor_9bcdec50cc9d156d = []byte{
-	// 321 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x2d, 0xc9, 0x48,
-	0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x4f, 0x2d, 0xcb, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xcb, 0xeb, 0xa5,
-	0x96, 0xe5, 0xea, 0x95, 0x19, 0x4a, 0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88,
-	0x22, 0x29, 0x29, 0x0c, 0x43, 0x40, 0x6a, 0x21, 0x72, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6,
-	0x3e, 0x88, 0x05, 0x11, 0x55, 0x9a, 0xc2, 0xc8, 0xc5, 0xe3, 0x0e, 0xb1, 0x28, 0xb8, 0x24, 0xb1,
-	0x24, 0x55, 0xc8, 0x9d, 0x8b, 0x23, 0x31, 0x39, 0x39, 0xbf, 0x34, 0xaf, 0xa4, 0x58, 0x82, 0x51,
-	0x81, 0x59, 0x83, 0xdb, 0x48, 0x41, 0x0f, 0xdd, 0x6a, 0x3d, 0xa8, 0x0e, 0x47, 0x88, 0x42, 0x27,
-	0xce, 0x13, 0xf7, 0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0xd7, 0x2c, 0x64, 0xcd,
-	0xc5, 0x56, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0x81,
-	0x69, 0x4c, 0x00, 0x58, 0x1e, 0x59, 0x3b, 0x54, 0x8b, 0x52, 0x3b, 0x23, 0x17, 0x1f, 0xaa, 0x25,
-	0x42, 0x12, 0x5c, 0xec, 0x89, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0x20, 0x77, 0x31, 0x6a, 0x70, 0x06,
-	0xc1, 0xb8, 0x42, 0x42, 0x5c, 0x2c, 0xc9, 0xf9, 0x29, 0xa9, 0x60, 0x7b, 0x38, 0x83, 0xc0, 0x6c,
-	0x21, 0x77, 0x2e, 0xf6, 0xe2, 0x92, 0xfc, 0xa2, 0xc4, 0xf4, 0x54, 0x09, 0x66, 0xb0, 0x2f, 0xc4,
-	0x31, 0xad, 0x07, 0x7b, 0xd8, 0x49, 0x04, 0x64, 0xfb, 0xaa, 0xfb, 0xf2, 0xec, 0xc1, 0x10, 0xf5,
-	0x10, 0x87, 0xc0, 0x74, 0x3b, 0x39, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83,
-	0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43,
-	0x94, 0x5a, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0x2e, 0x28, 0x98, 0xf3, 0x8b,
-	0xa1, 0x64, 0x99, 0x91, 0x81, 0x7e, 0x05, 0x38, 0xfc, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8,
-	0xc0, 0x21, 0x6d, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xe2, 0xca, 0x72, 0xe8, 0xe2, 0x01, 0x00,
-	0x00,
+	// 466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x3f, 0x8f, 0xd3, 0x30,
+	0x1c, 0x8d, 0x7b, 0xd5, 0x85, 0x3a, 0x27, 0x38, 0xac, 0x22, 0x42, 0x85, 0x72, 0x51, 0x06, 0xa8,
+	0x90, 0x48, 0xb8, 0x30, 0xb2, 0x70, 0x91, 0xa0, 0x13, 0x12, 0x4a, 0x16, 0xc4, 0x72, 0xca, 0xa5,
+	0xbe, 0x34, 0x43, 0xe2, 0x28, 0x76, 0x23, 0xf8, 0x04, 0xac, 0xcc, 0x7c, 0x02, 0xc4, 0xc4, 0x00,
+	0xdf, 0xa1, 0x63, 0xc5, 0xc4, 0x04, 0xa8, 0x1d, 0xf8, 0x1a, 0xc8, 0x7f, 0xd2, 0xa6, 0x04, 0xaa,
+	0x5b, 0x2c, 0xdb, 0xbf, 0xf7, 0x5e, 0xde, 0xef, 0xfd, 0x62, 0x68, 0x61, 0x36, 0xc3, 0x55, 0x9e,
+	0x15, 0xcc, 0xc3, 0x75, 0xee, 0xd5, 0xa7, 0x5e, 0x8a, 0x0b, 0x4c, 0x33, 0xea, 0x96, 0x15, 0x61,
+	0x04, 0x1d, 0x6f, 0xea, 0x2e, 0xae, 0x73, 0xb7, 0x3e, 0x1d, 0xdd, 0x8c, 0xf3, 0xac, 0x20, 0x9e,
+	0x58, 0x25, 0x68, 0x74, 0x27, 0x21, 0x34, 0x27, 0xf4, 0x5c, 0x9c, 0x3c, 0x79, 0x50, 0xa5, 0x51,
+	0x47, 0x9f, 0xcb, 0xc8, 0xda, 0x30, 0x25, 0x29, 0x91, 0x1c, 0xbe, 0x93, 0xb7, 0xce, 0xd7, 0x1e,
+	0x3c, 0x9a, 0x48, 0x0f, 0x11, 0x8b, 0x19, 0x46, 0x13, 0x78, 0x2d, 0x4e, 0x12, 0x32, 0x2f, 0x18,
+	0x35, 0x81, 0x7d, 0x30, 0x36, 0x7c, 0xdb, 0xfd, 0xdb, 0x95, 0xab, 0x18, 0x67, 0x12, 0x18, 0x0c,
+	0x16, 0x3f, 0x4e, 0xb4, 0x8f, 0xbf, 0x3f, 0x3f, 0x00, 0xe1, 0x86, 0x8c, 0x9e, 0xc0, 0xc3, 0x32,
+	0xae, 0xe2, 0x9c, 0x9a, 0x3d, 0x1b, 0x8c, 0x0d, 0xdf, 0xec, 0xca, 0xbc, 0x14, 0xf5, 0x36, 0x5d,
+	0x51, 0xd0, 0x2b, 0x78, 0x7c, 0x89, 0xf1, 0x39, 0x2d, 0x49, 0x41, 0x49, 0x45, 0x67, 0x59, 0x49,
+	0xcd, 0x03, 0xe1, 0xe6, 0xfe, 0x7f, 0xdd, 0x3c, 0xc7, 0x38, 0xda, 0xe2, 0x83, 0x3e, 0x57, 0x0d,
+	0x6f, 0x5c, 0xee, 0xdc, 0x52, 0xf4, 0x0c, 0x1e, 0xb5, 0x95, 0xcd, 0xbe, 0x50, 0xbd, 0xdb, 0x55,
+	0xdd, 0xca, 0x29, 0x29, 0xa3, 0x25, 0xe5, 0xbc, 0x03, 0xf0, 0xfa, 0x6e, 0x0a, 0xc8, 0x84, 0x7a,
+	0x3c, 0x9d, 0x56, 0x98, 0xf2, 0xe0, 0xc0, 0x78, 0x10, 0x36, 0x47, 0x84, 0x60, 0x3f, 0x21, 0x53,
+	0x2c, 0x82, 0x18, 0x84, 0x62, 0x8f, 0x26, 0x50, 0xa7, 0x8c, 0x54, 0x71, 0x8a, 0x55, 0x63, 0xb7,
+	0xbb, 0x16, 0xc4, 0x44, 0x82, 0x21, 0xff, 0xfa, 0xa7, 0x9f, 0x27, 0x7a, 0x24, 0xf1, 0x32, 0xa9,
+	0x86, 0xed, 0x7c, 0x00, 0xf0, 0xd6, 0x3f, 0x13, 0x40, 0x3e, 0xd4, 0x55, 0x9b, 0xd2, 0x50, 0x60,
+	0x7e, 0xfb, 0xf2, 0x70, 0xa8, 0x7e, 0x98, 0x33, 0xe9, 0x2d, 0x62, 0x55, 0x56, 0xa4, 0x61, 0x03,
+	0x44, 0x2f, 0xa0, 0xd1, 0x0a, 0x5d, 0x8d, 0xce, 0xde, 0x97, 0x8e, 0x08, 0xbb, 0x35, 0xc2, 0x36,
+	0xdf, 0x61, 0x10, 0x6e, 0x91, 0xdc, 0xd0, 0x4e, 0x42, 0xfb, 0x0c, 0x35, 0xd9, 0xb5, 0x9a, 0xe8,
+	0x5d, 0xb1, 0x89, 0xe0, 0xe9, 0x62, 0x65, 0x81, 0xe5, 0xca, 0x02, 0xbf, 0x56, 0x16, 0x78, 0xbf,
+	0xb6, 0xb4, 0xe5, 0xda, 0xd2, 0xbe, 0xaf, 0x2d, 0xed, 0xf5, 0xbd, 0x34, 0x63, 0xb3, 0xf9, 0x85,
+	0x9b, 0x90, 0x9c, 0x3f, 0x0d, 0x42, 0xd5, 0x5a, 0xfb, 0x8f, 0xbc, 0x37, 0x7c, 0xef, 0xb1, 0xb7,
+	0x25, 0xa6, 0x17, 0x87, 0xe2, 0x75, 0x3c, 0xfe, 0x33, 0x00, 0x27, 0xea, 0x72, 0x57, 0xb1, 0x03,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -197,6 +341,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeSponsors) > 0 {
+		for iNdEx := len(m.FeeSponsors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSponsors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FeeSponsorships) > 0 {
+		for iNdEx := len(m.FeeSponsorships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSponsorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -275,6 +447,83 @@ func (m *GenesisAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisFeeSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisFeeSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisFeeSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Sponsorship.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSponsor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSponsor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSponsor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -300,6 +549,18 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FeeSponsorships) > 0 {
+		for _, e := range m.FeeSponsorships {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeSponsors) > 0 {
+		for _, e := range m.FeeSponsors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -326,6 +587,38 @@ func (m *GenesisAccount) Size() (n int) {
 	return n
 }
 
+func (m *GenesisFeeSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Sponsorship.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *FeeSponsor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -428,6 +721,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSponsorships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSponsorships = append(m.FeeSponsorships, GenesisFeeSponsorship{})
+			if err := m.FeeSponsorships[len(m.FeeSponsorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSponsors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSponsors = append(m.FeeSponsors, FeeSponsor{})
+			if err := m.FeeSponsors[len(m.FeeSponsors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -597,6 +958,235 @@ func (m *GenesisAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GenesisFeeSponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisFeeSponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisFeeSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorship", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sponsorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSponsor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSponsor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSponsor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,8 @@ package types
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 
@@ -82,6 +84,16 @@ func (suite *GenesisTestSuite) TestValidateGenesisAccount() {
 }
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
+	sponsor := sdk.AccAddress(common.HexToAddress(suite.address).Bytes()).String()
+	sender := sdk.AccAddress(suite.hash.Bytes()[:20]).String()
+	sponsorship := GenesisFeeSponsorship{
+		Sponsor: sponsor,
+		Sponsorship: FeeSponsorship{
+			SpendLimit:   sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
+			MaxFeePerGas: math.NewInt(1e9),
+		},
+	}
+
 	testCases := []struct {
 		name     string
 		genState *GenesisState
@@ -114,6 +126,59 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			genState: NewGenesisState(DefaultGenesisState().Params, DefaultGenesisState().Accounts),
 			expPass:  true,
 		},
+		{
+			name: "valid genesis with fee sponsorships",
+			genState: &GenesisState{
+				Params:          DefaultParams(),
+				FeeSponsorships: []GenesisFeeSponsorship{sponsorship},
+				FeeSponsors:     []FeeSponsor{{Address: sender, Sponsor: sponsor}},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid fee sponsorship",
+			genState: &GenesisState{
+				Params:          DefaultParams(),
+				FeeSponsorships: []GenesisFeeSponsorship{{Sponsor: sponsor}},
+			},
+			expPass: false,
+		},
+		{
+			name: "duplicated fee sponsorship",
+			genState: &GenesisState{
+				Params:          DefaultParams(),
+				FeeSponsorships: []GenesisFeeSponsorship{sponsorship, sponsorship},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid fee sponsor",
+			genState: &GenesisState{
+				Params:      DefaultParams(),
+				FeeSponsors: []FeeSponsor{{Address: sender, Sponsor: suite.address}},
+			},
+			expPass: false,
+		},
+		{
+			name: "fee sponsor without fee sponsorship",
+			genState: &GenesisState{
+				Params:      DefaultParams(),
+				FeeSponsors: []FeeSponsor{{Address: sender, Sponsor: sponsor}},
+			},
+			expPass: false,
+		},
+		{
+			name: "duplicated fee sponsor",
+			genState: &GenesisState{
+				Params:          DefaultParams(),
+				FeeSponsorships: []GenesisFeeSponsorship{sponsorship},
+				FeeSponsors: []FeeSponsor{
+					{Address: sender, Sponsor: sponsor},
+					{Address: sender, Sponsor: sponsor},
+				},
+			},
+			expPass: false,
+		},
 		{
 			name: "invalid genesis account",
 			genState: &GenesisState{
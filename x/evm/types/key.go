@@ -3,6 +3,8 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -29,6 +31,9 @@ const (
 	prefixStorage
 	prefixParams
 	prefixCodeHash
+	prefixFeeSponsorship
+	prefixFeeSponsor
+	prefixFeeSponsorSender
 )
 
 // prefix bytes for the EVM transient store
@@ -41,10 +46,13 @@ const (
 
 // KVStore key prefixes
 var (
-	KeyPrefixCode     = []byte{prefixCode}
-	KeyPrefixStorage  = []byte{prefixStorage}
-	KeyPrefixParams   = []byte{prefixParams}
-	KeyPrefixCodeHash = []byte{prefixCodeHash}
+	KeyPrefixCode             = []byte{prefixCode}
+	KeyPrefixStorage          = []byte{prefixStorage}
+	KeyPrefixParams           = []byte{prefixParams}
+	KeyPrefixCodeHash         = []byte{prefixCodeHash}
+	KeyPrefixFeeSponsorship   = []byte{prefixFeeSponsorship}
+	KeyPrefixFeeSponsor       = []byte{prefixFeeSponsor}
+	KeyPrefixFeeSponsorSender = []byte{prefixFeeSponsorSender}
 )
 
 // Transient Store key prefixes
@@ -64,3 +72,20 @@ func AddressStoragePrefix(address common.Address) []byte {
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
 }
+
+// FeeSponsorSendersPrefix returns a prefix to iterate over the accounts that
+// chose the given sponsor to pay the fees of their EVM transactions.
+func FeeSponsorSendersPrefix(sponsor sdk.AccAddress) []byte {
+	return append(KeyPrefixFeeSponsorSender, address.MustLengthPrefix(sponsor)...)
+}
+
+// FeeSponsorSenderKey defines the full key under which an account is indexed
+// by the sponsor it chose.
+func FeeSponsorSenderKey(sponsor, sender sdk.AccAddress) []byte {
+	return append(FeeSponsorSendersPrefix(sponsor), sender.Bytes()...)
+}
+
+// CodeHashKey defines the full key under which the code hash of an account is stored.
+func CodeHashKey(address common.Address) []byte {
+	return append(KeyPrefixCodeHash, address.Bytes()...)
+}
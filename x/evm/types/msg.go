@@ -28,6 +28,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 var (
@@ -35,6 +36,9 @@ var (
 	_ sdk.Tx     = &MsgEthereumTx{}
 	_ ante.GasTx = &MsgEthereumTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgSetFeeSponsorship{}
+	_ sdk.Msg    = &MsgRevokeFeeSponsorship{}
+	_ sdk.Msg    = &MsgSetFeeSponsor{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgEthereumTx{}
 )
@@ -86,6 +90,22 @@ func newMsgEthereumTx(
 	}
 
 	switch {
+	case tx.AuthorizationList != nil:
+		gtc := sdkmath.NewIntFromBigInt(tx.GasTipCap)
+		gfc := sdkmath.NewIntFromBigInt(tx.GasFeeCap)
+
+		txData = &SetCodeTx{
+			ChainID:        cid,
+			Amount:         amt,
+			To:             toAddr,
+			GasTipCap:      &gtc,
+			GasFeeCap:      &gfc,
+			Nonce:          tx.Nonce,
+			GasLimit:       tx.GasLimit,
+			Data:           tx.Input,
+			Accesses:       NewAccessList(tx.Accesses),
+			Authorizations: tx.AuthorizationList,
+		}
 	case tx.GasFeeCap != nil:
 		gtc := sdkmath.NewIntFromBigInt(tx.GasTipCap)
 		gfc := sdkmath.NewIntFromBigInt(tx.GasFeeCap)
@@ -129,7 +149,7 @@ func newMsgEthereumTx(
 	}
 
 	msg := MsgEthereumTx{Data: dataAny}
-	msg.Hash = msg.AsTransaction().Hash().Hex()
+	msg.Hash = msg.TxHash().Hex()
 	return &msg
 }
 
@@ -192,7 +212,7 @@ func (msg MsgEthereumTx) ValidateBasic() error {
 	}
 
 	// Validate Hash field after validated txData to avoid panic
-	txHash := msg.AsTransaction().Hash().Hex()
+	txHash := msg.TxHash().Hex()
 	if msg.Hash != txHash {
 		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid tx hash %s, expected: %s", msg.Hash, txHash)
 	}
@@ -231,6 +251,31 @@ func (msg *MsgEthereumTx) Sign(ethSigner ethtypes.Signer, keyringSigner keyring.
 		return fmt.Errorf("sender address not defined for message")
 	}
 
+	if setCodeTx, ok := msg.setCodeTx(); ok {
+		sig, _, err := keyringSigner.SignByAddress(from, setCodeTx.SigHash().Bytes(), signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+		if err != nil {
+			return err
+		}
+		if len(sig) != crypto.SignatureLength {
+			return fmt.Errorf("wrong size for signature: got %d, want %d", len(sig), crypto.SignatureLength)
+		}
+
+		setCodeTx.SetSignatureValues(
+			nil,
+			new(big.Int).SetBytes(sig[crypto.RecoveryIDOffset:]),
+			new(big.Int).SetBytes(sig[:32]),
+			new(big.Int).SetBytes(sig[32:64]),
+		)
+
+		// the signature values are set on the cached tx data, so it must be
+		// packed again to be encoded
+		if msg.Data, err = PackTxData(setCodeTx); err != nil {
+			return err
+		}
+		msg.Hash = setCodeTx.Hash().Hex()
+		return nil
+	}
+
 	tx := msg.AsTransaction()
 	txHash := ethSigner.Hash(tx)
 
@@ -284,7 +329,11 @@ func (msg *MsgEthereumTx) GetFrom() sdk.AccAddress {
 	return common.HexToAddress(msg.From).Bytes()
 }
 
-// AsTransaction creates an Ethereum Transaction type from the msg fields
+// AsTransaction creates an Ethereum Transaction type from the msg fields.
+//
+// NOTE: go-ethereum can't represent set code transactions, which are returned as
+// dynamic fee transactions without the authorization list. Use TxHash,
+// MarshalBinary, GetSender and AsMessage, which support all the transaction types.
 func (msg MsgEthereumTx) AsTransaction() *ethtypes.Transaction {
 	txData, err := UnpackTxData(msg.Data)
 	if err != nil {
@@ -294,13 +343,80 @@ func (msg MsgEthereumTx) AsTransaction() *ethtypes.Transaction {
 	return ethtypes.NewTx(txData.AsEthereumData())
 }
 
-// AsMessage creates an Ethereum core.Message from the msg fields
+// TxHash returns the hash of the Ethereum transaction.
+func (msg MsgEthereumTx) TxHash() common.Hash {
+	if setCodeTx, ok := msg.setCodeTx(); ok {
+		return setCodeTx.Hash()
+	}
+
+	tx := msg.AsTransaction()
+	if tx == nil {
+		return common.Hash{}
+	}
+	return tx.Hash()
+}
+
+// MarshalBinary returns the canonical encoding of the Ethereum transaction.
+func (msg MsgEthereumTx) MarshalBinary() ([]byte, error) {
+	if setCodeTx, ok := msg.setCodeTx(); ok {
+		return setCodeTx.MarshalBinary()
+	}
+
+	tx := msg.AsTransaction()
+	if tx == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrUnpackAny, "failed to unpack tx data")
+	}
+	return tx.MarshalBinary()
+}
+
+// AsMessage creates an Ethereum core.Message from the msg fields. The messages of
+// set code transactions are SetCodeMessage, which carry the authorization list.
 func (msg MsgEthereumTx) AsMessage(signer ethtypes.Signer, baseFee *big.Int) (core.Message, error) {
-	return msg.AsTransaction().AsMessage(signer, baseFee)
+	setCodeTx, ok := msg.setCodeTx()
+	if !ok {
+		return msg.AsTransaction().AsMessage(signer, baseFee)
+	}
+
+	from, err := setCodeTx.Sender(signer.ChainID())
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice := setCodeTx.GetGasFeeCap()
+	if baseFee != nil {
+		gasPrice = setCodeTx.EffectiveGasPrice(baseFee)
+	}
+
+	return SetCodeMessage{
+		Message: ethtypes.NewMessage(
+			from,
+			setCodeTx.GetTo(),
+			setCodeTx.GetNonce(),
+			setCodeTx.GetValue(),
+			setCodeTx.GetGas(),
+			gasPrice,
+			setCodeTx.GetGasFeeCap(),
+			setCodeTx.GetGasTipCap(),
+			setCodeTx.GetData(),
+			setCodeTx.GetAccessList(),
+			false,
+		),
+		Authorizations: setCodeTx.GetAuthorizations(),
+	}, nil
 }
 
 // GetSender extracts the sender address from the signature values using the latest signer for the given chainID.
 func (msg *MsgEthereumTx) GetSender(chainID *big.Int) (common.Address, error) {
+	if setCodeTx, ok := msg.setCodeTx(); ok {
+		from, err := setCodeTx.Sender(chainID)
+		if err != nil {
+			return common.Address{}, err
+		}
+
+		msg.From = from.Hex()
+		return from, nil
+	}
+
 	signer := ethtypes.LatestSignerForChainID(chainID)
 	from, err := signer.Sender(msg.AsTransaction())
 	if err != nil {
@@ -318,6 +434,22 @@ func (msg MsgEthereumTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error
 
 // UnmarshalBinary decodes the canonical encoding of transactions.
 func (msg *MsgEthereumTx) UnmarshalBinary(b []byte) error {
+	if len(b) > 0 && b[0] == SetCodeTxType {
+		txData, err := NewSetCodeTx(b)
+		if err != nil {
+			return err
+		}
+
+		anyTxData, err := PackTxData(txData)
+		if err != nil {
+			return err
+		}
+
+		msg.Data = anyTxData
+		msg.Hash = txData.Hash().Hex()
+		return nil
+	}
+
 	tx := &ethtypes.Transaction{}
 	if err := tx.UnmarshalBinary(b); err != nil {
 		return err
@@ -325,6 +457,17 @@ func (msg *MsgEthereumTx) UnmarshalBinary(b []byte) error {
 	return msg.FromEthereumTx(tx)
 }
 
+// setCodeTx returns the tx data if the msg is a set code transaction.
+func (msg MsgEthereumTx) setCodeTx() (*SetCodeTx, bool) {
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, false
+	}
+
+	setCodeTx, ok := txData.(*SetCodeTx)
+	return setCodeTx, ok
+}
+
 // BuildTx builds the canonical cosmos tx from ethereum msg
 func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, evmDenom string) (signing.Tx, error) {
 	builder, ok := b.(authtx.ExtensionOptionsTxBuilder)
@@ -376,3 +519,58 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetFeeSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sponsor); err != nil {
+		return errorsmod.Wrap(err, "invalid sponsor address")
+	}
+
+	return m.Sponsorship.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetFeeSponsorship) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRevokeFeeSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sponsor); err != nil {
+		return errorsmod.Wrap(err, "invalid sponsor address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRevokeFeeSponsorship) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetFeeSponsor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	// an empty sponsor removes the previous one
+	if m.Sponsor == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Sponsor); err != nil {
+		return errorsmod.Wrap(err, "invalid sponsor address")
+	}
+
+	if m.Sponsor == m.Sender {
+		return errorsmod.Wrap(errortypes.ErrInvalidAddress, "sender cannot be its own sponsor")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetFeeSponsor) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -0,0 +1,63 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// StateOverride is the collection of overridden accounts.
+type StateOverride map[common.Address]OverrideAccount
+
+// OverrideAccount indicates the overriding fields of account during the execution of
+// a message call.
+// Note, state and stateDiff can't be specified at the same time. If state is
+// set, message execution will only use the data in the given state. Otherwise
+// if statDiff is set, all diff will be applied first and then execute the call
+// message.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64              `json:"nonce"`
+	Code      *hexutil.Bytes               `json:"code"`
+	Balance   **hexutil.Big                `json:"balance"`
+	State     *map[common.Hash]common.Hash `json:"state"`
+	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+// Validate performs a stateless validation of the state overrides.
+func (diff StateOverride) Validate() error {
+	for addr, account := range diff {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+		if account.Balance != nil && *account.Balance != nil && (*account.Balance).ToInt().Sign() < 0 {
+			return fmt.Errorf("account %s has a negative balance override", addr.Hex())
+		}
+	}
+	return nil
+}
+
+// BlockOverrides is a set of header fields to override during the execution of
+// a message call.
+type BlockOverrides struct {
+	Number   *hexutil.Big    `json:"number"`
+	Time     *hexutil.Uint64 `json:"time"`
+	Coinbase *common.Address `json:"coinbase"`
+	BaseFee  *hexutil.Big    `json:"baseFee"`
+}
+
+// Validate performs a stateless validation of the block overrides.
+func (diff BlockOverrides) Validate() error {
+	if diff.Number != nil && (diff.Number.ToInt().Sign() <= 0 || !diff.Number.ToInt().IsInt64()) {
+		return fmt.Errorf("invalid block number override %s", diff.Number)
+	}
+	if diff.Time != nil && uint64(*diff.Time) > uint64(1<<63-1) {
+		return fmt.Errorf("invalid block time override %d", uint64(*diff.Time))
+	}
+	if diff.BaseFee != nil && diff.BaseFee.ToInt().Sign() < 0 {
+		return fmt.Errorf("invalid base fee override %s", diff.BaseFee)
+	}
+	return nil
+}
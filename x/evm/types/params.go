@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"math/big"
 	"slices"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/gogoproto/proto"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 
@@ -20,7 +22,8 @@ import (
 var (
 	// DefaultAllowUnprotectedTxs rejects all unprotected txs (i.e false)
 	DefaultAllowUnprotectedTxs = false
-	// DefaultStaticPrecompiles defines the default active precompiles
+	// DefaultStaticPrecompiles defines the default active precompiles. The
+	// dispatch precompile is left out, so it has to be enabled explicitly.
 	DefaultStaticPrecompiles = []string{
 		P256PrecompileAddress,         // P256 precompile
 		Bech32PrecompileAddress,       // Bech32 precompile
@@ -30,6 +33,9 @@ var (
 		VestingPrecompileAddress,      // Vesting precompile
 		BankPrecompileAddress,         // Bank precompile
 		GovPrecompileAddress,          // Gov precompile
+		SlashingPrecompileAddress,     // Slashing precompile
+		AuthzPrecompileAddress,        // Authz precompile
+		FeegrantPrecompileAddress,     // Feegrant precompile
 	}
 	// DefaultExtraEIPs defines the default extra EIPs to be included
 	// On v15, EIP 3855 was enabled
@@ -39,6 +45,15 @@ var (
 		"channel-31", // Cronos
 		"channel-83", // Kava
 	}
+	// DefaultAllowedDispatchMsgs defines the default message types that can be
+	// dispatched by the dispatch precompile, none by default
+	DefaultAllowedDispatchMsgs []string
+	// DefaultMaxCallbackGas defines the default gas limit of the contract
+	// calls made by the IBC callbacks middleware
+	DefaultMaxCallbackGas uint64 = 1_000_000
+	// MaxCallbackGasCeiling defines the highest gas limit that can be set for
+	// the IBC callbacks, as their gas is charged to the relayers
+	MaxCallbackGasCeiling           uint64 = 10_000_000
 	DefaultCreateAllowlistAddresses []string
 	DefaultCallAllowlistAddresses   []string
 	DefaultAccessControl            = AccessControl{
@@ -60,6 +75,8 @@ func NewParams(
 	activeStaticPrecompiles,
 	evmChannels []string,
 	accessControl AccessControl,
+	allowedDispatchMsgs []string,
+	maxCallbackGas uint64,
 ) Params {
 	return Params{
 		AllowUnprotectedTxs:     allowUnprotectedTxs,
@@ -67,6 +84,8 @@ func NewParams(
 		ActiveStaticPrecompiles: activeStaticPrecompiles,
 		EVMChannels:             evmChannels,
 		AccessControl:           accessControl,
+		AllowedDispatchMsgs:     allowedDispatchMsgs,
+		MaxCallbackGas:          maxCallbackGas,
 	}
 }
 
@@ -78,6 +97,8 @@ func DefaultParams() Params {
 		ActiveStaticPrecompiles: DefaultStaticPrecompiles,
 		EVMChannels:             DefaultEVMChannels,
 		AccessControl:           DefaultAccessControl,
+		AllowedDispatchMsgs:     DefaultAllowedDispatchMsgs,
+		MaxCallbackGas:          DefaultMaxCallbackGas,
 	}
 }
 
@@ -117,6 +138,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateAllowedDispatchMsgs(p.AllowedDispatchMsgs); err != nil {
+		return err
+	}
+
+	if err := validateMaxCallbackGas(p.MaxCallbackGas); err != nil {
+		return err
+	}
+
 	return validateChannels(p.EVMChannels)
 }
 
@@ -143,6 +172,15 @@ func (p Params) IsEVMChannel(channel string) bool {
 	return slices.Contains(p.EVMChannels, channel)
 }
 
+// GetCallbackGasLimit returns the gas limit of the IBC callbacks. The default
+// limit applies when it's not set, i.e. on params stored before its addition.
+func (p Params) GetCallbackGasLimit() uint64 {
+	if p.MaxCallbackGas == 0 {
+		return DefaultMaxCallbackGas
+	}
+	return p.MaxCallbackGas
+}
+
 func (ac AccessControl) Validate() error {
 	if err := ac.Create.Validate(); err != nil {
 		return err
@@ -229,6 +267,49 @@ func validateEIPs(i interface{}) error {
 	return nil
 }
 
+// validateAllowedDispatchMsgs checks if the message type URLs allowed to be
+// dispatched are valid and unique. Ethereum transactions cannot be dispatched
+// from the EVM.
+func validateAllowedDispatchMsgs(i interface{}) error {
+	typeURLs, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid allowed dispatch msgs slice type: %T", i)
+	}
+
+	seenTypeURLs := make(map[string]struct{})
+	for _, typeURL := range typeURLs {
+		if len(typeURL) < 2 || !strings.HasPrefix(typeURL, "/") {
+			return fmt.Errorf("invalid message type URL: %q", typeURL)
+		}
+
+		if typeURL == "/"+proto.MessageName(&MsgEthereumTx{}) {
+			return fmt.Errorf("message type %s cannot be dispatched", typeURL)
+		}
+
+		if _, ok := seenTypeURLs[typeURL]; ok {
+			return fmt.Errorf("duplicate message type URL %s", typeURL)
+		}
+		seenTypeURLs[typeURL] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateMaxCallbackGas checks that the gas limit of the IBC callbacks doesn't
+// exceed the ceiling. A zero limit is valid and falls back to the default one.
+func validateMaxCallbackGas(i interface{}) error {
+	gas, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid max callback gas type: %T", i)
+	}
+
+	if gas > MaxCallbackGasCeiling {
+		return fmt.Errorf("max callback gas %d exceeds the ceiling %d", gas, MaxCallbackGasCeiling)
+	}
+
+	return nil
+}
+
 // ValidatePrecompiles checks if the precompile addresses are valid and unique.
 func ValidatePrecompiles(i interface{}) error {
 	precompiles, ok := i.([]string)
@@ -25,7 +25,7 @@ func TestParamsValidate(t *testing.T) {
 		},
 		{
 			name:    "valid",
-			params:  NewParams(false, extraEips, nil, nil, DefaultAccessControl),
+			params:  NewParams(false, extraEips, nil, nil, DefaultAccessControl, nil, DefaultMaxCallbackGas),
 			expPass: true,
 		},
 		{
@@ -45,6 +45,11 @@ func TestParamsValidate(t *testing.T) {
 			},
 			errContains: "precompiles need to be sorted",
 		},
+		{
+			name:        "max callback gas above the ceiling",
+			params:      NewParams(false, extraEips, nil, nil, DefaultAccessControl, nil, MaxCallbackGasCeiling+1),
+			errContains: "exceeds the ceiling",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -73,12 +78,21 @@ func TestParamsValidate(t *testing.T) {
 
 func TestParamsEIPs(t *testing.T) {
 	extraEips := []string{"ethereum_2929", "ethereum_1884", "ethereum_1344"}
-	params := NewParams(false, extraEips, nil, nil, DefaultAccessControl)
+	params := NewParams(false, extraEips, nil, nil, DefaultAccessControl, nil, DefaultMaxCallbackGas)
 	actual := params.EIPs()
 
 	require.Equal(t, []string{"ethereum_2929", "ethereum_1884", "ethereum_1344"}, actual)
 }
 
+func TestParamsCallbackGasLimit(t *testing.T) {
+	params := NewParams(false, nil, nil, nil, DefaultAccessControl, nil, 500_000)
+	require.Equal(t, uint64(500_000), params.GetCallbackGasLimit())
+
+	// params stored before the addition of the limit use the default one
+	params.MaxCallbackGas = 0
+	require.Equal(t, DefaultMaxCallbackGas, params.GetCallbackGasLimit())
+}
+
 func TestParamsValidatePriv(t *testing.T) {
 	require.Error(t, validateBool(""))
 	require.NoError(t, validateBool(true))
@@ -90,6 +104,16 @@ func TestParamsValidatePriv(t *testing.T) {
 	require.Error(t, validateChannels(false))
 	require.Error(t, validateChannels(int64(123)))
 	require.Error(t, validateChannels(""))
+	require.NoError(t, validateAllowedDispatchMsgs([]string{"/cosmos.bank.v1beta1.MsgSend"}))
+	require.Error(t, validateAllowedDispatchMsgs(false))
+	require.ErrorContains(t, validateAllowedDispatchMsgs([]string{"cosmos.bank.v1beta1.MsgSend"}), "invalid message type URL")
+	require.ErrorContains(t, validateAllowedDispatchMsgs([]string{"/"}), "invalid message type URL")
+	require.ErrorContains(t, validateAllowedDispatchMsgs([]string{"/ethermint.evm.v1.MsgEthereumTx"}), "cannot be dispatched")
+	require.ErrorContains(t, validateAllowedDispatchMsgs([]string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"}), "duplicate message type URL")
+	require.NoError(t, validateMaxCallbackGas(uint64(0)))
+	require.NoError(t, validateMaxCallbackGas(MaxCallbackGasCeiling))
+	require.ErrorContains(t, validateMaxCallbackGas(MaxCallbackGasCeiling+1), "exceeds the ceiling")
+	require.Error(t, validateMaxCallbackGas(int64(1)))
 }
 
 func TestIsLondon(t *testing.T) {
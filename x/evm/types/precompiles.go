@@ -15,6 +15,10 @@ const (
 	VestingPrecompileAddress      = "0x0000000000000000000000000000000000000803"
 	BankPrecompileAddress         = "0x0000000000000000000000000000000000000804"
 	GovPrecompileAddress          = "0x0000000000000000000000000000000000000805"
+	SlashingPrecompileAddress     = "0x0000000000000000000000000000000000000806"
+	AuthzPrecompileAddress        = "0x0000000000000000000000000000000000000807"
+	FeegrantPrecompileAddress     = "0x0000000000000000000000000000000000000808"
+	DispatchPrecompileAddress     = "0x0000000000000000000000000000000000000809"
 )
 
 // AvailableStaticPrecompiles defines the full list of all available EVM extension addresses.
@@ -30,4 +34,8 @@ var AvailableStaticPrecompiles = []string{
 	VestingPrecompileAddress,
 	BankPrecompileAddress,
 	GovPrecompileAddress,
+	SlashingPrecompileAddress,
+	AuthzPrecompileAddress,
+	FeegrantPrecompileAddress,
+	DispatchPrecompileAddress,
 }
@@ -798,6 +798,12 @@ type EthCallRequest struct {
 	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
 	// chain_id is the eip155 chain id parsed from the requested block header
 	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// overrides is the JSON-encoded set of account state overrides (balance, nonce,
+	// code, state and stateDiff) applied before executing the call.
+	Overrides []byte `protobuf:"bytes,5,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block_overrides is the JSON-encoded set of block context overrides (number,
+	// time, coinbase and baseFee) applied before executing the call.
+	BlockOverrides []byte `protobuf:"bytes,6,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
 }
 
 func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
@@ -861,6 +867,20 @@ func (m *EthCallRequest) GetChainId() int64 {
 	return 0
 }
 
+func (m *EthCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *EthCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
 // EstimateGasResponse defines EstimateGas response
 type EstimateGasResponse struct {
 	// gas returns the estimated gas
@@ -926,6 +946,127 @@ func (m *EstimateGasResponse) GetVmError() string {
 	return ""
 }
 
+// SimulateV1Request defines SimulateV1 request
+type SimulateV1Request struct {
+	// opts is the JSON-encoded set of simulated blocks and flags, using the same
+	// format as the `eth_simulateV1` json rpc api.
+	Opts []byte `protobuf:"bytes,1,opt,name=opts,proto3" json:"opts,omitempty"`
+	// gas_cap defines the gas cap shared by all the simulated calls
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *SimulateV1Request) Reset()         { *m = SimulateV1Request{} }
+func (m *SimulateV1Request) String() string { return proto.CompactTextString(m) }
+func (*SimulateV1Request) ProtoMessage()    {}
+func (*SimulateV1Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{18}
+}
+func (m *SimulateV1Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateV1Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateV1Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateV1Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateV1Request.Merge(m, src)
+}
+func (m *SimulateV1Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateV1Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateV1Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateV1Request proto.InternalMessageInfo
+
+func (m *SimulateV1Request) GetOpts() []byte {
+	if m != nil {
+		return m.Opts
+	}
+	return nil
+}
+
+func (m *SimulateV1Request) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *SimulateV1Request) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *SimulateV1Request) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+// SimulateV1Response defines SimulateV1 response
+type SimulateV1Response struct {
+	// result is the JSON-encoded list of simulated blocks with the results of
+	// their calls
+	Result []byte `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *SimulateV1Response) Reset()         { *m = SimulateV1Response{} }
+func (m *SimulateV1Response) String() string { return proto.CompactTextString(m) }
+func (*SimulateV1Response) ProtoMessage()    {}
+func (*SimulateV1Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{19}
+}
+func (m *SimulateV1Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateV1Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateV1Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateV1Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateV1Response.Merge(m, src)
+}
+func (m *SimulateV1Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateV1Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateV1Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateV1Response proto.InternalMessageInfo
+
+func (m *SimulateV1Response) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
 // QueryTraceTxRequest defines TraceTx request
 type QueryTraceTxRequest struct {
 	// msg is the MsgEthereumTx for the requested transaction
@@ -953,7 +1094,7 @@ func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
 func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxRequest) ProtoMessage()    {}
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{18}
+	return fileDescriptor_e15a877459347994, []int{20}
 }
 func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1055,7 +1196,7 @@ func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
 func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxResponse) ProtoMessage()    {}
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{19}
+	return fileDescriptor_e15a877459347994, []int{21}
 }
 func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1115,7 +1256,7 @@ func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{}
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{20}
+	return fileDescriptor_e15a877459347994, []int{22}
 }
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1210,7 +1351,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{21}
+	return fileDescriptor_e15a877459347994, []int{23}
 }
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1246,6 +1387,154 @@ func (m *QueryTraceBlockResponse) GetData() []byte {
 	return nil
 }
 
+// QueryTraceCallRequest defines TraceCall request
+type QueryTraceCallRequest struct {
+	// args uses the same json format as the json rpc api.
+	Args []byte `protobuf:"bytes,1,opt,name=args,proto3" json:"args,omitempty"`
+	// gas_cap defines the default gas cap to be used
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// trace_config holds extra parameters to trace functions.
+	TraceConfig *TraceConfig `protobuf:"bytes,5,opt,name=trace_config,json=traceConfig,proto3" json:"trace_config,omitempty"`
+	// overrides is the JSON-encoded set of account state overrides (balance, nonce,
+	// code, state and stateDiff) applied before tracing the call.
+	Overrides []byte `protobuf:"bytes,6,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block_overrides is the JSON-encoded set of block context overrides (number,
+	// time, coinbase and baseFee) applied before tracing the call.
+	BlockOverrides []byte `protobuf:"bytes,7,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
+}
+
+func (m *QueryTraceCallRequest) Reset()         { *m = QueryTraceCallRequest{} }
+func (m *QueryTraceCallRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallRequest) ProtoMessage()    {}
+func (*QueryTraceCallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{24}
+}
+func (m *QueryTraceCallRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceCallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceCallRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceCallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallRequest.Merge(m, src)
+}
+func (m *QueryTraceCallRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceCallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceCallRequest proto.InternalMessageInfo
+
+func (m *QueryTraceCallRequest) GetArgs() []byte {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QueryTraceCallRequest) GetTraceConfig() *TraceConfig {
+	if m != nil {
+		return m.TraceConfig
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *QueryTraceCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
+// QueryTraceCallResponse defines TraceCall response
+type QueryTraceCallResponse struct {
+	// data is the response serialized in bytes
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryTraceCallResponse) Reset()         { *m = QueryTraceCallResponse{} }
+func (m *QueryTraceCallResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceCallResponse) ProtoMessage()    {}
+func (*QueryTraceCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{25}
+}
+func (m *QueryTraceCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceCallResponse.Merge(m, src)
+}
+func (m *QueryTraceCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceCallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceCallResponse proto.InternalMessageInfo
+
+func (m *QueryTraceCallResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // QueryBaseFeeRequest defines the request type for querying the EIP1559 base
 // fee.
 type QueryBaseFeeRequest struct {
@@ -1255,7 +1544,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{22}
+	return fileDescriptor_e15a877459347994, []int{26}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1294,7 +1583,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{23}
+	return fileDescriptor_e15a877459347994, []int{27}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1331,7 +1620,7 @@ func (m *QueryGlobalMinGasPriceRequest) Reset()         { *m = QueryGlobalMinGas
 func (m *QueryGlobalMinGasPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalMinGasPriceRequest) ProtoMessage()    {}
 func (*QueryGlobalMinGasPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{24}
+	return fileDescriptor_e15a877459347994, []int{28}
 }
 func (m *QueryGlobalMinGasPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1370,7 +1659,7 @@ func (m *QueryGlobalMinGasPriceResponse) Reset()         { *m = QueryGlobalMinGa
 func (m *QueryGlobalMinGasPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalMinGasPriceResponse) ProtoMessage()    {}
 func (*QueryGlobalMinGasPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{25}
+	return fileDescriptor_e15a877459347994, []int{29}
 }
 func (m *QueryGlobalMinGasPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1407,7 +1696,7 @@ func (m *QueryConfigRequest) Reset()         { *m = QueryConfigRequest{} }
 func (m *QueryConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryConfigRequest) ProtoMessage()    {}
 func (*QueryConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{26}
+	return fileDescriptor_e15a877459347994, []int{30}
 }
 func (m *QueryConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1446,7 +1735,7 @@ func (m *QueryConfigResponse) Reset()         { *m = QueryConfigResponse{} }
 func (m *QueryConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryConfigResponse) ProtoMessage()    {}
 func (*QueryConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{27}
+	return fileDescriptor_e15a877459347994, []int{31}
 }
 func (m *QueryConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1482,6 +1771,99 @@ func (m *QueryConfigResponse) GetConfig() *ChainConfig {
 	return nil
 }
 
+// QueryFeeSponsorshipRequest defines the request type for querying the fee
+// sponsorship of a sponsor.
+type QueryFeeSponsorshipRequest struct {
+	// sponsor is the bech32 address of the sponsor
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+}
+
+func (m *QueryFeeSponsorshipRequest) Reset()         { *m = QueryFeeSponsorshipRequest{} }
+func (m *QueryFeeSponsorshipRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSponsorshipRequest) ProtoMessage()    {}
+func (*QueryFeeSponsorshipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{32}
+}
+func (m *QueryFeeSponsorshipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSponsorshipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSponsorshipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeSponsorshipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSponsorshipRequest.Merge(m, src)
+}
+func (m *QueryFeeSponsorshipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSponsorshipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSponsorshipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeSponsorshipRequest proto.InternalMessageInfo
+
+func (m *QueryFeeSponsorshipRequest) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+// QueryFeeSponsorshipResponse returns the fee sponsorship of a sponsor.
+type QueryFeeSponsorshipResponse struct {
+	// sponsorship is the allowance and scope of the sponsorship
+	Sponsorship FeeSponsorship `protobuf:"bytes,1,opt,name=sponsorship,proto3" json:"sponsorship"`
+}
+
+func (m *QueryFeeSponsorshipResponse) Reset()         { *m = QueryFeeSponsorshipResponse{} }
+func (m *QueryFeeSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSponsorshipResponse) ProtoMessage()    {}
+func (*QueryFeeSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{33}
+}
+func (m *QueryFeeSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSponsorshipResponse.Merge(m, src)
+}
+func (m *QueryFeeSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeSponsorshipResponse proto.InternalMessageInfo
+
+func (m *QueryFeeSponsorshipResponse) GetSponsorship() FeeSponsorship {
+	if m != nil {
+		return m.Sponsorship
+	}
+	return FeeSponsorship{}
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountRequest)(nil), "ethermint.evm.v1.QueryAccountRequest")
 	proto.RegisterType((*QueryAccountResponse)(nil), "ethermint.evm.v1.QueryAccountResponse")
@@ -1501,125 +1883,146 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.evm.v1.QueryParamsResponse")
 	proto.RegisterType((*EthCallRequest)(nil), "ethermint.evm.v1.EthCallRequest")
 	proto.RegisterType((*EstimateGasResponse)(nil), "ethermint.evm.v1.EstimateGasResponse")
+	proto.RegisterType((*SimulateV1Request)(nil), "ethermint.evm.v1.SimulateV1Request")
+	proto.RegisterType((*SimulateV1Response)(nil), "ethermint.evm.v1.SimulateV1Response")
 	proto.RegisterType((*QueryTraceTxRequest)(nil), "ethermint.evm.v1.QueryTraceTxRequest")
 	proto.RegisterType((*QueryTraceTxResponse)(nil), "ethermint.evm.v1.QueryTraceTxResponse")
 	proto.RegisterType((*QueryTraceBlockRequest)(nil), "ethermint.evm.v1.QueryTraceBlockRequest")
 	proto.RegisterType((*QueryTraceBlockResponse)(nil), "ethermint.evm.v1.QueryTraceBlockResponse")
+	proto.RegisterType((*QueryTraceCallRequest)(nil), "ethermint.evm.v1.QueryTraceCallRequest")
+	proto.RegisterType((*QueryTraceCallResponse)(nil), "ethermint.evm.v1.QueryTraceCallResponse")
 	proto.RegisterType((*QueryBaseFeeRequest)(nil), "ethermint.evm.v1.QueryBaseFeeRequest")
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.evm.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryGlobalMinGasPriceRequest)(nil), "ethermint.evm.v1.QueryGlobalMinGasPriceRequest")
 	proto.RegisterType((*QueryGlobalMinGasPriceResponse)(nil), "ethermint.evm.v1.QueryGlobalMinGasPriceResponse")
 	proto.RegisterType((*QueryConfigRequest)(nil), "ethermint.evm.v1.QueryConfigRequest")
 	proto.RegisterType((*QueryConfigResponse)(nil), "ethermint.evm.v1.QueryConfigResponse")
+	proto.RegisterType((*QueryFeeSponsorshipRequest)(nil), "ethermint.evm.v1.QueryFeeSponsorshipRequest")
+	proto.RegisterType((*QueryFeeSponsorshipResponse)(nil), "ethermint.evm.v1.QueryFeeSponsorshipResponse")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/query.proto", fileDescriptor_e15a877459347994) }
 
 var fileDescriptor_e15a877459347994 = []byte{
-	// 1637 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0x13, 0x47,
-	0x1b, 0xce, 0xc6, 0x4e, 0xec, 0x8c, 0x13, 0x08, 0x43, 0x00, 0xc7, 0x24, 0x76, 0x58, 0xc8, 0x0f,
-	0xf8, 0x60, 0x37, 0xc9, 0xf7, 0x7d, 0x48, 0xdf, 0xd7, 0x43, 0x49, 0xa2, 0x10, 0x28, 0x50, 0xd1,
-	0x6d, 0xd4, 0x43, 0xa5, 0xca, 0x1a, 0xaf, 0x87, 0xf5, 0x2a, 0xde, 0x1d, 0xb3, 0x33, 0xb6, 0x1c,
-	0x10, 0x87, 0xa2, 0xaa, 0x2d, 0xea, 0x05, 0xa9, 0xb7, 0xf6, 0xc2, 0xb1, 0x52, 0x2f, 0xbd, 0xf1,
-	0x2f, 0x70, 0x44, 0xed, 0xa5, 0xea, 0x81, 0x56, 0x50, 0xa9, 0xfd, 0x1b, 0x7a, 0xaa, 0xe6, 0xc7,
-	0xda, 0xbb, 0xb6, 0x37, 0x0e, 0x15, 0xbd, 0xf5, 0x92, 0xec, 0xcc, 0xbc, 0xef, 0xfb, 0x3c, 0xf3,
-	0xce, 0x3b, 0xf3, 0x3e, 0x06, 0x73, 0x98, 0xd5, 0x70, 0xe0, 0xb9, 0x3e, 0x33, 0x71, 0xcb, 0x33,
-	0x5b, 0x6b, 0xe6, 0xdd, 0x26, 0x0e, 0xf6, 0x8d, 0x46, 0x40, 0x18, 0x81, 0xd3, 0x9d, 0x55, 0x03,
-	0xb7, 0x3c, 0xa3, 0xb5, 0x56, 0x38, 0x86, 0x3c, 0xd7, 0x27, 0xa6, 0xf8, 0x2b, 0x8d, 0x0a, 0x17,
-	0x6c, 0x42, 0x3d, 0x42, 0xcd, 0x0a, 0xa2, 0x58, 0x7a, 0x9b, 0xad, 0xb5, 0x0a, 0x66, 0x68, 0xcd,
-	0x6c, 0x20, 0xc7, 0xf5, 0x11, 0x73, 0x89, 0xaf, 0x6c, 0x0b, 0x7d, 0x70, 0x3c, 0xae, 0x5c, 0x9b,
-	0xed, 0x5b, 0x63, 0x6d, 0xb5, 0x34, 0xe3, 0x10, 0x87, 0x88, 0x4f, 0x93, 0x7f, 0xa9, 0xd9, 0x39,
-	0x87, 0x10, 0xa7, 0x8e, 0x4d, 0xd4, 0x70, 0x4d, 0xe4, 0xfb, 0x84, 0x09, 0x24, 0xaa, 0x56, 0x4b,
-	0x6a, 0x55, 0x8c, 0x2a, 0xcd, 0x3b, 0x26, 0x73, 0x3d, 0x4c, 0x19, 0xf2, 0x1a, 0xd2, 0x40, 0xff,
-	0x1f, 0x38, 0xfe, 0x1e, 0x67, 0xbb, 0x61, 0xdb, 0xa4, 0xe9, 0x33, 0x0b, 0xdf, 0x6d, 0x62, 0xca,
-	0x60, 0x1e, 0x64, 0x50, 0xb5, 0x1a, 0x60, 0x4a, 0xf3, 0xda, 0x82, 0xb6, 0x32, 0x61, 0x85, 0xc3,
-	0xff, 0x67, 0x3f, 0x7f, 0x52, 0x1a, 0xf9, 0xfd, 0x49, 0x69, 0x44, 0xb7, 0xc1, 0x4c, 0xdc, 0x95,
-	0x36, 0x88, 0x4f, 0x31, 0xf7, 0xad, 0xa0, 0x3a, 0xf2, 0x6d, 0x1c, 0xfa, 0xaa, 0x21, 0x3c, 0x0d,
-	0x26, 0x6c, 0x52, 0xc5, 0xe5, 0x1a, 0xa2, 0xb5, 0xfc, 0xa8, 0x58, 0xcb, 0xf2, 0x89, 0x6b, 0x88,
-	0xd6, 0xe0, 0x0c, 0x18, 0xf3, 0x09, 0x77, 0x4a, 0x2d, 0x68, 0x2b, 0x69, 0x4b, 0x0e, 0xf4, 0xb7,
-	0xc1, 0xac, 0x00, 0xd9, 0x12, 0xe9, 0xfd, 0x0b, 0x2c, 0x3f, 0xd5, 0x40, 0x61, 0x50, 0x04, 0x45,
-	0x76, 0x11, 0x1c, 0x91, 0x27, 0x57, 0x8e, 0x47, 0x9a, 0x92, 0xb3, 0x1b, 0x72, 0x12, 0x16, 0x40,
-	0x96, 0x72, 0x50, 0xce, 0x6f, 0x54, 0xf0, 0xeb, 0x8c, 0x79, 0x08, 0x24, 0xa3, 0x96, 0xfd, 0xa6,
-	0x57, 0xc1, 0x81, 0xda, 0xc1, 0x94, 0x9a, 0x7d, 0x57, 0x4c, 0xea, 0x37, 0xc0, 0x9c, 0xe0, 0xf1,
-	0x01, 0xaa, 0xbb, 0x55, 0xc4, 0x48, 0xd0, 0xb3, 0x99, 0x33, 0x60, 0xd2, 0x26, 0x7e, 0x2f, 0x8f,
-	0x1c, 0x9f, 0xdb, 0xe8, 0xdb, 0xd5, 0x17, 0x1a, 0x98, 0x4f, 0x88, 0xa6, 0x36, 0xb6, 0x0c, 0x8e,
-	0x86, 0xac, 0xe2, 0x11, 0x43, 0xb2, 0x6f, 0x70, 0x6b, 0x61, 0x11, 0x6d, 0xca, 0x73, 0x7e, 0x9d,
-	0xe3, 0x59, 0x55, 0x45, 0xd4, 0x71, 0x1d, 0x56, 0x44, 0xfa, 0x0d, 0x05, 0xf6, 0x3e, 0x23, 0x01,
-	0x72, 0x86, 0x83, 0xc1, 0x69, 0x90, 0xda, 0xc3, 0xfb, 0xaa, 0xde, 0xf8, 0x67, 0x04, 0xfe, 0xa2,
-	0x82, 0xef, 0x04, 0x53, 0xf0, 0x33, 0x60, 0xac, 0x85, 0xea, 0xcd, 0x10, 0x5c, 0x0e, 0xf4, 0xcb,
-	0x60, 0x5a, 0x95, 0x52, 0xf5, 0xb5, 0x36, 0xb9, 0x0c, 0x8e, 0x45, 0xfc, 0x14, 0x04, 0x04, 0x69,
-	0x5e, 0xfb, 0xc2, 0x6b, 0xd2, 0x12, 0xdf, 0xfa, 0x3d, 0x00, 0x85, 0xe1, 0x6e, 0xfb, 0x26, 0x71,
-	0x68, 0x08, 0x01, 0x41, 0x5a, 0xdc, 0x18, 0x19, 0x5f, 0x7c, 0xc3, 0xab, 0x00, 0x74, 0xdf, 0x15,
-	0xb1, 0xb7, 0xdc, 0xfa, 0x92, 0x21, 0x8b, 0xd6, 0xe0, 0x8f, 0x90, 0x21, 0x9f, 0x30, 0xf5, 0x08,
-	0x19, 0xb7, 0xbb, 0xa9, 0xb2, 0x22, 0x9e, 0x11, 0x92, 0x8f, 0x34, 0x95, 0xd8, 0x10, 0x5c, 0xf1,
-	0x3c, 0x0f, 0xd2, 0x75, 0xe2, 0xf0, 0xdd, 0xa5, 0x56, 0x72, 0xeb, 0x27, 0x8c, 0xde, 0xd7, 0xd0,
-	0xb8, 0x49, 0x1c, 0x4b, 0x98, 0xc0, 0x9d, 0x01, 0xa4, 0x96, 0x87, 0x92, 0x92, 0x38, 0x51, 0x56,
-	0xfa, 0x8c, 0xca, 0xc3, 0x6d, 0x14, 0x20, 0x2f, 0xcc, 0x83, 0x6e, 0x29, 0x82, 0xe1, 0xac, 0x22,
-	0xf8, 0x16, 0x18, 0x6f, 0x88, 0x19, 0x91, 0xa0, 0xdc, 0x7a, 0xbe, 0x9f, 0xa2, 0xf4, 0xd8, 0x9c,
-	0x78, 0xf6, 0xa2, 0x34, 0xf2, 0xcd, 0x6f, 0xdf, 0x5d, 0xd0, 0x2c, 0xe5, 0xa2, 0x3f, 0xd5, 0xc0,
-	0x91, 0x6d, 0x56, 0xdb, 0x42, 0xf5, 0x7a, 0x24, 0xdd, 0x28, 0x70, 0x68, 0x78, 0x30, 0xfc, 0x1b,
-	0x9e, 0x02, 0x19, 0x07, 0xd1, 0xb2, 0x8d, 0x1a, 0xea, 0x8e, 0x8c, 0x3b, 0x88, 0x6e, 0xa1, 0x06,
-	0xfc, 0x08, 0x4c, 0x37, 0x02, 0xd2, 0x20, 0x14, 0x07, 0x9d, 0x7b, 0xc6, 0xef, 0xc8, 0xe4, 0xe6,
-	0xfa, 0x1f, 0x2f, 0x4a, 0x86, 0xe3, 0xb2, 0x5a, 0xb3, 0x62, 0xd8, 0xc4, 0x33, 0x55, 0x83, 0x90,
-	0xff, 0x2e, 0xd1, 0xea, 0x9e, 0xc9, 0xf6, 0x1b, 0x98, 0x1a, 0x5b, 0xdd, 0x0b, 0x6e, 0x1d, 0x0d,
-	0x63, 0x85, 0x97, 0x73, 0x16, 0x64, 0xed, 0x1a, 0x72, 0xfd, 0xb2, 0x5b, 0xcd, 0xa7, 0x17, 0xb4,
-	0x95, 0x94, 0x95, 0x11, 0xe3, 0xeb, 0x55, 0x7d, 0x17, 0x1c, 0xdf, 0xa6, 0xcc, 0xf5, 0x10, 0xc3,
-	0x3b, 0xa8, 0x9b, 0x8d, 0x69, 0x90, 0x72, 0x90, 0x24, 0x9f, 0xb6, 0xf8, 0x27, 0x9f, 0x09, 0x30,
-	0x13, 0xbc, 0x27, 0x2d, 0xfe, 0xc9, 0xa3, 0xb6, 0xbc, 0x32, 0x0e, 0x02, 0x22, 0x2f, 0xf4, 0x84,
-	0x95, 0x69, 0x79, 0xdb, 0x7c, 0xa8, 0x3f, 0x4a, 0x87, 0x55, 0x10, 0x20, 0x1b, 0xef, 0xb6, 0xc3,
-	0xa4, 0xac, 0x81, 0x94, 0x47, 0x1d, 0x95, 0xe1, 0x52, 0x7f, 0x86, 0x6f, 0x51, 0x67, 0x9b, 0xcf,
-	0xe1, 0xa6, 0xb7, 0xdb, 0xb6, 0xb8, 0x2d, 0xbc, 0x02, 0x26, 0x19, 0x0f, 0x52, 0xb6, 0x89, 0x7f,
-	0xc7, 0x75, 0x04, 0x52, 0x6e, 0x7d, 0xbe, 0xdf, 0x57, 0x40, 0x6d, 0x09, 0x23, 0x2b, 0xc7, 0xba,
-	0x03, 0xb8, 0x05, 0x26, 0x1b, 0x01, 0xae, 0x62, 0x1b, 0x53, 0x4a, 0x02, 0x9a, 0x4f, 0x8b, 0x12,
-	0x1c, 0x8a, 0x1e, 0x73, 0xe2, 0xef, 0x6a, 0xa5, 0x4e, 0xec, 0xbd, 0xf0, 0x05, 0x1b, 0x13, 0x69,
-	0xcc, 0x89, 0x39, 0xf9, 0x7e, 0xc1, 0x79, 0x00, 0xa4, 0x89, 0xb8, 0x66, 0xe3, 0x22, 0x23, 0x13,
-	0x62, 0x46, 0x74, 0xa6, 0x6b, 0xe1, 0x32, 0x6f, 0x9e, 0xf9, 0x8c, 0xd8, 0x46, 0xc1, 0x90, 0x9d,
-	0xd5, 0x08, 0x3b, 0xab, 0xb1, 0x1b, 0x76, 0xd6, 0xcd, 0x29, 0x5e, 0x66, 0x8f, 0x7f, 0x2e, 0x69,
-	0xb2, 0xd4, 0x64, 0x24, 0xbe, 0x3c, 0xb0, 0x5a, 0xb2, 0x7f, 0x4f, 0xb5, 0x4c, 0xc4, 0xaa, 0x05,
-	0xea, 0x60, 0x4a, 0xee, 0xc1, 0x43, 0xed, 0x32, 0x2f, 0x10, 0x10, 0x49, 0xc3, 0x2d, 0xd4, 0xde,
-	0x41, 0xf4, 0x9d, 0x74, 0x76, 0x74, 0x3a, 0x65, 0x65, 0x59, 0xbb, 0xec, 0xfa, 0x55, 0xdc, 0xd6,
-	0x2f, 0xa8, 0xc7, 0xb1, 0x53, 0x0a, 0xdd, 0x97, 0xab, 0x8a, 0x18, 0x0a, 0x2f, 0x08, 0xff, 0xd6,
-	0x9f, 0xa6, 0xc0, 0xc9, 0xae, 0xf1, 0x26, 0x8f, 0x1a, 0x29, 0x1d, 0xd6, 0x0e, 0xdf, 0x8f, 0xe1,
-	0xa5, 0xc3, 0xda, 0xf4, 0x0d, 0x94, 0xce, 0x3f, 0xa7, 0x7e, 0xc8, 0x53, 0xd7, 0x2f, 0x81, 0x53,
-	0x7d, 0x07, 0x77, 0xc0, 0x41, 0x9f, 0xe8, 0xf4, 0x7a, 0x8a, 0xaf, 0xe2, 0xb0, 0xa7, 0xe8, 0x37,
-	0x3b, 0x7d, 0x5c, 0x4d, 0xab, 0x10, 0xff, 0x01, 0x59, 0xfe, 0xf0, 0x97, 0xef, 0x60, 0xd5, 0x4b,
-	0x37, 0x67, 0x7f, 0x7a, 0x51, 0x3a, 0x21, 0x77, 0x48, 0xab, 0x7b, 0x86, 0x4b, 0x4c, 0x0f, 0xb1,
-	0x9a, 0x71, 0xdd, 0x67, 0xbc, 0xc7, 0x0b, 0x6f, 0xbd, 0xa4, 0xd4, 0xcd, 0x4e, 0x9d, 0x54, 0x50,
-	0xfd, 0x96, 0xeb, 0xef, 0x20, 0x7a, 0x3b, 0x70, 0x3b, 0xd2, 0x42, 0xb7, 0x41, 0x31, 0xc9, 0x40,
-	0x01, 0x6f, 0x80, 0x29, 0xcf, 0xf5, 0xf9, 0xa6, 0xcb, 0x0d, 0xbe, 0xa0, 0xd0, 0xe7, 0xf9, 0x29,
-	0x25, 0x33, 0xc8, 0x79, 0xdd, 0x50, 0x9d, 0x2e, 0xa4, 0xea, 0xab, 0xb3, 0xd3, 0xe3, 0xb1, 0x59,
-	0x85, 0xf7, 0x5f, 0x30, 0xae, 0x8a, 0x55, 0x4b, 0x2a, 0xd6, 0x2d, 0x7e, 0x2a, 0xca, 0x4d, 0x19,
-	0xaf, 0x7f, 0x7f, 0x04, 0x8c, 0x89, 0x70, 0xf0, 0x63, 0x0d, 0x64, 0x94, 0x88, 0x83, 0x8b, 0xfd,
-	0xce, 0x03, 0x54, 0x7a, 0x61, 0x69, 0x98, 0x99, 0xe4, 0xa6, 0x2f, 0x3f, 0xfc, 0xe1, 0xd7, 0x2f,
-	0x47, 0xcf, 0xc0, 0x12, 0xff, 0x4d, 0x41, 0x68, 0xf8, 0xcb, 0x42, 0x89, 0x38, 0xf3, 0xbe, 0x2a,
-	0xca, 0x07, 0xf0, 0x2b, 0x0d, 0x4c, 0xc5, 0x74, 0x32, 0xfc, 0x57, 0x02, 0xc4, 0x20, 0x3d, 0x5e,
-	0xb8, 0x78, 0x38, 0x63, 0xc5, 0xca, 0x10, 0xac, 0x56, 0xe0, 0x52, 0x9c, 0x55, 0x28, 0xc7, 0xfb,
-	0xc8, 0x7d, 0xab, 0x81, 0xe9, 0x5e, 0xb9, 0x0b, 0x8d, 0x04, 0xc8, 0x04, 0x95, 0x5d, 0x30, 0x0f,
-	0x6d, 0xaf, 0x58, 0x5e, 0x16, 0x2c, 0x57, 0xa1, 0x11, 0x67, 0xd9, 0x0a, 0xed, 0xbb, 0x44, 0xa3,
-	0xea, 0xfd, 0x01, 0x7c, 0xa8, 0x81, 0x8c, 0x12, 0xb5, 0x89, 0xc7, 0x19, 0xd7, 0xcb, 0x89, 0xc7,
-	0xd9, 0xa3, 0x8d, 0xf5, 0x15, 0x41, 0x49, 0x87, 0x0b, 0x71, 0x4a, 0x4a, 0x20, 0xd3, 0x48, 0xca,
-	0x3e, 0xd3, 0x40, 0x46, 0x49, 0xdb, 0x44, 0x12, 0x71, 0x1d, 0x9d, 0x48, 0xa2, 0x47, 0x21, 0xeb,
-	0x97, 0x04, 0x89, 0x65, 0xb8, 0x18, 0x27, 0x41, 0xa5, 0x59, 0x97, 0x83, 0x79, 0x7f, 0x0f, 0xef,
-	0x3f, 0x80, 0x2d, 0x90, 0xe6, 0xea, 0x17, 0xea, 0x89, 0x25, 0xd2, 0x91, 0xd4, 0x85, 0xb3, 0x07,
-	0xda, 0x28, 0xfc, 0x45, 0x81, 0x5f, 0x82, 0xf3, 0xbd, 0xd5, 0x53, 0x8d, 0x65, 0x80, 0x82, 0x71,
-	0x29, 0xfe, 0xe0, 0xb9, 0x84, 0xa8, 0x31, 0x8d, 0x59, 0x58, 0x1c, 0x62, 0xa5, 0xd0, 0xe7, 0x04,
-	0xfa, 0x49, 0x38, 0x13, 0x47, 0x97, 0xa2, 0x12, 0x32, 0x90, 0x51, 0x9a, 0x12, 0x2e, 0xf4, 0xc7,
-	0x8b, 0xcb, 0xcd, 0xc2, 0xf2, 0xb0, 0x8e, 0x18, 0x62, 0x16, 0x05, 0x66, 0x1e, 0x9e, 0x8c, 0x63,
-	0x62, 0x56, 0x2b, 0xdb, 0x1c, 0xea, 0x1e, 0xc8, 0x45, 0x04, 0xe1, 0x21, 0x90, 0x07, 0xec, 0x75,
-	0x80, 0xa2, 0xd4, 0x75, 0x81, 0x3b, 0x07, 0x0b, 0x3d, 0xb8, 0xca, 0x94, 0x3f, 0xb1, 0xb0, 0x0d,
-	0x32, 0x4a, 0x25, 0x24, 0xd6, 0x59, 0x5c, 0x50, 0x26, 0xd6, 0x59, 0x8f, 0xd8, 0x48, 0xda, 0xb5,
-	0x94, 0x07, 0xac, 0x0d, 0x3f, 0xd1, 0x00, 0xe8, 0xb6, 0x2e, 0xb8, 0x72, 0x50, 0xd8, 0xa8, 0x2c,
-	0x29, 0x9c, 0x3f, 0x84, 0xa5, 0xe2, 0x70, 0x46, 0x70, 0x38, 0x0d, 0x67, 0x07, 0x71, 0x10, 0xbd,
-	0x94, 0x27, 0x40, 0xb5, 0xbe, 0x03, 0x6e, 0x7b, 0xb4, 0x63, 0x1e, 0x70, 0xdb, 0x63, 0x1d, 0x34,
-	0x29, 0x01, 0x61, 0x57, 0x85, 0x5f, 0x6b, 0xe0, 0x58, 0x5f, 0x1b, 0x84, 0x49, 0xef, 0x5c, 0x52,
-	0x47, 0x2d, 0xac, 0x1e, 0xde, 0x41, 0x11, 0x3b, 0x2b, 0x88, 0xcd, 0xc3, 0xd3, 0x71, 0x62, 0xb1,
-	0xae, 0xcb, 0xef, 0x9f, 0x52, 0x64, 0xe7, 0x12, 0x6f, 0x75, 0xa4, 0xbb, 0x26, 0xde, 0xbf, 0x78,
-	0xb7, 0x4d, 0xba, 0x7f, 0xb2, 0xa9, 0x6e, 0x5e, 0x79, 0xf6, 0xb2, 0xa8, 0x3d, 0x7f, 0x59, 0xd4,
-	0x7e, 0x79, 0x59, 0xd4, 0x1e, 0xbf, 0x2a, 0x8e, 0x3c, 0x7f, 0x55, 0x1c, 0xf9, 0xf1, 0x55, 0x71,
-	0xe4, 0xc3, 0xa5, 0x88, 0xd8, 0xea, 0x78, 0x12, 0x6a, 0xb6, 0xd6, 0x57, 0xcd, 0xb6, 0x88, 0x22,
-	0x04, 0x57, 0x65, 0x5c, 0x08, 0xbc, 0x7f, 0xff, 0x19, 0x00, 0x00, 0xff, 0xff, 0x02, 0xd3, 0xdf,
-	0xf6, 0x1a, 0x14, 0x00, 0x00,
+	// 1886 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x77, 0x7b, 0xc6, 0x33, 0xf6, 0x1b, 0x3b, 0x6b, 0x97, 0x27, 0xde, 0x71, 0xc7, 0xf6, 0x38,
+	0xbd, 0xb1, 0x3d, 0x1b, 0x92, 0x6e, 0xdb, 0x40, 0x24, 0xe0, 0xc0, 0xc6, 0x56, 0xe2, 0x2c, 0x9b,
+	0x40, 0x98, 0x58, 0x7b, 0x40, 0x42, 0xa3, 0x9a, 0x99, 0x4a, 0x4f, 0xcb, 0xd3, 0x5d, 0xb3, 0x5d,
+	0x35, 0xa3, 0x49, 0xa2, 0x48, 0xb0, 0x42, 0xc0, 0x8a, 0xcb, 0x4a, 0xdc, 0xe0, 0xc0, 0x1e, 0x91,
+	0xb8, 0x70, 0x43, 0xe2, 0x1f, 0x60, 0x8f, 0x2b, 0x71, 0x41, 0x1c, 0x02, 0x4a, 0x90, 0xe0, 0xce,
+	0x8d, 0x03, 0x42, 0xf5, 0xd1, 0x33, 0xdd, 0xf3, 0x1d, 0xb4, 0x48, 0x20, 0x71, 0x99, 0xe9, 0x7a,
+	0xf5, 0xde, 0xfb, 0xfd, 0xea, 0xd5, 0xab, 0xaa, 0xf7, 0x60, 0x8b, 0xf0, 0x06, 0x09, 0x7d, 0x2f,
+	0xe0, 0x0e, 0xe9, 0xf8, 0x4e, 0xe7, 0xc8, 0xf9, 0xa0, 0x4d, 0xc2, 0x27, 0x76, 0x2b, 0xa4, 0x9c,
+	0xa2, 0xd5, 0xde, 0xac, 0x4d, 0x3a, 0xbe, 0xdd, 0x39, 0x32, 0xd7, 0xb0, 0xef, 0x05, 0xd4, 0x91,
+	0xbf, 0x4a, 0xc9, 0xbc, 0x5e, 0xa3, 0xcc, 0xa7, 0xcc, 0xa9, 0x62, 0x46, 0x94, 0xb5, 0xd3, 0x39,
+	0xaa, 0x12, 0x8e, 0x8f, 0x9c, 0x16, 0x76, 0xbd, 0x00, 0x73, 0x8f, 0x06, 0x5a, 0xd7, 0x1c, 0x82,
+	0x13, 0x7e, 0xd5, 0xdc, 0xe6, 0xd0, 0x1c, 0xef, 0xea, 0xa9, 0xbc, 0x4b, 0x5d, 0x2a, 0x3f, 0x1d,
+	0xf1, 0xa5, 0xa5, 0x5b, 0x2e, 0xa5, 0x6e, 0x93, 0x38, 0xb8, 0xe5, 0x39, 0x38, 0x08, 0x28, 0x97,
+	0x48, 0x4c, 0xcf, 0x16, 0xf5, 0xac, 0x1c, 0x55, 0xdb, 0x8f, 0x1d, 0xee, 0xf9, 0x84, 0x71, 0xec,
+	0xb7, 0x94, 0x82, 0xf5, 0x15, 0x58, 0xff, 0xb6, 0x60, 0x7b, 0xbb, 0x56, 0xa3, 0xed, 0x80, 0x97,
+	0xc9, 0x07, 0x6d, 0xc2, 0x38, 0x2a, 0x40, 0x16, 0xd7, 0xeb, 0x21, 0x61, 0xac, 0x60, 0xec, 0x1a,
+	0xa5, 0xa5, 0x72, 0x34, 0xfc, 0xea, 0xe2, 0x8f, 0x3f, 0x29, 0xce, 0xfd, 0xed, 0x93, 0xe2, 0x9c,
+	0x55, 0x83, 0x7c, 0xd2, 0x94, 0xb5, 0x68, 0xc0, 0x88, 0xb0, 0xad, 0xe2, 0x26, 0x0e, 0x6a, 0x24,
+	0xb2, 0xd5, 0x43, 0x74, 0x05, 0x96, 0x6a, 0xb4, 0x4e, 0x2a, 0x0d, 0xcc, 0x1a, 0x85, 0x79, 0x39,
+	0xb7, 0x28, 0x04, 0xf7, 0x30, 0x6b, 0xa0, 0x3c, 0x2c, 0x04, 0x54, 0x18, 0xa5, 0x76, 0x8d, 0x52,
+	0xba, 0xac, 0x06, 0xd6, 0xd7, 0x61, 0x53, 0x82, 0x9c, 0xca, 0xf0, 0xfe, 0x1b, 0x2c, 0x7f, 0x68,
+	0x80, 0x39, 0xca, 0x83, 0x26, 0xbb, 0x07, 0x97, 0xd4, 0xce, 0x55, 0x92, 0x9e, 0x56, 0x94, 0xf4,
+	0xb6, 0x12, 0x22, 0x13, 0x16, 0x99, 0x00, 0x15, 0xfc, 0xe6, 0x25, 0xbf, 0xde, 0x58, 0xb8, 0xc0,
+	0xca, 0x6b, 0x25, 0x68, 0xfb, 0x55, 0x12, 0xea, 0x15, 0xac, 0x68, 0xe9, 0x37, 0xa5, 0xd0, 0x7a,
+	0x0f, 0xb6, 0x24, 0x8f, 0xf7, 0x71, 0xd3, 0xab, 0x63, 0x4e, 0xc3, 0x81, 0xc5, 0x5c, 0x85, 0xe5,
+	0x1a, 0x0d, 0x06, 0x79, 0xe4, 0x84, 0xec, 0xf6, 0xd0, 0xaa, 0x7e, 0x62, 0xc0, 0xf6, 0x18, 0x6f,
+	0x7a, 0x61, 0x07, 0xf0, 0x46, 0xc4, 0x2a, 0xe9, 0x31, 0x22, 0xfb, 0x39, 0x2e, 0x2d, 0x4a, 0xa2,
+	0x13, 0xb5, 0xcf, 0xaf, 0xb3, 0x3d, 0x87, 0x90, 0x4f, 0x9a, 0x4e, 0x4b, 0x22, 0xeb, 0x3d, 0x0d,
+	0xf6, 0x88, 0xd3, 0x10, 0xbb, 0xd3, 0xc1, 0xd0, 0x2a, 0xa4, 0x2e, 0xc8, 0x13, 0x9d, 0x6f, 0xe2,
+	0x33, 0x06, 0x7f, 0x03, 0xf2, 0x49, 0x67, 0x1a, 0x3e, 0x0f, 0x0b, 0x1d, 0xdc, 0x6c, 0x47, 0xe0,
+	0x6a, 0x60, 0xdd, 0x82, 0x55, 0x9d, 0x4a, 0xf5, 0xd7, 0x5a, 0xe4, 0x01, 0xac, 0xc5, 0xec, 0x34,
+	0x04, 0x82, 0xb4, 0xc8, 0x7d, 0x69, 0xb5, 0x5c, 0x96, 0xdf, 0xd6, 0x53, 0x40, 0x52, 0xf1, 0xbc,
+	0x7b, 0x9f, 0xba, 0x2c, 0x82, 0x40, 0x90, 0x96, 0x27, 0x46, 0xf9, 0x97, 0xdf, 0xe8, 0x2e, 0x40,
+	0xff, 0x5e, 0x91, 0x6b, 0xcb, 0x1d, 0xef, 0xdb, 0x2a, 0x69, 0x6d, 0x71, 0x09, 0xd9, 0xea, 0x0a,
+	0xd3, 0x97, 0x90, 0xfd, 0xb0, 0x1f, 0xaa, 0x72, 0xcc, 0x32, 0x46, 0xf2, 0x23, 0x03, 0xd6, 0x13,
+	0xe0, 0x9a, 0xe7, 0xdb, 0x90, 0x6e, 0x52, 0x57, 0xac, 0x2e, 0x55, 0xca, 0x1d, 0x5f, 0xb6, 0x07,
+	0x6f, 0x43, 0xfb, 0x3e, 0x75, 0xcb, 0x52, 0x05, 0x9d, 0x8d, 0x20, 0x75, 0x30, 0x95, 0x94, 0xc2,
+	0x89, 0xb3, 0xb2, 0xf2, 0x3a, 0x0e, 0x0f, 0x71, 0x88, 0xfd, 0x28, 0x0e, 0x56, 0x19, 0xd6, 0x13,
+	0x52, 0x4d, 0xf0, 0x6b, 0x90, 0x69, 0x49, 0x89, 0x0c, 0x50, 0xee, 0xb8, 0x30, 0x4c, 0x51, 0x59,
+	0x9c, 0x2c, 0x7d, 0xfa, 0xa2, 0x38, 0xf7, 0xcb, 0xbf, 0xfe, 0xfa, 0xba, 0x51, 0xd6, 0x26, 0xd6,
+	0x3f, 0x0d, 0xb8, 0x74, 0x87, 0x37, 0x4e, 0x71, 0xb3, 0x19, 0x0b, 0x37, 0x0e, 0x5d, 0x16, 0x6d,
+	0x8c, 0xf8, 0x46, 0x6f, 0x42, 0xd6, 0xc5, 0xac, 0x52, 0xc3, 0x2d, 0x7d, 0x46, 0x32, 0x2e, 0x66,
+	0xa7, 0xb8, 0x85, 0xbe, 0x0b, 0xab, 0xad, 0x90, 0xb6, 0x28, 0x23, 0x61, 0xef, 0x9c, 0x89, 0x33,
+	0xb2, 0x7c, 0x72, 0xfc, 0x8f, 0x17, 0x45, 0xdb, 0xf5, 0x78, 0xa3, 0x5d, 0xb5, 0x6b, 0xd4, 0x77,
+	0xf4, 0x03, 0xa1, 0xfe, 0x6e, 0xb2, 0xfa, 0x85, 0xc3, 0x9f, 0xb4, 0x08, 0xb3, 0x4f, 0xfb, 0x07,
+	0xbc, 0xfc, 0x46, 0xe4, 0x2b, 0x3a, 0x9c, 0x9b, 0xb0, 0x58, 0x6b, 0x60, 0x2f, 0xa8, 0x78, 0xf5,
+	0x42, 0x7a, 0xd7, 0x28, 0xa5, 0xca, 0x59, 0x39, 0x7e, 0xb7, 0x8e, 0xb6, 0x60, 0x89, 0x76, 0x48,
+	0x18, 0x7a, 0x75, 0xc2, 0x0a, 0x0b, 0x92, 0x6b, 0x5f, 0x20, 0x8e, 0x7f, 0xb5, 0x49, 0x6b, 0x17,
+	0x95, 0xbe, 0x4e, 0x46, 0xea, 0x5c, 0x92, 0xe2, 0x6f, 0x45, 0x52, 0xeb, 0x1c, 0xd6, 0xef, 0x30,
+	0xee, 0xf9, 0x98, 0x93, 0x33, 0xdc, 0x0f, 0xea, 0x2a, 0xa4, 0x5c, 0xac, 0x62, 0x90, 0x2e, 0x8b,
+	0x4f, 0x21, 0x09, 0x09, 0x97, 0xcb, 0x5f, 0x2e, 0x8b, 0x4f, 0x41, 0xae, 0xe3, 0x57, 0x48, 0x18,
+	0x52, 0x75, 0x2f, 0x2c, 0x95, 0xb3, 0x1d, 0xff, 0x8e, 0x18, 0x5a, 0xbf, 0x35, 0x60, 0xed, 0x91,
+	0xe7, 0xb7, 0x9b, 0x98, 0x93, 0xf7, 0x8f, 0x62, 0x91, 0xa5, 0x2d, 0xde, 0x8b, 0xac, 0xf8, 0xfe,
+	0x2f, 0x8c, 0xac, 0x75, 0x03, 0x50, 0x9c, 0xbb, 0x8e, 0xc8, 0x06, 0x64, 0x42, 0xc2, 0xda, 0x4d,
+	0xae, 0xe9, 0xeb, 0x91, 0xf5, 0x51, 0x3a, 0x3a, 0x37, 0x21, 0xae, 0x91, 0xf3, 0x6e, 0xb4, 0xd8,
+	0x23, 0x48, 0xf9, 0xcc, 0xd5, 0x39, 0x59, 0x1c, 0xce, 0xc9, 0x07, 0xcc, 0xbd, 0x23, 0x64, 0xa4,
+	0xed, 0x9f, 0x77, 0xcb, 0x42, 0x17, 0xbd, 0x03, 0xcb, 0x5c, 0x38, 0xa9, 0xd4, 0x68, 0xf0, 0xd8,
+	0x73, 0xe5, 0x72, 0x73, 0xc7, 0xdb, 0xc3, 0xb6, 0x12, 0xea, 0x54, 0x2a, 0x95, 0x73, 0xbc, 0x3f,
+	0x40, 0xa7, 0xb0, 0xdc, 0x0a, 0x49, 0x9d, 0xd4, 0x08, 0x63, 0x34, 0x64, 0x85, 0xf4, 0x6e, 0x6a,
+	0x16, 0xf4, 0x84, 0x91, 0x78, 0x89, 0x54, 0xee, 0xe8, 0x3b, 0x7f, 0x41, 0x86, 0x27, 0x27, 0x65,
+	0xea, 0xc6, 0x47, 0xdb, 0x00, 0x4a, 0x45, 0x5e, 0x4c, 0x19, 0xb9, 0xf9, 0x4b, 0x52, 0x22, 0xdf,
+	0xf2, 0x7b, 0xd1, 0xb4, 0x28, 0x37, 0x0a, 0x59, 0xb9, 0x0c, 0xd3, 0x56, 0xb5, 0x88, 0x1d, 0xd5,
+	0x22, 0xf6, 0x79, 0x54, 0x8b, 0x9c, 0xac, 0x88, 0x83, 0xf9, 0xf1, 0x9f, 0x8a, 0x86, 0x3a, 0x9c,
+	0xca, 0x93, 0x98, 0x1e, 0x99, 0x05, 0x8b, 0xff, 0x99, 0x2c, 0x58, 0x4a, 0x9e, 0x2f, 0x0b, 0x56,
+	0xd4, 0x1a, 0x7c, 0xdc, 0xad, 0x88, 0xb3, 0x00, 0xb1, 0x30, 0x3c, 0xc0, 0xdd, 0x33, 0xcc, 0xbe,
+	0x91, 0x5e, 0x9c, 0x5f, 0x4d, 0x95, 0x17, 0x79, 0xb7, 0xe2, 0x05, 0x75, 0xd2, 0xb5, 0xae, 0xeb,
+	0xe7, 0xa4, 0x97, 0x0a, 0xfd, 0xbb, 0xbe, 0x8e, 0x39, 0x8e, 0x12, 0x5f, 0x7c, 0x5b, 0xbf, 0x49,
+	0xc1, 0x46, 0x5f, 0xf9, 0x44, 0x78, 0x8d, 0xa5, 0x0e, 0xef, 0x46, 0x37, 0xee, 0xf4, 0xd4, 0xe1,
+	0x5d, 0xf6, 0x39, 0xa4, 0xce, 0xff, 0x77, 0x7d, 0xc6, 0x5d, 0xb7, 0x6e, 0xc2, 0x9b, 0x43, 0x1b,
+	0x37, 0x61, 0xa3, 0x7f, 0x37, 0x0f, 0x97, 0xfb, 0xfa, 0xff, 0x83, 0x2f, 0xcd, 0x60, 0x6e, 0x2d,
+	0xbc, 0x76, 0x6e, 0x25, 0xde, 0xaa, 0xcc, 0x0c, 0x6f, 0x55, 0x76, 0xe4, 0x5b, 0x75, 0x03, 0x36,
+	0x06, 0x03, 0x39, 0x21, 0xee, 0x97, 0x7b, 0x55, 0x29, 0x23, 0x77, 0x49, 0x54, 0xfd, 0x58, 0xf7,
+	0x21, 0x9f, 0x14, 0x6b, 0x17, 0x5f, 0x82, 0x45, 0x51, 0xa2, 0x54, 0x1e, 0x13, 0x5d, 0xf5, 0x9d,
+	0x6c, 0xfe, 0xf1, 0x45, 0xf1, 0xb2, 0x8a, 0x22, 0xab, 0x5f, 0xd8, 0x1e, 0x75, 0x7c, 0xcc, 0x1b,
+	0xf6, 0xbb, 0x01, 0x17, 0xd5, 0xa8, 0xb4, 0xb6, 0x8a, 0xba, 0x0e, 0x3f, 0x6b, 0xd2, 0x2a, 0x6e,
+	0x3e, 0xf0, 0x82, 0x33, 0xcc, 0x1e, 0x86, 0x5e, 0xaf, 0x08, 0xb6, 0x6a, 0xb0, 0x33, 0x4e, 0x41,
+	0x03, 0xdf, 0x86, 0x15, 0xdf, 0x0b, 0x44, 0xb2, 0x55, 0x5a, 0x62, 0x42, 0xa3, 0x6f, 0x8b, 0xd3,
+	0x31, 0x9e, 0x41, 0xce, 0xef, 0xbb, 0xea, 0xd5, 0x4b, 0x3a, 0xf6, 0xbd, 0x95, 0xae, 0x27, 0xa4,
+	0x1a, 0xef, 0xcb, 0x90, 0xd1, 0x1b, 0x69, 0x8c, 0xdb, 0xc8, 0x53, 0xb1, 0xf3, 0xda, 0x4c, 0x2b,
+	0x5b, 0xb7, 0x74, 0x1f, 0x75, 0x97, 0x90, 0x47, 0xc2, 0x11, 0x0d, 0x59, 0xc3, 0x6b, 0xc5, 0xca,
+	0x60, 0xa6, 0xa4, 0x51, 0x19, 0xac, 0x87, 0x96, 0x0b, 0x57, 0x46, 0xda, 0x69, 0x36, 0xf7, 0x20,
+	0xc7, 0xfa, 0x62, 0x4d, 0x69, 0x77, 0x98, 0x52, 0xd2, 0xfc, 0x24, 0x2d, 0xa2, 0x53, 0x8e, 0x9b,
+	0x1e, 0xff, 0x7d, 0x0d, 0x16, 0x24, 0x12, 0xfa, 0xbe, 0x01, 0x59, 0xdd, 0x0f, 0xa1, 0xbd, 0x61,
+	0x57, 0x23, 0x1a, 0x5e, 0x73, 0x7f, 0x9a, 0x9a, 0xa2, 0x6b, 0x1d, 0x7c, 0xf8, 0xfb, 0xbf, 0xfc,
+	0x74, 0xfe, 0x2a, 0x2a, 0x8a, 0xf6, 0x9c, 0xb2, 0xa8, 0x49, 0xd7, 0xfd, 0x90, 0xf3, 0x4c, 0x9f,
+	0xcc, 0xe7, 0xe8, 0x67, 0x06, 0xac, 0x24, 0x5a, 0x4e, 0xf4, 0x85, 0x31, 0x10, 0xa3, 0x5a, 0x5b,
+	0xf3, 0xc6, 0x6c, 0xca, 0x9a, 0x95, 0x2d, 0x59, 0x95, 0xd0, 0x7e, 0x92, 0x55, 0xd4, 0xd9, 0x0e,
+	0x91, 0xfb, 0x95, 0x01, 0xab, 0x83, 0x9d, 0x23, 0xb2, 0xc7, 0x40, 0x8e, 0x69, 0x58, 0x4d, 0x67,
+	0x66, 0x7d, 0xcd, 0xf2, 0x96, 0x64, 0x79, 0x88, 0xec, 0x24, 0xcb, 0x4e, 0xa4, 0xdf, 0x27, 0x1a,
+	0x6f, 0x84, 0x9f, 0xa3, 0x0f, 0x0d, 0xc8, 0xea, 0xfe, 0x70, 0xec, 0x76, 0x26, 0x5b, 0x4f, 0x73,
+	0x7f, 0x9a, 0x9a, 0xa6, 0x54, 0x92, 0x94, 0x2c, 0xb4, 0x9b, 0xa4, 0xa4, 0x7b, 0x4d, 0x16, 0x0b,
+	0xd9, 0x8f, 0x0c, 0xc8, 0xea, 0x2e, 0x71, 0x2c, 0x89, 0x64, 0x4b, 0x6a, 0xee, 0x4f, 0x53, 0xd3,
+	0x24, 0x6e, 0x4a, 0x12, 0x07, 0x68, 0x2f, 0x49, 0x82, 0x29, 0xb5, 0x3e, 0x07, 0xe7, 0xd9, 0x05,
+	0x79, 0xf2, 0x1c, 0x75, 0x20, 0x2d, 0x1a, 0x49, 0x64, 0x8d, 0x4d, 0x91, 0x5e, 0x77, 0x6a, 0xbe,
+	0x35, 0x51, 0x47, 0xe3, 0xef, 0x49, 0xfc, 0x22, 0xda, 0x1e, 0xcc, 0x9e, 0x7a, 0x22, 0x02, 0x0c,
+	0x32, 0xaa, 0x8f, 0x42, 0xd7, 0xc6, 0x78, 0x4d, 0xb4, 0x6b, 0xe6, 0xde, 0x14, 0x2d, 0x8d, 0xbe,
+	0x25, 0xd1, 0x37, 0x50, 0x3e, 0x89, 0xae, 0xfa, 0x33, 0xc4, 0x21, 0xab, 0xdb, 0x33, 0x34, 0xe2,
+	0x52, 0x48, 0x76, 0x6e, 0xe6, 0xc1, 0xb4, 0x52, 0x29, 0xc2, 0xdc, 0x91, 0x98, 0x05, 0xb4, 0x91,
+	0xc4, 0x24, 0xbc, 0x51, 0xa9, 0x09, 0xa8, 0xa7, 0x90, 0x8b, 0x35, 0x45, 0x33, 0x20, 0x8f, 0x58,
+	0xeb, 0x88, 0xae, 0xca, 0xb2, 0x24, 0xee, 0x16, 0x32, 0x07, 0x70, 0xb5, 0xaa, 0x78, 0x03, 0xd0,
+	0x53, 0x80, 0x7e, 0xf7, 0x81, 0x46, 0x6c, 0xe0, 0x50, 0x5f, 0x65, 0x5e, 0x9b, 0xac, 0xa4, 0xc1,
+	0xaf, 0x4a, 0xf0, 0x2b, 0x68, 0x73, 0x20, 0xcd, 0xb4, 0x66, 0xa5, 0x73, 0x84, 0xba, 0x90, 0xd5,
+	0xa5, 0xeb, 0xd8, 0x1c, 0x4f, 0x76, 0x39, 0xe6, 0xfe, 0x34, 0xb5, 0xc9, 0x11, 0x57, 0x75, 0x05,
+	0xef, 0xa2, 0x1f, 0x18, 0x00, 0xfd, 0x7a, 0x0a, 0x95, 0x26, 0xb9, 0x8d, 0xd7, 0xca, 0xe6, 0xdb,
+	0x33, 0x68, 0x4e, 0x0e, 0x80, 0xe2, 0x20, 0x4b, 0x0d, 0xf4, 0x3d, 0x03, 0x96, 0x7a, 0xd5, 0x05,
+	0x3a, 0x98, 0xe4, 0x3b, 0xbe, 0xfd, 0xa5, 0xe9, 0x8a, 0x9a, 0xc3, 0xae, 0xe4, 0x60, 0xa2, 0xc2,
+	0x28, 0x0e, 0x32, 0xf7, 0xba, 0xe2, 0xb2, 0x93, 0xc5, 0xc5, 0x84, 0xcb, 0x2e, 0x5e, 0xd1, 0x98,
+	0xfb, 0xd3, 0xd4, 0x26, 0xef, 0x41, 0x54, 0xf5, 0xa0, 0x9f, 0x1b, 0xb0, 0x36, 0x54, 0xa6, 0xa0,
+	0x71, 0xd7, 0xfc, 0xb8, 0x8a, 0xc7, 0x3c, 0x9c, 0xdd, 0x40, 0x13, 0x7b, 0x4b, 0x12, 0xdb, 0x46,
+	0x57, 0x92, 0xc4, 0x12, 0x55, 0x91, 0xb8, 0x7e, 0x74, 0x35, 0x79, 0x6d, 0xec, 0xa5, 0x16, 0xab,
+	0x7e, 0xcc, 0xbd, 0x29, 0x5a, 0x93, 0xaf, 0x1f, 0x55, 0xf4, 0xa0, 0x5f, 0x18, 0x70, 0x29, 0x59,
+	0x79, 0xa0, 0x71, 0x2f, 0xf3, 0xc8, 0xba, 0xc8, 0xbc, 0x39, 0xa3, 0xb6, 0x66, 0x73, 0x28, 0xd9,
+	0x5c, 0x47, 0xa5, 0x24, 0x9b, 0xc7, 0x84, 0x54, 0x62, 0xa5, 0x0e, 0x73, 0x9e, 0xe9, 0xd1, 0xf3,
+	0x93, 0x77, 0x3e, 0x7d, 0xb9, 0x63, 0x7c, 0xf6, 0x72, 0xc7, 0xf8, 0xf3, 0xcb, 0x1d, 0xe3, 0xe3,
+	0x57, 0x3b, 0x73, 0x9f, 0xbd, 0xda, 0x99, 0xfb, 0xc3, 0xab, 0x9d, 0xb9, 0xef, 0xec, 0xc7, 0x5a,
+	0x82, 0x9e, 0x37, 0xca, 0x9c, 0xce, 0xf1, 0xa1, 0xd3, 0x95, 0x9e, 0x65, 0x5b, 0x50, 0xcd, 0xc8,
+	0xd6, 0xec, 0x8b, 0xff, 0x1a, 0x00, 0xc9, 0x91, 0x19, 0xa3, 0x06, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1654,10 +2057,14 @@ type QueryClient interface {
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
 	TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork status.
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
@@ -1668,6 +2075,8 @@ type QueryClient interface {
 	GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinGasPriceRequest, opts ...grpc.CallOption) (*QueryGlobalMinGasPriceResponse, error)
 	// Config queries the EVM configuration
 	Config(ctx context.Context, in *QueryConfigRequest, opts ...grpc.CallOption) (*QueryConfigResponse, error)
+	// FeeSponsorship queries the fee sponsorship of a sponsor
+	FeeSponsorship(ctx context.Context, in *QueryFeeSponsorshipRequest, opts ...grpc.CallOption) (*QueryFeeSponsorshipResponse, error)
 }
 
 type queryClient struct {
@@ -1759,9 +2168,18 @@ func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts
 	return out, nil
 }
 
-func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
-	out := new(QueryTraceTxResponse)
-	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceTx", in, out, opts...)
+func (c *queryClient) SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error) {
+	out := new(SimulateV1Response)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/SimulateV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
+	out := new(QueryTraceTxResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceTx", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1777,6 +2195,15 @@ func (c *queryClient) TraceBlock(ctx context.Context, in *QueryTraceBlockRequest
 	return out, nil
 }
 
+func (c *queryClient) TraceCall(ctx context.Context, in *QueryTraceCallRequest, opts ...grpc.CallOption) (*QueryTraceCallResponse, error) {
+	out := new(QueryTraceCallResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
 	out := new(QueryBaseFeeResponse)
 	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/BaseFee", in, out, opts...)
@@ -1804,6 +2231,15 @@ func (c *queryClient) Config(ctx context.Context, in *QueryConfigRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) FeeSponsorship(ctx context.Context, in *QueryFeeSponsorshipRequest, opts ...grpc.CallOption) (*QueryFeeSponsorshipResponse, error) {
+	out := new(QueryFeeSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/FeeSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Account queries an Ethereum account.
@@ -1826,10 +2262,14 @@ type QueryServer interface {
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(context.Context, *SimulateV1Request) (*SimulateV1Response, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
 	TraceBlock(context.Context, *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error)
+	// TraceCall implements the `debug_traceCall` rpc api
+	TraceCall(context.Context, *QueryTraceCallRequest) (*QueryTraceCallResponse, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork status.
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
@@ -1840,6 +2280,8 @@ type QueryServer interface {
 	GlobalMinGasPrice(context.Context, *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error)
 	// Config queries the EVM configuration
 	Config(context.Context, *QueryConfigRequest) (*QueryConfigResponse, error)
+	// FeeSponsorship queries the fee sponsorship of a sponsor
+	FeeSponsorship(context.Context, *QueryFeeSponsorshipRequest) (*QueryFeeSponsorshipResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1873,12 +2315,18 @@ func (*UnimplementedQueryServer) EthCall(ctx context.Context, req *EthCallReques
 func (*UnimplementedQueryServer) EstimateGas(ctx context.Context, req *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
+func (*UnimplementedQueryServer) SimulateV1(ctx context.Context, req *SimulateV1Request) (*SimulateV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateV1 not implemented")
+}
 func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
 }
 func (*UnimplementedQueryServer) TraceBlock(ctx context.Context, req *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceBlock not implemented")
 }
+func (*UnimplementedQueryServer) TraceCall(ctx context.Context, req *QueryTraceCallRequest) (*QueryTraceCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraceCall not implemented")
+}
 func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
 }
@@ -1888,6 +2336,9 @@ func (*UnimplementedQueryServer) GlobalMinGasPrice(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) Config(ctx context.Context, req *QueryConfigRequest) (*QueryConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Config not implemented")
 }
+func (*UnimplementedQueryServer) FeeSponsorship(ctx context.Context, req *QueryFeeSponsorshipRequest) (*QueryFeeSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSponsorship not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2055,6 +2506,24 @@ func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimulateV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/SimulateV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateV1(ctx, req.(*SimulateV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceTxRequest)
 	if err := dec(in); err != nil {
@@ -2091,6 +2560,24 @@ func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TraceCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TraceCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/TraceCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TraceCall(ctx, req.(*QueryTraceCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBaseFeeRequest)
 	if err := dec(in); err != nil {
@@ -2145,6 +2632,25 @@ func _Query_Config_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/FeeSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSponsorship(ctx, req.(*QueryFeeSponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2185,6 +2691,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
 		},
+		{
+			MethodName: "SimulateV1",
+			Handler:    _Query_SimulateV1_Handler,
+		},
 		{
 			MethodName: "TraceTx",
 			Handler:    _Query_TraceTx_Handler,
@@ -2193,6 +2703,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TraceBlock",
 			Handler:    _Query_TraceBlock_Handler,
 		},
+		{
+			MethodName: "TraceCall",
+			Handler:    _Query_TraceCall_Handler,
+		},
 		{
 			MethodName: "BaseFee",
 			Handler:    _Query_BaseFee_Handler,
@@ -2205,6 +2719,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Config",
 			Handler:    _Query_Config_Handler,
 		},
+		{
+			MethodName: "FeeSponsorship",
+			Handler:    _Query_FeeSponsorship_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/query.proto",
@@ -2776,6 +3294,20 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ChainId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
@@ -2845,6 +3377,83 @@ func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SimulateV1Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateV1Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateV1Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Opts) > 0 {
+		i -= len(m.Opts)
+		copy(dAtA[i:], m.Opts)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Opts)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateV1Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateV1Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateV1Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3089,6 +3698,109 @@ func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryTraceCallRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceCallRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TraceConfig != nil {
+		{
+			size, err := m.TraceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTraceCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3261,28 +3973,91 @@ func (m *QueryConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryFeeSponsorshipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryFeeSponsorshipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSponsorshipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Sponsorship.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryAccountResponse) Size() (n int) {
@@ -3527,6 +4302,14 @@ func (m *EthCallRequest) Size() (n int) {
 	if m.ChainId != 0 {
 		n += 1 + sovQuery(uint64(m.ChainId))
 	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3550,6 +4333,42 @@ func (m *EstimateGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *SimulateV1Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Opts)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *SimulateV1Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryTraceTxRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3656,6 +4475,54 @@ func (m *QueryTraceBlockResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTraceCallRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTraceCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryBaseFeeRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3720,6 +4587,30 @@ func (m *QueryConfigResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFeeSponsorshipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sponsorship.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5377,6 +6268,74 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5533,7 +6492,7 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
+func (m *SimulateV1Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5556,17 +6515,17 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulateV1Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulateV1Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Opts", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5576,33 +6535,31 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgEthereumTx{}
-			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Opts = append(m.Opts[:0], dAtA[iNdEx:postIndex]...)
+			if m.Opts == nil {
+				m.Opts = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
 			}
-			var msglen int
+			m.GasCap = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5612,22 +6569,264 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GasCap |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
-			if m.TraceConfig == nil {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateV1Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateV1Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateV1Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &MsgEthereumTx{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceConfig == nil {
 				m.TraceConfig = &TraceConfig{}
 			}
 			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
@@ -6289,7 +7488,7 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceCallRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6312,20 +7511,364 @@ func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceCallRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceCallResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceCallResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -6695,6 +8238,171 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFeeSponsorshipRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSponsorshipRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSponsorshipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeSponsorshipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSponsorshipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorship", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sponsorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,544 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"errors"
+	"math"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/evmos/evmos/v20/types"
+	ethutils "github.com/evmos/evmos/v20/utils/eth"
+)
+
+// SetCodeTxType is the type of the EIP-7702 set code transactions.
+//
+// NOTE: go-ethereum doesn't support this transaction type in the version used by
+// the EVM, so the transactions are encoded, hashed and signed by this package.
+const SetCodeTxType = 0x04
+
+const (
+	// PerEmptyAccountCost is the intrinsic gas charged for each authorization of a
+	// set code transaction.
+	PerEmptyAccountCost uint64 = 25000
+	// PerAuthBaseCost is the gas cost of an authorization whose authority already
+	// exists. The difference with PerEmptyAccountCost is refunded.
+	PerAuthBaseCost uint64 = 12500
+)
+
+// setCodeAuthorizationMagic is prepended to the RLP encoding of the authorizations
+// to compute the hash signed by the authorities.
+const setCodeAuthorizationMagic = 0x05
+
+// NewSetCodeTx decodes a set code transaction from its canonical encoding:
+// 0x04 || rlp([chain_id, nonce, gas_tip_cap, gas_fee_cap, gas, to, value, data,
+// access_list, authorization_list, y_parity, r, s]).
+func NewSetCodeTx(b []byte) (*SetCodeTx, error) {
+	if len(b) == 0 || b[0] != SetCodeTxType {
+		return nil, ethtypes.ErrTxTypeNotSupported
+	}
+
+	var dec setCodeTxRLP
+	if err := rlp.DecodeBytes(b[1:], &dec); err != nil {
+		return nil, err
+	}
+
+	txData := &SetCodeTx{
+		Nonce:    dec.Nonce,
+		GasLimit: dec.Gas,
+		To:       dec.To.Hex(),
+		Data:     dec.Data,
+		Accesses: NewAccessList(&dec.AccessList),
+	}
+
+	for _, value := range []struct {
+		src *big.Int
+		dst **sdkmath.Int
+	}{
+		{dec.Value, &txData.Amount},
+		{dec.GasTipCap, &txData.GasTipCap},
+		{dec.GasFeeCap, &txData.GasFeeCap},
+	} {
+		valueInt, err := types.SafeNewIntFromBigInt(value.src)
+		if err != nil {
+			return nil, err
+		}
+		*value.dst = &valueInt
+	}
+
+	txData.Authorizations = make(AuthorizationList, len(dec.AuthList))
+	for i, auth := range dec.AuthList {
+		chainID, err := types.SafeNewIntFromBigInt(auth.ChainID)
+		if err != nil {
+			return nil, err
+		}
+
+		txData.Authorizations[i] = SetCodeAuthorization{
+			ChainID: chainID,
+			Address: auth.Address.Hex(),
+			Nonce:   auth.Nonce,
+			V:       auth.V.Bytes(),
+			R:       auth.R.Bytes(),
+			S:       auth.S.Bytes(),
+		}
+	}
+
+	txData.SetSignatureValues(dec.ChainID, dec.V, dec.R, dec.S)
+	return txData, nil
+}
+
+// TxType returns the tx type
+func (tx *SetCodeTx) TxType() uint8 {
+	return SetCodeTxType
+}
+
+// Copy returns an instance with the same field values
+func (tx *SetCodeTx) Copy() TxData {
+	return &SetCodeTx{
+		ChainID:        tx.ChainID,
+		Nonce:          tx.Nonce,
+		GasTipCap:      tx.GasTipCap,
+		GasFeeCap:      tx.GasFeeCap,
+		GasLimit:       tx.GasLimit,
+		To:             tx.To,
+		Amount:         tx.Amount,
+		Data:           common.CopyBytes(tx.Data),
+		Accesses:       tx.Accesses,
+		Authorizations: tx.Authorizations,
+		V:              common.CopyBytes(tx.V),
+		R:              common.CopyBytes(tx.R),
+		S:              common.CopyBytes(tx.S),
+	}
+}
+
+// GetChainID returns the chain id field from the SetCodeTx
+func (tx *SetCodeTx) GetChainID() *big.Int {
+	if tx.ChainID == nil {
+		return nil
+	}
+
+	return tx.ChainID.BigInt()
+}
+
+// GetAccessList returns the AccessList field.
+func (tx *SetCodeTx) GetAccessList() ethtypes.AccessList {
+	if tx.Accesses == nil {
+		return nil
+	}
+	return *tx.Accesses.ToEthAccessList()
+}
+
+// GetAuthorizations returns the authorization list of the transaction.
+func (tx *SetCodeTx) GetAuthorizations() AuthorizationList {
+	return tx.Authorizations
+}
+
+// GetData returns the a copy of the input data bytes.
+func (tx *SetCodeTx) GetData() []byte {
+	return common.CopyBytes(tx.Data)
+}
+
+// GetGas returns the gas limit.
+func (tx *SetCodeTx) GetGas() uint64 {
+	return tx.GasLimit
+}
+
+// GetGasPrice returns the gas fee cap field.
+func (tx *SetCodeTx) GetGasPrice() *big.Int {
+	return tx.GetGasFeeCap()
+}
+
+// GetGasTipCap returns the gas tip cap field.
+func (tx *SetCodeTx) GetGasTipCap() *big.Int {
+	if tx.GasTipCap == nil {
+		return nil
+	}
+	return tx.GasTipCap.BigInt()
+}
+
+// GetGasFeeCap returns the gas fee cap field.
+func (tx *SetCodeTx) GetGasFeeCap() *big.Int {
+	if tx.GasFeeCap == nil {
+		return nil
+	}
+	return tx.GasFeeCap.BigInt()
+}
+
+// GetValue returns the tx amount.
+func (tx *SetCodeTx) GetValue() *big.Int {
+	if tx.Amount == nil {
+		return nil
+	}
+
+	return tx.Amount.BigInt()
+}
+
+// GetNonce returns the account sequence for the transaction.
+func (tx *SetCodeTx) GetNonce() uint64 { return tx.Nonce }
+
+// GetTo returns the pointer to the recipient address.
+func (tx *SetCodeTx) GetTo() *common.Address {
+	if tx.To == "" {
+		return nil
+	}
+	to := common.HexToAddress(tx.To)
+	return &to
+}
+
+// AsEthereumData returns a DynamicFeeTx with the same fields as the set code
+// transaction, except for the authorization list.
+//
+// NOTE: go-ethereum can't represent set code transactions, so the returned data
+// must only be used to read the common fields (e.g. fees and gas). The hash,
+// signature and encoding of the transaction are the ones of SetCodeTx.
+func (tx *SetCodeTx) AsEthereumData() ethtypes.TxData {
+	v, r, s := tx.GetRawSignatureValues()
+	return &ethtypes.DynamicFeeTx{
+		ChainID:    tx.GetChainID(),
+		Nonce:      tx.GetNonce(),
+		GasTipCap:  tx.GetGasTipCap(),
+		GasFeeCap:  tx.GetGasFeeCap(),
+		Gas:        tx.GetGas(),
+		To:         tx.GetTo(),
+		Value:      tx.GetValue(),
+		Data:       tx.GetData(),
+		AccessList: tx.GetAccessList(),
+		V:          v,
+		R:          r,
+		S:          s,
+	}
+}
+
+// GetRawSignatureValues returns the V, R, S signature values of the transaction.
+// The return values should not be modified by the caller.
+func (tx *SetCodeTx) GetRawSignatureValues() (v, r, s *big.Int) {
+	return ethutils.RawSignatureValues(tx.V, tx.R, tx.S)
+}
+
+// SetSignatureValues sets the signature values to the transaction.
+func (tx *SetCodeTx) SetSignatureValues(chainID, v, r, s *big.Int) {
+	if v != nil {
+		tx.V = v.Bytes()
+	}
+	if r != nil {
+		tx.R = r.Bytes()
+	}
+	if s != nil {
+		tx.S = s.Bytes()
+	}
+	if chainID != nil {
+		chainIDInt := sdkmath.NewIntFromBigInt(chainID)
+		tx.ChainID = &chainIDInt
+	}
+}
+
+// Validate performs a stateless validation of the tx fields.
+func (tx SetCodeTx) Validate() error {
+	if tx.GetChainID() == nil {
+		return errorsmod.Wrap(
+			errortypes.ErrInvalidChainID,
+			"chain ID must be present on SetCode txs",
+		)
+	}
+
+	if tx.To == "" {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "SetCode txs can't create contracts")
+	}
+
+	// the fee, value and recipient rules are the ones of dynamic fee txs
+	dynamicFeeTx := DynamicFeeTx{
+		ChainID:   tx.ChainID,
+		GasTipCap: tx.GasTipCap,
+		GasFeeCap: tx.GasFeeCap,
+		GasLimit:  tx.GasLimit,
+		To:        tx.To,
+		Amount:    tx.Amount,
+	}
+	if err := dynamicFeeTx.Validate(); err != nil {
+		return err
+	}
+
+	if len(tx.Authorizations) == 0 {
+		return errorsmod.Wrap(ErrInvalidAuthorization, "authorization list cannot be empty")
+	}
+
+	for i, auth := range tx.Authorizations {
+		if err := auth.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "authorization %d", i)
+		}
+	}
+
+	return nil
+}
+
+// Fee returns gasprice * gaslimit.
+func (tx SetCodeTx) Fee() *big.Int {
+	return fee(tx.GetGasFeeCap(), tx.GetGas())
+}
+
+// Cost returns amount + gasprice * gaslimit.
+func (tx SetCodeTx) Cost() *big.Int {
+	return cost(tx.Fee(), tx.GetValue())
+}
+
+// EffectiveGasPrice returns the effective gas price
+func (tx *SetCodeTx) EffectiveGasPrice(baseFee *big.Int) *big.Int {
+	return EffectiveGasPrice(baseFee, tx.GasFeeCap.BigInt(), tx.GasTipCap.BigInt())
+}
+
+// EffectiveFee returns effective_gasprice * gaslimit.
+func (tx SetCodeTx) EffectiveFee(baseFee *big.Int) *big.Int {
+	return fee(tx.EffectiveGasPrice(baseFee), tx.GetGas())
+}
+
+// EffectiveCost returns amount + effective_gasprice * gaslimit.
+func (tx SetCodeTx) EffectiveCost(baseFee *big.Int) *big.Int {
+	return cost(tx.EffectiveFee(baseFee), tx.GetValue())
+}
+
+// SigHash returns the hash signed by the sender of the transaction:
+// keccak256(0x04 || rlp([chain_id, ..., access_list, authorization_list])).
+func (tx *SetCodeTx) SigHash() common.Hash {
+	enc := tx.toRLP()
+	return prefixedRlpHash(SetCodeTxType, []interface{}{
+		enc.ChainID,
+		enc.Nonce,
+		enc.GasTipCap,
+		enc.GasFeeCap,
+		enc.Gas,
+		enc.To,
+		enc.Value,
+		enc.Data,
+		enc.AccessList,
+		enc.AuthList,
+	})
+}
+
+// MarshalBinary returns the canonical encoding of the transaction.
+func (tx *SetCodeTx) MarshalBinary() ([]byte, error) {
+	bz, err := rlp.EncodeToBytes(tx.toRLP())
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{SetCodeTxType}, bz...), nil
+}
+
+// Hash returns the transaction hash, which is the hash of its canonical encoding.
+func (tx *SetCodeTx) Hash() common.Hash {
+	bz, err := tx.MarshalBinary()
+	if err != nil {
+		return common.Hash{}
+	}
+	return crypto.Keccak256Hash(bz)
+}
+
+// Sender recovers the sender of the transaction, which must be signed for the
+// given chain ID.
+func (tx *SetCodeTx) Sender(chainID *big.Int) (common.Address, error) {
+	if txChainID := tx.GetChainID(); txChainID == nil || txChainID.Cmp(chainID) != 0 {
+		return common.Address{}, ethtypes.ErrInvalidChainId
+	}
+
+	v, r, s := tx.GetRawSignatureValues()
+	return recoverAddress(tx.SigHash(), v, r, s)
+}
+
+func (tx *SetCodeTx) toRLP() *setCodeTxRLP {
+	v, r, s := tx.GetRawSignatureValues()
+	enc := &setCodeTxRLP{
+		ChainID:    bigOrZero(tx.GetChainID()),
+		Nonce:      tx.Nonce,
+		GasTipCap:  bigOrZero(tx.GetGasTipCap()),
+		GasFeeCap:  bigOrZero(tx.GetGasFeeCap()),
+		Gas:        tx.GasLimit,
+		To:         common.HexToAddress(tx.To),
+		Value:      bigOrZero(tx.GetValue()),
+		Data:       tx.Data,
+		AccessList: tx.GetAccessList(),
+		AuthList:   make([]authorizationRLP, len(tx.Authorizations)),
+		V:          bigOrZero(v),
+		R:          bigOrZero(r),
+		S:          bigOrZero(s),
+	}
+	if enc.AccessList == nil {
+		enc.AccessList = ethtypes.AccessList{}
+	}
+	for i, auth := range tx.Authorizations {
+		enc.AuthList[i] = auth.toRLP()
+	}
+	return enc
+}
+
+// AuthorizationList is the list of EIP-7702 authorizations of a set code
+// transaction.
+type AuthorizationList []SetCodeAuthorization
+
+// GetChainID returns the chain ID of the authorization, where zero means that it
+// is valid on all chains.
+func (auth SetCodeAuthorization) GetChainID() *big.Int {
+	if auth.ChainID.IsNil() {
+		return new(big.Int)
+	}
+	return auth.ChainID.BigInt()
+}
+
+// GetAddress returns the address of the contract the code is delegated to.
+func (auth SetCodeAuthorization) GetAddress() common.Address {
+	return common.HexToAddress(auth.Address)
+}
+
+// GetRawSignatureValues returns the V, R, S signature values of the authorization.
+func (auth SetCodeAuthorization) GetRawSignatureValues() (v, r, s *big.Int) {
+	return ethutils.RawSignatureValues(auth.V, auth.R, auth.S)
+}
+
+// SigHash returns the hash signed by the authority:
+// keccak256(0x05 || rlp([chain_id, address, nonce])).
+func (auth SetCodeAuthorization) SigHash() common.Hash {
+	return prefixedRlpHash(setCodeAuthorizationMagic, []interface{}{
+		auth.GetChainID(),
+		auth.GetAddress(),
+		auth.Nonce,
+	})
+}
+
+// Authority recovers the address of the account that signed the authorization.
+func (auth SetCodeAuthorization) Authority() (common.Address, error) {
+	v, r, s := auth.GetRawSignatureValues()
+	return recoverAddress(auth.SigHash(), v, r, s)
+}
+
+// Validate performs a stateless validation of the authorization fields. The
+// signature is only checked when recovering the authority, as the transaction
+// remains valid with invalid authorizations.
+func (auth SetCodeAuthorization) Validate() error {
+	if err := types.ValidateAddress(auth.Address); err != nil {
+		return errorsmod.Wrap(err, "invalid delegation address")
+	}
+
+	chainID := auth.GetChainID()
+	if chainID.Sign() == -1 || !types.IsValidInt256(chainID) {
+		return errorsmod.Wrapf(ErrInvalidAuthorization, "invalid chain ID %s", chainID)
+	}
+
+	return nil
+}
+
+func (auth SetCodeAuthorization) toRLP() authorizationRLP {
+	v, r, s := auth.GetRawSignatureValues()
+	return authorizationRLP{
+		ChainID: auth.GetChainID(),
+		Address: auth.GetAddress(),
+		Nonce:   auth.Nonce,
+		V:       bigOrZero(v),
+		R:       bigOrZero(r),
+		S:       bigOrZero(s),
+	}
+}
+
+// setCodeTxRLP is the RLP encoding of a SetCodeTx.
+type setCodeTxRLP struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         common.Address
+	Value      *big.Int
+	Data       []byte
+	AccessList ethtypes.AccessList
+	AuthList   []authorizationRLP
+	V, R, S    *big.Int
+}
+
+// authorizationRLP is the RLP encoding of a SetCodeAuthorization.
+type authorizationRLP struct {
+	ChainID *big.Int
+	Address common.Address
+	Nonce   uint64
+	V, R, S *big.Int
+}
+
+// recoverAddress returns the address that signed the hash with the given
+// signature values, where v is the y parity of the signature. A zero y parity is
+// stored as empty bytes, so a nil v is zero.
+func recoverAddress(hash common.Hash, v, r, s *big.Int) (common.Address, error) {
+	v = bigOrZero(v)
+	if r == nil || s == nil || !v.IsUint64() || v.Uint64() > 1 {
+		return common.Address{}, ethtypes.ErrInvalidSig
+	}
+
+	yParity := byte(v.Uint64())
+	if !crypto.ValidateSignatureValues(yParity, r, s, true) {
+		return common.Address{}, ethtypes.ErrInvalidSig
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:64])
+	sig[crypto.RecoveryIDOffset] = yParity
+
+	pub, err := crypto.Ecrecover(hash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(pub) == 0 || pub[0] != 4 {
+		return common.Address{}, errors.New("invalid public key")
+	}
+
+	return common.BytesToAddress(crypto.Keccak256(pub[1:])[12:]), nil
+}
+
+func prefixedRlpHash(prefix byte, x interface{}) common.Hash {
+	bz, err := rlp.EncodeToBytes(x)
+	if err != nil {
+		return common.Hash{}
+	}
+	return crypto.Keccak256Hash(append([]byte{prefix}, bz...))
+}
+
+func bigOrZero(i *big.Int) *big.Int {
+	if i == nil {
+		return new(big.Int)
+	}
+	return i
+}
+
+// SetCodeMessage is the core.Message of a set code transaction. It carries the
+// authorization list, which is applied before executing the message.
+type SetCodeMessage struct {
+	ethtypes.Message
+	Authorizations AuthorizationList
+	// Applied is set when the authorizations were already applied and committed
+	// ahead of the execution, so that they aren't reverted with it. The execution
+	// then only warms the authorities and credits the refund.
+	Applied *AppliedAuthorizations
+}
+
+// AppliedAuthorizations is the outcome of applying an authorization list.
+type AppliedAuthorizations struct {
+	// Authorities are the recovered authorities, which are added to the access list
+	Authorities []common.Address
+	// Refund is the gas refunded for the authorities that already existed
+	Refund uint64
+}
+
+// AddAuthorizationsGas adds the intrinsic gas of the authorizations of a set code
+// transaction to the given intrinsic gas.
+func AddAuthorizationsGas(gas uint64, authorizations AuthorizationList) (uint64, error) {
+	n := uint64(len(authorizations))
+	if n > 0 && (math.MaxUint64-gas)/n < PerEmptyAccountCost {
+		return 0, core.ErrGasUintOverflow
+	}
+	return gas + n*PerEmptyAccountCost, nil
+}
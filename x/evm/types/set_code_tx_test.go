@@ -0,0 +1,214 @@
+package types_test
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/x/evm/types"
+)
+
+func signAuthorization(t *testing.T, key *ecdsa.PrivateKey, auth types.SetCodeAuthorization) types.SetCodeAuthorization {
+	sig, err := crypto.Sign(auth.SigHash().Bytes(), key)
+	require.NoError(t, err)
+
+	// the y parity is stored as big endian bytes, i.e. empty for zero
+	auth.R, auth.S, auth.V = sig[:32], sig[32:64], big.NewInt(int64(sig[64])).Bytes()
+	return auth
+}
+
+func newSetCodeTx(t *testing.T, key *ecdsa.PrivateKey, chainID *big.Int, auths types.AuthorizationList) *types.SetCodeTx {
+	cid := sdkmath.NewIntFromBigInt(chainID)
+	tip, feeCap, amount := sdkmath.NewInt(1), sdkmath.NewInt(10), sdkmath.NewInt(5)
+	tx := &types.SetCodeTx{
+		ChainID:        &cid,
+		Nonce:          3,
+		GasTipCap:      &tip,
+		GasFeeCap:      &feeCap,
+		GasLimit:       100_000,
+		To:             common.HexToAddress("0x01").Hex(),
+		Amount:         &amount,
+		Data:           []byte("data"),
+		Accesses:       types.AccessList{{Address: common.HexToAddress("0x02").Hex(), StorageKeys: []string{common.Hash{}.Hex()}}},
+		Authorizations: auths,
+	}
+
+	sig, err := crypto.Sign(tx.SigHash().Bytes(), key)
+	require.NoError(t, err)
+	tx.SetSignatureValues(nil, big.NewInt(int64(sig[64])), new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64]))
+	return tx
+}
+
+func TestSetCodeTxEncoding(t *testing.T) {
+	chainID := big.NewInt(9001)
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	authorityKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	auth := signAuthorization(t, authorityKey, types.SetCodeAuthorization{
+		ChainID: sdkmath.ZeroInt(),
+		Address: common.HexToAddress("0xc0de").Hex(),
+		Nonce:   7,
+	})
+	tx := newSetCodeTx(t, key, chainID, types.AuthorizationList{auth})
+	require.NoError(t, tx.Validate())
+
+	bz, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, byte(types.SetCodeTxType), bz[0])
+	require.Equal(t, crypto.Keccak256Hash(bz), tx.Hash())
+
+	decoded, err := types.NewSetCodeTx(bz)
+	require.NoError(t, err)
+	require.Equal(t, tx.Hash(), decoded.Hash())
+	require.Equal(t, tx.GetAccessList(), decoded.GetAccessList())
+	require.Len(t, decoded.GetAuthorizations(), 1)
+
+	// the sender and the authority are recovered from the signatures
+	sender, err := decoded.Sender(chainID)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), sender)
+
+	authority, err := decoded.GetAuthorizations()[0].Authority()
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(authorityKey.PublicKey), authority)
+
+	_, err = decoded.Sender(big.NewInt(1))
+	require.ErrorIs(t, err, ethtypes.ErrInvalidChainId)
+
+	// the signature of the sender covers the authorization list
+	decoded.Authorizations[0].Nonce++
+	sender, err = decoded.Sender(chainID)
+	require.NoError(t, err)
+	require.NotEqual(t, crypto.PubkeyToAddress(key.PublicKey), sender)
+
+	_, err = types.NewSetCodeTx(bz[1:])
+	require.Error(t, err)
+}
+
+func TestSetCodeTxValidate(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	auth := signAuthorization(t, key, types.SetCodeAuthorization{
+		ChainID: sdkmath.ZeroInt(),
+		Address: common.HexToAddress("0xc0de").Hex(),
+	})
+
+	testCases := []struct {
+		name     string
+		malleate func(tx *types.SetCodeTx)
+		expErr   string
+	}{
+		{"pass", func(*types.SetCodeTx) {}, ""},
+		{"fail - no chain ID", func(tx *types.SetCodeTx) { tx.ChainID = nil }, "chain ID must be present"},
+		{"fail - contract creation", func(tx *types.SetCodeTx) { tx.To = "" }, "can't create contracts"},
+		{"fail - nil gas tip cap", func(tx *types.SetCodeTx) { tx.GasTipCap = nil }, "gas tip cap cannot nil"},
+		{"fail - empty authorization list", func(tx *types.SetCodeTx) { tx.Authorizations = nil }, "authorization list cannot be empty"},
+		{
+			"fail - invalid delegation address",
+			func(tx *types.SetCodeTx) { tx.Authorizations[0].Address = "0x123" },
+			"invalid delegation address",
+		},
+		{
+			"fail - negative authorization chain ID",
+			func(tx *types.SetCodeTx) { tx.Authorizations[0].ChainID = sdkmath.NewInt(-1) },
+			"invalid chain ID",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := newSetCodeTx(t, key, big.NewInt(9001), types.AuthorizationList{auth})
+			tc.malleate(tx)
+
+			err := tx.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestSetCodeAuthorizationAuthority(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	auth := signAuthorization(t, key, types.SetCodeAuthorization{
+		ChainID: sdkmath.NewInt(9001),
+		Address: common.HexToAddress("0xc0de").Hex(),
+		Nonce:   1,
+	})
+
+	authority, err := auth.Authority()
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), authority)
+
+	// y parity must be 0 or 1
+	invalid := auth
+	invalid.V = []byte{27}
+	_, err = invalid.Authority()
+	require.ErrorIs(t, err, ethtypes.ErrInvalidSig)
+
+	// high s values are malleable
+	invalid = auth
+	invalid.S = crypto.S256().Params().N.Bytes()
+	_, err = invalid.Authority()
+	require.ErrorIs(t, err, ethtypes.ErrInvalidSig)
+
+	// the signature covers the chain ID
+	other := auth
+	other.ChainID = sdkmath.ZeroInt()
+	authority, err = other.Authority()
+	require.NoError(t, err)
+	require.NotEqual(t, crypto.PubkeyToAddress(key.PublicKey), authority)
+}
+
+func TestMsgEthereumTxSetCode(t *testing.T) {
+	chainID := big.NewInt(9001)
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	auth := signAuthorization(t, key, types.SetCodeAuthorization{
+		ChainID: sdkmath.ZeroInt(),
+		Address: common.HexToAddress("0xc0de").Hex(),
+	})
+	tx := newSetCodeTx(t, key, chainID, types.AuthorizationList{auth})
+
+	bz, err := tx.MarshalBinary()
+	require.NoError(t, err)
+
+	msg := &types.MsgEthereumTx{}
+	require.NoError(t, msg.UnmarshalBinary(bz))
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, tx.Hash(), msg.TxHash())
+	require.Equal(t, tx.Hash().Hex(), msg.Hash)
+
+	msgBz, err := msg.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, bz, msgBz)
+
+	sender, err := msg.GetSender(chainID)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), sender)
+	require.Equal(t, sender.Hex(), msg.From)
+
+	coreMsg, err := msg.AsMessage(ethtypes.LatestSignerForChainID(chainID), big.NewInt(5))
+	require.NoError(t, err)
+	setCodeMsg, ok := coreMsg.(types.SetCodeMessage)
+	require.True(t, ok)
+	require.Equal(t, sender, setCodeMsg.From())
+	require.Equal(t, big.NewInt(6), setCodeMsg.GasPrice())
+	require.Equal(t, types.AuthorizationList{auth}, setCodeMsg.Authorizations)
+
+	// the intrinsic gas of the authorizations
+	gas, err := types.AddAuthorizationsGas(21000, setCodeMsg.Authorizations)
+	require.NoError(t, err)
+	require.Equal(t, uint64(21000)+types.PerEmptyAccountCost, gas)
+}
@@ -0,0 +1,85 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// MaxSimulateBlocks is the maximum number of blocks that can be simulated
+// on a single eth_simulateV1 request.
+const MaxSimulateBlocks = 256
+
+// MaxSimulateCalls is the maximum number of calls that can be simulated on a
+// single eth_simulateV1 request, across all of its blocks.
+const MaxSimulateCalls = 1000
+
+// SimOpts are the inputs of eth_simulateV1.
+type SimOpts struct {
+	BlockStateCalls []SimBlock `json:"blockStateCalls"`
+	TraceTransfers  bool       `json:"traceTransfers"`
+	Validation      bool       `json:"validation"`
+}
+
+// SimBlock is a batch of calls to be simulated sequentially on top of the
+// given block and state overrides.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides   `json:"blockOverrides"`
+	StateOverrides StateOverride     `json:"stateOverrides"`
+	Calls          []TransactionArgs `json:"calls"`
+}
+
+// Validate performs a stateless validation of the simulation options.
+func (opts SimOpts) Validate() error {
+	if len(opts.BlockStateCalls) == 0 {
+		return errors.New("empty input")
+	}
+	if len(opts.BlockStateCalls) > MaxSimulateBlocks {
+		return fmt.Errorf("too many blocks: %d > %d", len(opts.BlockStateCalls), MaxSimulateBlocks)
+	}
+	var calls int
+	for _, block := range opts.BlockStateCalls {
+		calls += len(block.Calls)
+	}
+	if calls > MaxSimulateCalls {
+		return fmt.Errorf("too many calls: %d > %d", calls, MaxSimulateCalls)
+	}
+	if opts.TraceTransfers {
+		return errors.New("tracing transfers is not supported")
+	}
+	return nil
+}
+
+// SimCallResult is the result of a simulated call.
+type SimCallResult struct {
+	ReturnValue hexutil.Bytes   `json:"returnData"`
+	Logs        []*ethtypes.Log `json:"logs"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	Status      hexutil.Uint64  `json:"status"`
+	Error       *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a failed simulated call, following the JSON-RPC
+// error format.
+type SimCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// SimBlockResult is the result of a simulated block.
+type SimBlockResult struct {
+	Number        hexutil.Uint64  `json:"number"`
+	Timestamp     hexutil.Uint64  `json:"timestamp"`
+	GasLimit      hexutil.Uint64  `json:"gasLimit"`
+	GasUsed       hexutil.Uint64  `json:"gasUsed"`
+	Miner         common.Address  `json:"miner"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+	Transactions  []common.Hash   `json:"transactions"`
+	Calls         []SimCallResult `json:"calls"`
+}
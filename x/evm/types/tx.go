@@ -25,10 +25,16 @@ type EvmTxArgs struct {
 	GasTipCap *big.Int
 	To        *common.Address
 	Accesses  *ethtypes.AccessList
+	// AuthorizationList makes the tx an EIP-7702 set code transaction
+	AuthorizationList AuthorizationList
 }
 
 // ToTxData converts the EvmTxArgs to TxData
 func (args *EvmTxArgs) ToTxData() (TxData, error) {
+	if args.AuthorizationList != nil {
+		return UnpackTxData(NewTx(args).Data)
+	}
+
 	ethTx := NewTx(args).AsTransaction()
 	return NewTxDataFromTx(ethTx)
 }
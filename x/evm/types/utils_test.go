@@ -11,6 +11,7 @@ import (
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	proto "github.com/cosmos/gogoproto/proto"
 
@@ -78,6 +79,20 @@ func TestUnwrapEthererumMsg(t *testing.T) {
 	unwrappedMsg, err := evmtypes.UnwrapEthereumMsg(&tx, msg.AsTransaction().Hash())
 	require.Nil(t, err)
 	require.Equal(t, unwrappedMsg, msg)
+
+	// set code transactions are found by their own hash
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	bz, err := newSetCodeTx(t, key, big.NewInt(1), nil).MarshalBinary()
+	require.NoError(t, err)
+	setCodeMsg := &evmtypes.MsgEthereumTx{}
+	require.NoError(t, setCodeMsg.UnmarshalBinary(bz))
+	require.NoError(t, builder.SetMsgs(setCodeMsg))
+
+	tx = builder.GetTx().(sdk.Tx)
+	unwrappedMsg, err = evmtypes.UnwrapEthereumMsg(&tx, crypto.Keccak256Hash(bz))
+	require.NoError(t, err)
+	require.Equal(t, crypto.Keccak256Hash(bz).Hex(), unwrappedMsg.Hash)
 }
 
 func TestBinSearch(t *testing.T) {
@@ -25,6 +25,7 @@ func GetQueryCmd() *cobra.Command {
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
 		GetParamsCmd(),
+		GetFeeTokenRateCmd(),
 	)
 	return cmd
 }
@@ -116,3 +117,30 @@ If the height is not provided, it will use the latest height from context.`,
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetFeeTokenRateCmd queries the conversion rate of a fee token
+func GetFeeTokenRateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-token-rate DENOM",
+		Short: "Get the conversion rate of a fee token to the EVM denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeTokenRate(cmd.Context(), &types.QueryFeeTokenRateRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,94 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/evmos/evmos/v20/x/feemarket/types"
+)
+
+// NewTxCmd returns a root CLI command handler for fee market transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "fee market subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewSetFeeTokenRateCmd(),
+		NewSetFeeTokenPreferenceCmd(),
+	)
+	return txCmd
+}
+
+// NewSetFeeTokenRateCmd returns a CLI command handler for setting the
+// conversion rate of a fee token priced by an oracle
+func NewSetFeeTokenRateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee-token-rate DENOM RATE",
+		Short: "Set the conversion rate of a fee token to the EVM denom. The sender must be the oracle of the token.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			rate, err := math.LegacyNewDecFromStr(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid rate %s: %w", args[1], err)
+			}
+
+			msg := &types.MsgSetFeeTokenRate{
+				Oracle: cliCtx.GetFromAddress().String(),
+				Denom:  args[0],
+				Rate:   rate,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetFeeTokenPreferenceCmd returns a CLI command handler for choosing the
+// fee token used to pay the fees of the EVM transactions of the sender
+func NewSetFeeTokenPreferenceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee-token-preference [DENOM]",
+		Short: "Set the fee token used to pay the fees of the EVM transactions of the sender. The fees are paid in the EVM denom if no denom is given.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetFeeTokenPreference{
+				Sender: cliCtx.GetFromAddress().String(),
+			}
+			if len(args) > 0 {
+				msg.Denom = args[0]
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -24,13 +24,25 @@ func InitGenesis(
 
 	k.SetBlockGasWanted(ctx, data.BlockGas)
 
+	for _, rate := range data.OracleRates {
+		if err := k.SetOracleRate(ctx, rate.Denom, rate.Rate); err != nil {
+			panic(errorsmod.Wrapf(err, "could not set the oracle rate of %s at genesis", rate.Denom))
+		}
+	}
+
+	for _, preference := range data.FeeTokenPreferences {
+		k.SetAccountFeeToken(ctx, sdk.MustAccAddressFromBech32(preference.Address), preference.Denom)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis exports genesis state of the fee market module
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:   k.GetParams(ctx),
-		BlockGas: k.GetBlockGasWanted(ctx),
+		Params:              k.GetParams(ctx),
+		BlockGas:            k.GetBlockGasWanted(ctx),
+		OracleRates:         k.GetOracleRates(ctx),
+		FeeTokenPreferences: k.GetAccountFeeTokens(ctx),
 	}
 }
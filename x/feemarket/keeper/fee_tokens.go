@@ -0,0 +1,163 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v20/x/feemarket/types"
+)
+
+// GetFeeTokenRate returns the conversion rate of the given fee token to the EVM
+// denom, i.e. the amount of the EVM denom using 18 decimals paid by each base
+// unit of the token. It returns an error if the denom is not an accepted fee
+// token or if its oracle hasn't set a rate yet.
+func (k Keeper) GetFeeTokenRate(ctx sdk.Context, denom string) (math.LegacyDec, error) {
+	feeToken, found := k.GetParams(ctx).GetFeeToken(denom)
+	if !found {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrFeeTokenNotFound, "denom %s", denom)
+	}
+
+	switch feeToken.PriceSource {
+	case types.PriceSourceFixed:
+		return feeToken.FixedRate, nil
+	case types.PriceSourceOracle:
+		rate, found := k.getOracleRate(ctx, denom)
+		if !found {
+			return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidFeeTokenRate, "rate of %s not set by the oracle", denom)
+		}
+		return rate, nil
+	default:
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidFeeTokenRate, "invalid price source %s", feeToken.PriceSource)
+	}
+}
+
+// SetOracleRate sets the conversion rate of a fee token priced by an oracle.
+func (k Keeper) SetOracleRate(ctx sdk.Context, denom string, rate math.LegacyDec) error {
+	bz, err := rate.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeTokenRate)
+	store.Set([]byte(denom), bz)
+	return nil
+}
+
+// getOracleRate returns the conversion rate of a fee token set by its oracle.
+func (k Keeper) getOracleRate(ctx sdk.Context, denom string) (math.LegacyDec, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeTokenRate)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return math.LegacyDec{}, false
+	}
+
+	var rate math.LegacyDec
+	if err := rate.Unmarshal(bz); err != nil {
+		return math.LegacyDec{}, false
+	}
+	return rate, true
+}
+
+// GetOracleRates returns the conversion rates of the fee tokens set by their
+// oracles.
+func (k Keeper) GetOracleRates(ctx sdk.Context) []types.OracleRate {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeTokenRate)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var rates []types.OracleRate
+	for ; iterator.Valid(); iterator.Next() {
+		var rate math.LegacyDec
+		if err := rate.Unmarshal(iterator.Value()); err != nil {
+			continue
+		}
+		rates = append(rates, types.OracleRate{Denom: string(iterator.Key()), Rate: rate})
+	}
+	return rates
+}
+
+// pruneOracleRates deletes the rates set by the oracles of the tokens that are
+// no longer priced by an oracle in the given parameters.
+func (k Keeper) pruneOracleRates(ctx sdk.Context, params types.Params) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeTokenRate)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var stale [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		feeToken, found := params.GetFeeToken(string(iterator.Key()))
+		if !found || feeToken.PriceSource != types.PriceSourceOracle {
+			stale = append(stale, iterator.Key())
+		}
+	}
+
+	for _, key := range stale {
+		store.Delete(key)
+	}
+}
+
+// pruneAccountFeeTokens deletes the preferences of the accounts for the tokens
+// that are no longer accepted as fee tokens in the given parameters, so that
+// those accounts go back to paying the fees in the EVM denom.
+func (k Keeper) pruneAccountFeeTokens(ctx sdk.Context, params types.Params) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeTokenPreference)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var stale [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		if _, found := params.GetFeeToken(string(iterator.Value())); !found {
+			stale = append(stale, iterator.Key())
+		}
+	}
+
+	for _, key := range stale {
+		store.Delete(key)
+	}
+}
+
+// SetAccountFeeToken sets the fee token used to pay the fees of the EVM
+// transactions of the account. An empty denom removes the preference.
+func (k Keeper) SetAccountFeeToken(ctx sdk.Context, addr sdk.AccAddress, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeTokenPreference)
+	if denom == "" {
+		store.Delete(addr)
+		return
+	}
+	store.Set(addr, []byte(denom))
+}
+
+// GetAccountFeeToken returns the fee token chosen by the account to pay the
+// fees of its EVM transactions, if any.
+func (k Keeper) GetAccountFeeToken(ctx sdk.Context, addr sdk.AccAddress) (string, bool) {
+	if addr.Empty() {
+		return "", false
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeTokenPreference)
+	bz := store.Get(addr)
+	if len(bz) == 0 {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// GetAccountFeeTokens returns the fee tokens chosen by the accounts to pay
+// the fees of their EVM transactions.
+func (k Keeper) GetAccountFeeTokens(ctx sdk.Context) []types.FeeTokenPreference {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeTokenPreference)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var preferences []types.FeeTokenPreference
+	for ; iterator.Valid(); iterator.Next() {
+		preferences = append(preferences, types.FeeTokenPreference{
+			Address: sdk.AccAddress(iterator.Key()).String(),
+			Denom:   string(iterator.Value()),
+		})
+	}
+	return preferences
+}
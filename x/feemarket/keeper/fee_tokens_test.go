@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v20/testutil/integration/evmos/network"
+	"github.com/evmos/evmos/v20/x/feemarket"
+	"github.com/evmos/evmos/v20/x/feemarket/types"
+)
+
+const (
+	fixedDenom  = "ibc/fixed"
+	oracleDenom = "ibc/oracle"
+)
+
+func setFeeTokens(t *testing.T, nw *network.UnitTestNetwork, oracle string) {
+	t.Helper()
+
+	params := nw.App.FeeMarketKeeper.GetParams(nw.GetContext())
+	params.FeeTokens = []types.FeeToken{
+		types.NewFixedRateFeeToken(fixedDenom, sdkmath.LegacyNewDec(1_000_000_000_000)),
+		types.NewOracleFeeToken(oracleDenom, oracle),
+	}
+	require.NoError(t, nw.App.FeeMarketKeeper.SetParams(nw.GetContext(), params))
+}
+
+func TestSetFeeTokenRate(t *testing.T) {
+	oracle := authtypes.NewModuleAddress("oracle").String()
+	rate := sdkmath.LegacyNewDecWithPrec(15, 1)
+
+	testCases := []struct {
+		name    string
+		request *types.MsgSetFeeTokenRate
+		expErr  error
+	}{
+		{
+			"fail - unknown fee token",
+			&types.MsgSetFeeTokenRate{Oracle: oracle, Denom: "ibc/unknown", Rate: rate},
+			types.ErrFeeTokenNotFound,
+		},
+		{
+			"fail - fee token with a fixed rate",
+			&types.MsgSetFeeTokenRate{Oracle: oracle, Denom: fixedDenom, Rate: rate},
+			types.ErrInvalidFeeTokenRate,
+		},
+		{
+			"fail - not the oracle of the fee token",
+			&types.MsgSetFeeTokenRate{Oracle: authtypes.NewModuleAddress(govtypes.ModuleName).String(), Denom: oracleDenom, Rate: rate},
+			types.ErrUnauthorizedOracle,
+		},
+		{
+			"pass",
+			&types.MsgSetFeeTokenRate{Oracle: oracle, Denom: oracleDenom, Rate: rate},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nw := network.NewUnitTestNetwork()
+			ctx := nw.GetContext()
+			setFeeTokens(t, nw, oracle)
+
+			_, err := nw.App.FeeMarketKeeper.SetFeeTokenRate(ctx, tc.request)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			res, err := nw.GetFeeMarketClient().FeeTokenRate(ctx, &types.QueryFeeTokenRateRequest{Denom: oracleDenom})
+			require.NoError(t, err)
+			require.Equal(t, rate, res.Rate)
+		})
+	}
+}
+
+func TestGetFeeTokenRate(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	k := nw.App.FeeMarketKeeper
+	oracle := authtypes.NewModuleAddress("oracle").String()
+	setFeeTokens(t, nw, oracle)
+
+	rate, err := k.GetFeeTokenRate(ctx, fixedDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(1_000_000_000_000), rate)
+
+	// the rate of an oracle token is unavailable until the oracle sets it
+	_, err = k.GetFeeTokenRate(ctx, oracleDenom)
+	require.ErrorIs(t, err, types.ErrInvalidFeeTokenRate)
+
+	require.NoError(t, k.SetOracleRate(ctx, oracleDenom, sdkmath.LegacyNewDec(2)))
+	rate, err = k.GetFeeTokenRate(ctx, oracleDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(2), rate)
+
+	_, err = k.GetFeeTokenRate(ctx, "ibc/unknown")
+	require.ErrorIs(t, err, types.ErrFeeTokenNotFound)
+
+	// removing the token through governance deletes the rate set by the oracle
+	params := k.GetParams(ctx)
+	params.FeeTokens = params.FeeTokens[:1]
+	_, err = k.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Params:    params,
+	})
+	require.NoError(t, err)
+
+	params.FeeTokens = append(params.FeeTokens, types.NewOracleFeeToken(oracleDenom, oracle))
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = k.GetFeeTokenRate(ctx, oracleDenom)
+	require.ErrorIs(t, err, types.ErrInvalidFeeTokenRate)
+}
+
+func TestSetFeeTokenPreference(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	k := nw.App.FeeMarketKeeper
+	setFeeTokens(t, nw, authtypes.NewModuleAddress("oracle").String())
+	sender := authtypes.NewModuleAddress("sender")
+
+	_, err := k.SetFeeTokenPreference(ctx, &types.MsgSetFeeTokenPreference{Sender: sender.String(), Denom: "ibc/unknown"})
+	require.ErrorIs(t, err, types.ErrFeeTokenNotFound)
+	_, found := k.GetAccountFeeToken(ctx, sender)
+	require.False(t, found)
+
+	_, err = k.SetFeeTokenPreference(ctx, &types.MsgSetFeeTokenPreference{Sender: sender.String(), Denom: fixedDenom})
+	require.NoError(t, err)
+	denom, found := k.GetAccountFeeToken(ctx, sender)
+	require.True(t, found)
+	require.Equal(t, fixedDenom, denom)
+
+	// an empty denom goes back to paying the fees in the EVM denom
+	_, err = k.SetFeeTokenPreference(ctx, &types.MsgSetFeeTokenPreference{Sender: sender.String()})
+	require.NoError(t, err)
+	_, found = k.GetAccountFeeToken(ctx, sender)
+	require.False(t, found)
+}
+
+func TestFeeTokensGenesis(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	k := nw.App.FeeMarketKeeper
+	setFeeTokens(t, nw, authtypes.NewModuleAddress("oracle").String())
+	sender := authtypes.NewModuleAddress("sender")
+
+	require.NoError(t, k.SetOracleRate(ctx, oracleDenom, sdkmath.LegacyNewDec(2)))
+	k.SetAccountFeeToken(ctx, sender, oracleDenom)
+
+	genesis := feemarket.ExportGenesis(ctx, k)
+	require.NoError(t, genesis.Validate())
+	require.Equal(t, []types.OracleRate{{Denom: oracleDenom, Rate: sdkmath.LegacyNewDec(2)}}, genesis.OracleRates)
+	require.Equal(t, []types.FeeTokenPreference{{Address: sender.String(), Denom: oracleDenom}}, genesis.FeeTokenPreferences)
+
+	// the oracle rates and preferences are restored on a fresh chain
+	nw = network.NewUnitTestNetwork()
+	ctx = nw.GetContext()
+	k = nw.App.FeeMarketKeeper
+	feemarket.InitGenesis(ctx, k, *genesis)
+
+	rate, err := k.GetFeeTokenRate(ctx, oracleDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(2), rate)
+	denom, found := k.GetAccountFeeToken(ctx, sender)
+	require.True(t, found)
+	require.Equal(t, oracleDenom, denom)
+}
+
+func TestRemoveFeeToken(t *testing.T) {
+	nw := network.NewUnitTestNetwork()
+	ctx := nw.GetContext()
+	k := nw.App.FeeMarketKeeper
+	setFeeTokens(t, nw, authtypes.NewModuleAddress("oracle").String())
+	fixedSender := authtypes.NewModuleAddress("fixed_sender")
+	oracleSender := authtypes.NewModuleAddress("oracle_sender")
+
+	k.SetAccountFeeToken(ctx, fixedSender, fixedDenom)
+	k.SetAccountFeeToken(ctx, oracleSender, oracleDenom)
+
+	// removing the token through governance deletes the preferences of the
+	// accounts that chose it
+	params := k.GetParams(ctx)
+	params.FeeTokens = params.FeeTokens[:1]
+	_, err := k.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Params:    params,
+	})
+	require.NoError(t, err)
+
+	_, found := k.GetAccountFeeToken(ctx, oracleSender)
+	require.False(t, found)
+	denom, found := k.GetAccountFeeToken(ctx, fixedSender)
+	require.True(t, found)
+	require.Equal(t, fixedDenom, denom)
+
+	// the exported genesis remains valid
+	genesis := feemarket.ExportGenesis(ctx, k)
+	require.NoError(t, genesis.Validate())
+	require.Equal(t, []types.FeeTokenPreference{{Address: fixedSender.String(), Denom: fixedDenom}}, genesis.FeeTokenPreferences)
+}
@@ -8,6 +8,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/evmos/evmos/v20/x/feemarket/types"
 )
@@ -48,3 +50,20 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: gas.Int64(),
 	}, nil
 }
+
+// FeeTokenRate implements the Query/FeeTokenRate gRPC method
+func (k Keeper) FeeTokenRate(c context.Context, req *types.QueryFeeTokenRateRequest) (*types.QueryFeeTokenRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rate, err := k.GetFeeTokenRate(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryFeeTokenRateResponse{
+		Rate: rate,
+	}, nil
+}
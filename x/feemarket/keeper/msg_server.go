@@ -25,5 +25,73 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 		return nil, err
 	}
 
+	k.pruneOracleRates(ctx, req.Params)
+	k.pruneAccountFeeTokens(ctx, req.Params)
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetFeeTokenRate implements the gRPC MsgServer interface. It updates the
+// conversion rate of a fee token, which can only be performed by the oracle
+// of the token defined in the module parameters.
+func (k *Keeper) SetFeeTokenRate(goCtx context.Context, req *types.MsgSetFeeTokenRate) (*types.MsgSetFeeTokenRateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	feeToken, found := k.GetParams(ctx).GetFeeToken(req.Denom)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrFeeTokenNotFound, "denom %s", req.Denom)
+	}
+
+	if feeToken.PriceSource != types.PriceSourceOracle {
+		return nil, errorsmod.Wrapf(types.ErrInvalidFeeTokenRate, "rate of %s is not set by an oracle", req.Denom)
+	}
+
+	if feeToken.Oracle != req.Oracle {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorizedOracle, "expected %s, got %s", feeToken.Oracle, req.Oracle)
+	}
+
+	if err := k.SetOracleRate(ctx, req.Denom, req.Rate); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeTokenRate,
+			sdk.NewAttribute(types.AttributeKeyDenom, req.Denom),
+			sdk.NewAttribute(types.AttributeKeyRate, req.Rate.String()),
+		),
+	)
+
+	return &types.MsgSetFeeTokenRateResponse{}, nil
+}
+
+// SetFeeTokenPreference implements the gRPC MsgServer interface. It sets the
+// fee token used to pay the fees of the EVM transactions of the sender, which
+// must be accepted by the fee market. An empty denom removes the preference,
+// so that the fees are paid in the EVM denom.
+func (k *Keeper) SetFeeTokenPreference(goCtx context.Context, req *types.MsgSetFeeTokenPreference) (*types.MsgSetFeeTokenPreferenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if req.Denom != "" {
+		if _, found := k.GetParams(ctx).GetFeeToken(req.Denom); !found {
+			return nil, errorsmod.Wrapf(types.ErrFeeTokenNotFound, "denom %s", req.Denom)
+		}
+	}
+
+	k.SetAccountFeeToken(ctx, sender, req.Denom)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeTokenPreference,
+			sdk.NewAttribute(types.AttributeKeySender, req.Sender),
+			sdk.NewAttribute(types.AttributeKeyDenom, req.Denom),
+		),
+	)
+
+	return &types.MsgSetFeeTokenPreferenceResponse{}, nil
+}
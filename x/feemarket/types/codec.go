@@ -22,7 +22,9 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "ethermint/feemarket/MsgUpdateParams"
+	updateParamsName          = "ethermint/feemarket/MsgUpdateParams"
+	setFeeTokenRateName       = "ethermint/feemarket/MsgSetFeeTokenRate"
+	setFeeTokenPreferenceName = "ethermint/feemarket/MsgSetFeeTokenPreference"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -36,6 +38,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgSetFeeTokenRate{},
+		&MsgSetFeeTokenPreference{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -43,4 +47,6 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgSetFeeTokenRate{}, setFeeTokenRateName, nil)
+	cdc.RegisterConcrete(&MsgSetFeeTokenPreference{}, setFeeTokenPreferenceName, nil)
 }
@@ -0,0 +1,24 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	codeErrFeeTokenNotFound = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
+	codeErrInvalidFeeTokenRate
+	codeErrUnauthorizedOracle
+)
+
+var (
+	// ErrFeeTokenNotFound returns an error if the denom is not an accepted fee token.
+	ErrFeeTokenNotFound = errorsmod.Register(ModuleName, codeErrFeeTokenNotFound, "fee token not found")
+
+	// ErrInvalidFeeTokenRate returns an error if the conversion rate of a fee token is invalid or not set.
+	ErrInvalidFeeTokenRate = errorsmod.Register(ModuleName, codeErrInvalidFeeTokenRate, "invalid fee token rate")
+
+	// ErrUnauthorizedOracle returns an error if the signer is not the oracle of the fee token.
+	ErrUnauthorizedOracle = errorsmod.Register(ModuleName, codeErrUnauthorizedOracle, "unauthorized fee token oracle")
+)
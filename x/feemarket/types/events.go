@@ -4,7 +4,13 @@ package types
 
 // feemarket module events
 const (
-	EventTypeFeeMarket = "fee_market"
+	EventTypeFeeMarket    = "fee_market"
+	EventTypeFeeTokenRate = "fee_token_rate"
+
+	EventTypeFeeTokenPreference = "fee_token_preference"
 
 	AttributeKeyBaseFee = "base_fee"
+	AttributeKeyDenom   = "denom"
+	AttributeKeyRate    = "rate"
+	AttributeKeySender  = "sender"
 )
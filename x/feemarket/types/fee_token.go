@@ -0,0 +1,98 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewFixedRateFeeToken creates a new fee token converted to the EVM denom at a
+// fixed rate.
+func NewFixedRateFeeToken(denom string, rate math.LegacyDec) FeeToken {
+	return FeeToken{
+		Denom:       denom,
+		PriceSource: PriceSourceFixed,
+		FixedRate:   rate,
+	}
+}
+
+// NewOracleFeeToken creates a new fee token converted to the EVM denom at the
+// rate set by the given oracle.
+func NewOracleFeeToken(denom, oracle string) FeeToken {
+	return FeeToken{
+		Denom:       denom,
+		PriceSource: PriceSourceOracle,
+		FixedRate:   math.LegacyZeroDec(),
+		Oracle:      oracle,
+	}
+}
+
+// Validate performs a basic validation of the fee token.
+func (ft FeeToken) Validate() error {
+	if err := sdk.ValidateDenom(ft.Denom); err != nil {
+		return fmt.Errorf("invalid fee token denom: %w", err)
+	}
+
+	switch ft.PriceSource {
+	case PriceSourceFixed:
+		if ft.FixedRate.IsNil() || !ft.FixedRate.IsPositive() {
+			return fmt.Errorf("fixed rate of fee token %s must be positive: %s", ft.Denom, ft.FixedRate)
+		}
+		if ft.Oracle != "" {
+			return fmt.Errorf("fee token %s with a fixed rate cannot have an oracle", ft.Denom)
+		}
+	case PriceSourceOracle:
+		if !ft.FixedRate.IsNil() && !ft.FixedRate.IsZero() {
+			return fmt.Errorf("fee token %s with an oracle cannot have a fixed rate", ft.Denom)
+		}
+		if _, err := sdk.AccAddressFromBech32(ft.Oracle); err != nil {
+			return fmt.Errorf("invalid oracle address of fee token %s: %w", ft.Denom, err)
+		}
+	default:
+		return fmt.Errorf("invalid price source of fee token %s: %s", ft.Denom, ft.PriceSource)
+	}
+
+	return nil
+}
+
+// GetFeeToken returns the fee token with the given denom, if any.
+func (p Params) GetFeeToken(denom string) (FeeToken, bool) {
+	for _, ft := range p.FeeTokens {
+		if ft.Denom == denom {
+			return ft, true
+		}
+	}
+	return FeeToken{}, false
+}
+
+// ConvertToFeeToken converts an amount of the EVM denom, using 18 decimals,
+// into the amount of the fee token with the given rate. The result is rounded
+// up so that the fees paid in the fee token are never lower than the ones that
+// would be paid in the EVM denom.
+func ConvertToFeeToken(amount math.Int, rate math.LegacyDec) math.Int {
+	return math.LegacyNewDecFromInt(amount).QuoRoundUp(rate).Ceil().TruncateInt()
+}
+
+// ConvertRefundToFeeToken converts an amount of the EVM denom, using 18
+// decimals, into the amount of the fee token with the given rate. The result
+// is rounded down so that a refund never exceeds the fees paid.
+func ConvertRefundToFeeToken(amount math.Int, rate math.LegacyDec) math.Int {
+	return math.LegacyNewDecFromInt(amount).QuoTruncate(rate).TruncateInt()
+}
+
+func validateFeeTokens(feeTokens []FeeToken) error {
+	seen := make(map[string]bool, len(feeTokens))
+	for _, ft := range feeTokens {
+		if err := ft.Validate(); err != nil {
+			return err
+		}
+		if seen[ft.Denom] {
+			return fmt.Errorf("duplicate fee token: %s", ft.Denom)
+		}
+		seen[ft.Denom] = true
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeTokensValidate(t *testing.T) {
+	oracle := authtypes.NewModuleAddress("oracle").String()
+	rate := math.LegacyNewDec(1_000_000_000_000)
+
+	testCases := []struct {
+		name      string
+		feeTokens []FeeToken
+		expError  bool
+	}{
+		{"pass - empty", nil, false},
+		{
+			"pass - fixed and oracle",
+			[]FeeToken{NewFixedRateFeeToken("ibc/usdc", rate), NewOracleFeeToken("ibc/usdt", oracle)},
+			false,
+		},
+		{"fail - invalid denom", []FeeToken{NewFixedRateFeeToken("1", rate)}, true},
+		{"fail - zero fixed rate", []FeeToken{NewFixedRateFeeToken("ibc/usdc", math.LegacyZeroDec())}, true},
+		{"fail - nil fixed rate", []FeeToken{{Denom: "ibc/usdc", PriceSource: PriceSourceFixed}}, true},
+		{"fail - invalid oracle", []FeeToken{NewOracleFeeToken("ibc/usdc", "oracle")}, true},
+		{
+			"fail - oracle token with a fixed rate",
+			[]FeeToken{{Denom: "ibc/usdc", PriceSource: PriceSourceOracle, FixedRate: rate, Oracle: oracle}},
+			true,
+		},
+		{"fail - unspecified price source", []FeeToken{{Denom: "ibc/usdc", FixedRate: rate}}, true},
+		{
+			"fail - duplicate denom",
+			[]FeeToken{NewFixedRateFeeToken("ibc/usdc", rate), NewOracleFeeToken("ibc/usdc", oracle)},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.FeeTokens = tc.feeTokens
+
+			err := params.Validate()
+			if tc.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConvertToFeeToken(t *testing.T) {
+	rate := math.LegacyNewDec(3)
+
+	// fees are rounded up while refunds are rounded down
+	require.Equal(t, math.NewInt(4), ConvertToFeeToken(math.NewInt(10), rate))
+	require.Equal(t, math.NewInt(3), ConvertRefundToFeeToken(math.NewInt(10), rate))
+	require.Equal(t, math.NewInt(3), ConvertToFeeToken(math.NewInt(9), rate))
+	require.Equal(t, math.NewInt(3), ConvertRefundToFeeToken(math.NewInt(9), rate))
+
+	// a 6 decimals token worth 1 EVM coin
+	rate = math.LegacyNewDec(1_000_000_000_000)
+	require.Equal(t, math.NewInt(21), ConvertToFeeToken(math.NewInt(21_000_000_000_000), rate))
+	require.Equal(t, math.NewInt(1), ConvertToFeeToken(math.NewInt(1), rate))
+	require.True(t, ConvertRefundToFeeToken(math.NewInt(1), rate).IsZero())
+}
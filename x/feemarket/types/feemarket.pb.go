@@ -6,6 +6,7 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -25,6 +26,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PriceSource defines the source of the conversion rate of a fee token
+type PriceSource int32
+
+const (
+	// PRICE_SOURCE_UNSPECIFIED is an invalid price source
+	PriceSourceUnspecified PriceSource = 0
+	// PRICE_SOURCE_FIXED uses the fixed rate defined in the parameters
+	PriceSourceFixed PriceSource = 1
+	// PRICE_SOURCE_ORACLE uses the rate set by the oracle of the token
+	PriceSourceOracle PriceSource = 2
+)
+
+var PriceSource_name = map[int32]string{
+	0: "PRICE_SOURCE_UNSPECIFIED",
+	1: "PRICE_SOURCE_FIXED",
+	2: "PRICE_SOURCE_ORACLE",
+}
+
+var PriceSource_value = map[string]int32{
+	"PRICE_SOURCE_UNSPECIFIED": 0,
+	"PRICE_SOURCE_FIXED":       1,
+	"PRICE_SOURCE_ORACLE":      2,
+}
+
+func (x PriceSource) String() string {
+	return proto.EnumName(PriceSource_name, int32(x))
+}
+
+func (PriceSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{0}
+}
+
 // Params defines the feemarket module parameters
 type Params struct {
 	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
@@ -44,6 +77,9 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_multiplier"`
+	// fee_tokens defines the alternate denominations accepted to pay the fees of
+	// the EVM transactions along with the source of their conversion rate
+	FeeTokens []FeeToken `protobuf:"bytes,9,rep,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -107,8 +143,86 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetFeeTokens() []FeeToken {
+	if m != nil {
+		return m.FeeTokens
+	}
+	return nil
+}
+
+// FeeToken defines an alternate denomination accepted to pay the fees of the
+// EVM transactions
+type FeeToken struct {
+	// denom of the token
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// price_source defines where the conversion rate of the token comes from
+	PriceSource PriceSource `protobuf:"varint,2,opt,name=price_source,json=priceSource,proto3,enum=ethermint.feemarket.v1.PriceSource" json:"price_source,omitempty"`
+	// fixed_rate is the amount of the EVM denom, using 18 decimals, paid by each
+	// base unit of the token. It's only used by the PRICE_SOURCE_FIXED source.
+	FixedRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=fixed_rate,json=fixedRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fixed_rate"`
+	// oracle is the address allowed to set the conversion rate of the token. It's
+	// only used by the PRICE_SOURCE_ORACLE source.
+	Oracle string `protobuf:"bytes,4,opt,name=oracle,proto3" json:"oracle,omitempty"`
+}
+
+func (m *FeeToken) Reset()         { *m = FeeToken{} }
+func (m *FeeToken) String() string { return proto.CompactTextString(m) }
+func (*FeeToken) ProtoMessage()    {}
+func (*FeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{1}
+}
+func (m *FeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeToken.Merge(m, src)
+}
+func (m *FeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeToken proto.InternalMessageInfo
+
+func (m *FeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeToken) GetPriceSource() PriceSource {
+	if m != nil {
+		return m.PriceSource
+	}
+	return PriceSourceUnspecified
+}
+
+func (m *FeeToken) GetOracle() string {
+	if m != nil {
+		return m.Oracle
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("ethermint.feemarket.v1.PriceSource", PriceSource_name, PriceSource_value)
 	proto.RegisterType((*Params)(nil), "ethermint.feemarket.v1.Params")
+	proto.RegisterType((*FeeToken)(nil), "ethermint.feemarket.v1.FeeToken")
 }
 
 func init() {
@@ -116,34 +230,50 @@ func init() {
 }
 
 var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 427 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xc1, 0x6e, 0xd4, 0x30,
-	0x10, 0x5d, 0xb3, 0xed, 0x76, 0xeb, 0xb2, 0xd2, 0x62, 0x15, 0x14, 0xb5, 0x22, 0x5d, 0x81, 0x84,
-	0xa2, 0x0a, 0x25, 0x94, 0xde, 0x90, 0xb8, 0x6c, 0xab, 0x82, 0x50, 0x91, 0xaa, 0x1c, 0x38, 0x70,
-	0xb1, 0x9c, 0x74, 0x9a, 0x8c, 0x1a, 0xdb, 0xab, 0xd8, 0x8d, 0xd8, 0x5f, 0xe0, 0xc4, 0x67, 0x70,
-	0xec, 0x67, 0xf4, 0xd8, 0x23, 0xe2, 0x50, 0xa1, 0xcd, 0xa1, 0xbf, 0x81, 0x1a, 0xd3, 0xa4, 0x1c,
-	0xf7, 0x32, 0x1a, 0xbf, 0xf7, 0xe6, 0x79, 0xec, 0x19, 0xfa, 0x0a, 0x6c, 0x0e, 0xa5, 0x44, 0x65,
-	0xa3, 0x33, 0x00, 0x29, 0xca, 0x73, 0xb0, 0x51, 0xb5, 0xd7, 0x1d, 0xc2, 0x59, 0xa9, 0xad, 0x66,
-	0xcf, 0x5a, 0x5d, 0xd8, 0x51, 0xd5, 0xde, 0xd6, 0x13, 0x21, 0x51, 0xe9, 0xa8, 0x89, 0x4e, 0xba,
-	0xb5, 0x99, 0xe9, 0x4c, 0x37, 0x69, 0x74, 0x97, 0x39, 0xf4, 0x45, 0xdd, 0xa7, 0x83, 0x13, 0x51,
-	0x0a, 0x69, 0x98, 0x4f, 0x37, 0x94, 0xe6, 0x89, 0x30, 0xc0, 0xcf, 0x00, 0x3c, 0x32, 0x21, 0xc1,
-	0x30, 0x5e, 0x57, 0x7a, 0x2a, 0x0c, 0x1c, 0x01, 0xb0, 0xf7, 0x74, 0xfb, 0x9e, 0xe4, 0x69, 0x2e,
-	0x54, 0x06, 0xfc, 0x14, 0x94, 0x96, 0xa8, 0x84, 0xd5, 0xa5, 0xf7, 0x68, 0x42, 0x82, 0x51, 0xec,
-	0x25, 0x4e, 0x7d, 0xd0, 0x08, 0x0e, 0x3b, 0x9e, 0xed, 0xd3, 0xa7, 0x50, 0x08, 0x63, 0x31, 0x45,
-	0x3b, 0xe7, 0xf2, 0xa2, 0xb0, 0x38, 0x2b, 0x10, 0x4a, 0xaf, 0xdf, 0x14, 0x6e, 0x76, 0xe4, 0xe7,
-	0x96, 0x63, 0x2f, 0xe9, 0x08, 0x94, 0x48, 0x0a, 0xe0, 0x39, 0x60, 0x96, 0x5b, 0x6f, 0x75, 0x42,
-	0x82, 0x7e, 0xfc, 0xd8, 0x81, 0x1f, 0x1b, 0x8c, 0x1d, 0xd0, 0x61, 0xdb, 0xf5, 0x60, 0x42, 0x82,
-	0xf5, 0x69, 0x70, 0x75, 0xb3, 0xd3, 0xfb, 0x7d, 0xb3, 0xb3, 0x9d, 0x6a, 0x23, 0xb5, 0x31, 0xa7,
-	0xe7, 0x21, 0xea, 0x48, 0x0a, 0x9b, 0x87, 0xc7, 0x90, 0x89, 0x74, 0x7e, 0x08, 0xe9, 0xcf, 0xdb,
-	0xcb, 0x5d, 0x12, 0xaf, 0xfd, 0xeb, 0x97, 0x1d, 0xd3, 0x91, 0x44, 0xc5, 0x33, 0x61, 0xf8, 0xac,
-	0xc4, 0x14, 0xbc, 0xb5, 0x25, 0x9d, 0x36, 0x24, 0xaa, 0x0f, 0xc2, 0x9c, 0xdc, 0x15, 0xb3, 0x2f,
-	0x94, 0xdd, 0xbb, 0x3d, 0x78, 0xe9, 0x70, 0x49, 0xcb, 0xb1, 0xb3, 0xec, 0xfe, 0xe3, 0xdd, 0xf3,
-	0xef, 0xb7, 0x97, 0xbb, 0x1e, 0x54, 0x52, 0x9b, 0xe8, 0xdb, 0x83, 0xd5, 0x70, 0x23, 0xfc, 0xb4,
-	0x32, 0x5c, 0x19, 0xaf, 0xc6, 0x63, 0x54, 0x68, 0x51, 0x14, 0xed, 0x2c, 0xa7, 0x47, 0x57, 0x0b,
-	0x9f, 0x5c, 0x2f, 0x7c, 0xf2, 0x67, 0xe1, 0x93, 0x1f, 0xb5, 0xdf, 0xbb, 0xae, 0xfd, 0xde, 0xaf,
-	0xda, 0xef, 0x7d, 0x7d, 0x9d, 0xa1, 0xcd, 0x2f, 0x92, 0x30, 0xd5, 0x32, 0x72, 0xb6, 0x2e, 0x56,
-	0x6f, 0xdf, 0xfc, 0x77, 0x81, 0x9d, 0xcf, 0xc0, 0x24, 0x83, 0x66, 0x69, 0xf6, 0xff, 0x06, 0x00,
-	0x00, 0xff, 0xff, 0x0f, 0xe9, 0x83, 0xfe, 0x9f, 0x02, 0x00, 0x00,
+	// 676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0x8d, 0x49, 0x08, 0xc9, 0x04, 0x3e, 0x85, 0xf9, 0x02, 0xf2, 0x17, 0xf4, 0x19, 0x0b, 0xa4,
+	0x2a, 0x42, 0xd4, 0xe1, 0x67, 0x53, 0x55, 0xea, 0x82, 0xfc, 0xd1, 0x20, 0x5a, 0x22, 0xa7, 0x54,
+	0x55, 0x37, 0xd6, 0xc4, 0xb9, 0x71, 0x46, 0xc4, 0x33, 0x91, 0x67, 0x88, 0xe0, 0x0d, 0xaa, 0xac,
+	0xfa, 0x02, 0xac, 0xba, 0xa9, 0xd4, 0x45, 0x59, 0xf4, 0x21, 0x58, 0xa2, 0xae, 0xaa, 0x2e, 0x50,
+	0x45, 0x16, 0xbc, 0x46, 0x95, 0x71, 0x48, 0x82, 0xd4, 0x2e, 0xd8, 0x8c, 0x72, 0xef, 0x3d, 0xf7,
+	0xe4, 0xf8, 0xcc, 0xb1, 0xd1, 0x13, 0x90, 0x6d, 0x08, 0x7c, 0xca, 0x64, 0xbe, 0x05, 0xe0, 0x93,
+	0xe0, 0x04, 0x64, 0xbe, 0xb7, 0x3d, 0x29, 0xac, 0x6e, 0xc0, 0x25, 0xc7, 0xcb, 0x63, 0x9c, 0x35,
+	0x19, 0xf5, 0xb6, 0xb3, 0x8b, 0xc4, 0xa7, 0x8c, 0xe7, 0xd5, 0x19, 0x42, 0xb3, 0xff, 0xb9, 0x5c,
+	0xf8, 0x5c, 0x38, 0xaa, 0xca, 0x87, 0xc5, 0x68, 0x94, 0xf1, 0xb8, 0xc7, 0xc3, 0xfe, 0xf0, 0x57,
+	0xd8, 0x5d, 0xfb, 0x1a, 0x43, 0xf1, 0x1a, 0x09, 0x88, 0x2f, 0xb0, 0x81, 0x52, 0x8c, 0x3b, 0x0d,
+	0x22, 0xc0, 0x69, 0x01, 0xe8, 0x9a, 0xa9, 0xe5, 0x12, 0x76, 0x92, 0xf1, 0x02, 0x11, 0x50, 0x01,
+	0xc0, 0x2f, 0xd0, 0xca, 0xfd, 0xd0, 0x71, 0xdb, 0x84, 0x79, 0xe0, 0x34, 0x81, 0x71, 0x9f, 0x32,
+	0x22, 0x79, 0xa0, 0xcf, 0x98, 0x5a, 0x6e, 0xc1, 0xd6, 0x1b, 0x21, 0xba, 0xa8, 0x00, 0xa5, 0xc9,
+	0x1c, 0xef, 0xa2, 0x25, 0xe8, 0x10, 0x21, 0xa9, 0x4b, 0xe5, 0xb9, 0xe3, 0x9f, 0x76, 0x24, 0xed,
+	0x76, 0x28, 0x04, 0x7a, 0x54, 0x2d, 0x66, 0x26, 0xc3, 0x57, 0xe3, 0x19, 0x5e, 0x47, 0x0b, 0xc0,
+	0x48, 0xa3, 0x03, 0x4e, 0x1b, 0xa8, 0xd7, 0x96, 0xfa, 0xac, 0xa9, 0xe5, 0xa2, 0xf6, 0x7c, 0xd8,
+	0x7c, 0xa9, 0x7a, 0xb8, 0x88, 0x12, 0x63, 0xd5, 0x71, 0x53, 0xcb, 0x25, 0x0b, 0xb9, 0xab, 0x9b,
+	0xd5, 0xc8, 0xcf, 0x9b, 0xd5, 0x95, 0xd0, 0x01, 0xd1, 0x3c, 0xb1, 0x28, 0xcf, 0xfb, 0x44, 0xb6,
+	0xad, 0x43, 0xf0, 0x88, 0x7b, 0x5e, 0x02, 0xf7, 0xf3, 0xdd, 0xe5, 0x86, 0x66, 0xcf, 0x8d, 0xf4,
+	0xe2, 0x43, 0xb4, 0xe0, 0x53, 0xe6, 0x78, 0x64, 0x68, 0x1e, 0x75, 0x41, 0x9f, 0x7b, 0x24, 0x53,
+	0xca, 0xa7, 0x6c, 0x9f, 0x88, 0xda, 0x70, 0x19, 0xbf, 0x45, 0xf8, 0x9e, 0x6d, 0xea, 0x49, 0x13,
+	0x8f, 0xa4, 0x4c, 0x87, 0x94, 0x53, 0x7e, 0x1c, 0x20, 0x34, 0xb4, 0x5f, 0xf2, 0x13, 0x60, 0x42,
+	0x4f, 0x9a, 0xd1, 0x5c, 0x6a, 0xc7, 0xb4, 0xfe, 0x9c, 0x0f, 0xab, 0x02, 0xf0, 0x66, 0x08, 0x2c,
+	0x24, 0x87, 0xff, 0x18, 0x52, 0x26, 0x5b, 0xa3, 0xa6, 0x78, 0xfe, 0x7f, 0xff, 0xee, 0x72, 0x43,
+	0x87, 0x9e, 0xcf, 0x45, 0xfe, 0x6c, 0x2a, 0x81, 0x61, 0x1c, 0x0e, 0x62, 0x89, 0x58, 0x7a, 0xd6,
+	0x4e, 0x53, 0x46, 0x25, 0x25, 0x9d, 0x71, 0x2e, 0xd6, 0x06, 0x1a, 0x4a, 0xdc, 0x33, 0xe3, 0x0c,
+	0x9a, 0x55, 0x19, 0x50, 0x69, 0x49, 0xda, 0x61, 0x81, 0x2b, 0x68, 0x5e, 0x79, 0xe8, 0x08, 0x7e,
+	0x1a, 0xb8, 0xa0, 0xa2, 0xf1, 0xcf, 0xce, 0xfa, 0xdf, 0x74, 0x2a, 0xcb, 0xea, 0x0a, 0x6a, 0xa7,
+	0xba, 0x93, 0x02, 0xef, 0x23, 0xd4, 0xa2, 0x67, 0xd0, 0x74, 0x02, 0x22, 0x41, 0x8f, 0x3e, 0xd2,
+	0xbd, 0xa4, 0xda, 0xb5, 0x89, 0x04, 0xbc, 0x85, 0xe2, 0x3c, 0x20, 0x6e, 0x07, 0xf4, 0x98, 0x22,
+	0xd1, 0xbf, 0x7f, 0x7b, 0x9a, 0x19, 0xbd, 0x1d, 0x7b, 0xcd, 0x66, 0x00, 0x42, 0xd4, 0x65, 0x40,
+	0x99, 0x67, 0x8f, 0x70, 0x1b, 0x5f, 0x34, 0x94, 0x9a, 0xd2, 0x85, 0x9f, 0x21, 0xbd, 0x66, 0x57,
+	0x8b, 0x65, 0xa7, 0x7e, 0x74, 0x6c, 0x17, 0xcb, 0xce, 0xf1, 0xeb, 0x7a, 0xad, 0x5c, 0xac, 0x56,
+	0xaa, 0xe5, 0x52, 0x3a, 0x92, 0xcd, 0xf6, 0x2f, 0xcc, 0xe5, 0x29, 0xf8, 0x31, 0x13, 0x5d, 0x70,
+	0x69, 0x8b, 0x42, 0x13, 0x6f, 0x22, 0xfc, 0x60, 0xb3, 0x52, 0x7d, 0x57, 0x2e, 0xa5, 0xb5, 0x6c,
+	0xa6, 0x7f, 0x61, 0xa6, 0xa7, 0x76, 0x2a, 0x43, 0xb5, 0xd8, 0x42, 0xff, 0x3e, 0x40, 0x1f, 0xd9,
+	0x7b, 0xc5, 0xc3, 0x72, 0x7a, 0x26, 0xbb, 0xd4, 0xbf, 0x30, 0x17, 0xa7, 0xe0, 0x47, 0x4a, 0x67,
+	0x36, 0xf6, 0xe1, 0x93, 0x11, 0x29, 0x54, 0xae, 0x6e, 0x0d, 0xed, 0xfa, 0xd6, 0xd0, 0x7e, 0xdd,
+	0x1a, 0xda, 0xc7, 0x81, 0x11, 0xb9, 0x1e, 0x18, 0x91, 0x1f, 0x03, 0x23, 0xf2, 0x7e, 0xd3, 0xa3,
+	0xb2, 0x7d, 0xda, 0xb0, 0x5c, 0xee, 0xe7, 0xc3, 0xab, 0x0e, 0xcf, 0xde, 0xce, 0xd6, 0x83, 0x4b,
+	0x97, 0xe7, 0x5d, 0x10, 0x8d, 0xb8, 0xfa, 0x28, 0xec, 0xfe, 0x1e, 0x00, 0xdd, 0x48, 0xc6, 0x45,
+	0x9a, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -166,6 +296,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeTokens) > 0 {
+		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -224,6 +368,58 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Oracle) > 0 {
+		i -= len(m.Oracle)
+		copy(dAtA[i:], m.Oracle)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.Oracle)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.FixedRate.Size()
+		i -= size
+		if _, err := m.FixedRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PriceSource != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.PriceSource))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeemarket(v)
 	base := offset
@@ -259,6 +455,34 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFeemarket(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFeemarket(uint64(l))
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	if m.PriceSource != 0 {
+		n += 1 + sovFeemarket(uint64(m.PriceSource))
+	}
+	l = m.FixedRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = len(m.Oracle)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
 	return n
 }
 
@@ -476,6 +700,207 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokens = append(m.FeeTokens, FeeToken{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSource", wireType)
+			}
+			m.PriceSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceSource |= PriceSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FixedRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
@@ -2,6 +2,12 @@
 // SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultGenesisState sets default fee market genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
@@ -21,5 +27,38 @@ func NewGenesisState(params Params, blockGas uint64) *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenRates := make(map[string]bool, len(gs.OracleRates))
+	for _, rate := range gs.OracleRates {
+		feeToken, found := gs.Params.GetFeeToken(rate.Denom)
+		if !found || feeToken.PriceSource != PriceSourceOracle {
+			return fmt.Errorf("oracle rate of %s, which is not a fee token priced by an oracle", rate.Denom)
+		}
+		if rate.Rate.IsNil() || !rate.Rate.IsPositive() {
+			return fmt.Errorf("oracle rate of %s must be positive: %s", rate.Denom, rate.Rate)
+		}
+		if seenRates[rate.Denom] {
+			return fmt.Errorf("duplicate oracle rate: %s", rate.Denom)
+		}
+		seenRates[rate.Denom] = true
+	}
+
+	seenAccounts := make(map[string]bool, len(gs.FeeTokenPreferences))
+	for _, preference := range gs.FeeTokenPreferences {
+		if _, err := sdk.AccAddressFromBech32(preference.Address); err != nil {
+			return fmt.Errorf("invalid fee token preference address: %w", err)
+		}
+		if _, found := gs.Params.GetFeeToken(preference.Denom); !found {
+			return fmt.Errorf("fee token preference of %s for %s, which is not a fee token", preference.Address, preference.Denom)
+		}
+		if seenAccounts[preference.Address] {
+			return fmt.Errorf("duplicate fee token preference: %s", preference.Address)
+		}
+		seenAccounts[preference.Address] = true
+	}
+
+	return nil
 }
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -31,6 +33,12 @@ type GenesisState struct {
 	// block_gas is the amount of gas wanted on the last block before the upgrade.
 	// Zero by default.
 	BlockGas uint64 `protobuf:"varint,3,opt,name=block_gas,json=blockGas,proto3" json:"block_gas,omitempty"`
+	// oracle_rates defines the conversion rates of the fee tokens set by their
+	// oracles.
+	OracleRates []OracleRate `protobuf:"bytes,4,rep,name=oracle_rates,json=oracleRates,proto3" json:"oracle_rates"`
+	// fee_token_preferences defines the fee tokens chosen by the accounts to pay
+	// the fees of their EVM transactions.
+	FeeTokenPreferences []FeeTokenPreference `protobuf:"bytes,5,rep,name=fee_token_preferences,json=feeTokenPreferences,proto3" json:"fee_token_preferences"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,8 +88,129 @@ func (m *GenesisState) GetBlockGas() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetOracleRates() []OracleRate {
+	if m != nil {
+		return m.OracleRates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeTokenPreferences() []FeeTokenPreference {
+	if m != nil {
+		return m.FeeTokenPreferences
+	}
+	return nil
+}
+
+// OracleRate defines the conversion rate of a fee token set by its oracle
+type OracleRate struct {
+	// denom of the fee token
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the amount of the EVM denom, using 18 decimals, paid by each base
+	// unit of the token
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *OracleRate) Reset()         { *m = OracleRate{} }
+func (m *OracleRate) String() string { return proto.CompactTextString(m) }
+func (*OracleRate) ProtoMessage()    {}
+func (*OracleRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6241c21661288629, []int{1}
+}
+func (m *OracleRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleRate.Merge(m, src)
+}
+func (m *OracleRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleRate proto.InternalMessageInfo
+
+func (m *OracleRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// FeeTokenPreference defines the fee token chosen by an account to pay the fees
+// of its EVM transactions
+type FeeTokenPreference struct {
+	// address of the account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom of the fee token
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *FeeTokenPreference) Reset()         { *m = FeeTokenPreference{} }
+func (m *FeeTokenPreference) String() string { return proto.CompactTextString(m) }
+func (*FeeTokenPreference) ProtoMessage()    {}
+func (*FeeTokenPreference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6241c21661288629, []int{2}
+}
+func (m *FeeTokenPreference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeTokenPreference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTokenPreference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeTokenPreference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTokenPreference.Merge(m, src)
+}
+func (m *FeeTokenPreference) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTokenPreference) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTokenPreference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTokenPreference proto.InternalMessageInfo
+
+func (m *FeeTokenPreference) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FeeTokenPreference) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ethermint.feemarket.v1.GenesisState")
+	proto.RegisterType((*OracleRate)(nil), "ethermint.feemarket.v1.OracleRate")
+	proto.RegisterType((*FeeTokenPreference)(nil), "ethermint.feemarket.v1.FeeTokenPreference")
 }
 
 func init() {
@@ -89,24 +218,36 @@ func init() {
 }
 
 var fileDescriptor_6241c21661288629 = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0x2d, 0xc9, 0x48,
-	0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x4f, 0x4b, 0x4d, 0xcd, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xab, 0xd2, 0x83, 0xab, 0xd2, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc,
-	0xcb, 0xd7, 0x07, 0x93, 0x10, 0xa5, 0x52, 0x6a, 0x38, 0x0c, 0x44, 0xe8, 0x83, 0xa8, 0x13, 0x49,
-	0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0x52, 0x1d, 0x17, 0x8f, 0x3b, 0xc4,
-	0xe6, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x47, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc, 0x62,
-	0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x39, 0x3d, 0xec, 0x2e, 0xd1, 0x0b, 0x00, 0xab, 0x72,
-	0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x8d, 0x42, 0xd2,
-	0x5c, 0x9c, 0x49, 0x39, 0xf9, 0xc9, 0xd9, 0xf1, 0xe9, 0x89, 0xc5, 0x12, 0xcc, 0x0a, 0x8c, 0x1a,
-	0x2c, 0x41, 0x1c, 0x60, 0x01, 0xf7, 0xc4, 0x62, 0x2f, 0x16, 0x0e, 0x26, 0x01, 0xe6, 0x20, 0x8e,
-	0xa4, 0xc4, 0xe2, 0xd4, 0xf8, 0xb4, 0xd4, 0x54, 0x27, 0xb7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c,
-	0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e,
-	0x3c, 0x96, 0x63, 0x88, 0xd2, 0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5,
-	0x4f, 0x2d, 0xcb, 0xcd, 0x2f, 0x86, 0x92, 0x65, 0x46, 0x06, 0xfa, 0x15, 0x48, 0x5e, 0x2d, 0xa9,
-	0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x7b, 0xc7, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x4a,
-	0xda, 0x75, 0x5f, 0x01, 0x00, 0x00,
+	// 459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xc1, 0x6e, 0x13, 0x31,
+	0x10, 0xcd, 0x26, 0x69, 0x49, 0x9c, 0x1e, 0xc0, 0x04, 0xb4, 0xb4, 0xd2, 0x36, 0x8a, 0x10, 0x8a,
+	0x2a, 0xb0, 0x69, 0xb8, 0x72, 0x69, 0x84, 0x5a, 0x09, 0x21, 0x11, 0x6d, 0x39, 0x71, 0x60, 0x71,
+	0x36, 0xb3, 0x9b, 0x55, 0xea, 0x75, 0xe4, 0x31, 0x11, 0xfd, 0x0b, 0x3e, 0x83, 0x23, 0x07, 0x6e,
+	0xfc, 0x40, 0x8f, 0x15, 0x27, 0xc4, 0xa1, 0x42, 0xc9, 0x81, 0xdf, 0x40, 0x6b, 0x87, 0x24, 0x52,
+	0x9a, 0x8b, 0xb5, 0xf3, 0xe6, 0xbd, 0x79, 0x6f, 0xed, 0x21, 0x8f, 0xc1, 0x8c, 0x40, 0xcb, 0x2c,
+	0x37, 0x3c, 0x01, 0x90, 0x42, 0x8f, 0xc1, 0xf0, 0xe9, 0x31, 0x4f, 0x21, 0x07, 0xcc, 0x90, 0x4d,
+	0xb4, 0x32, 0x8a, 0x3e, 0x5c, 0xb2, 0xd8, 0x92, 0xc5, 0xa6, 0xc7, 0xfb, 0xf7, 0x84, 0xcc, 0x72,
+	0xc5, 0xed, 0xe9, 0xa8, 0xfb, 0x8f, 0x62, 0x85, 0x52, 0x61, 0x64, 0x2b, 0xee, 0x8a, 0x45, 0xeb,
+	0xc9, 0x16, 0xaf, 0xd5, 0x48, 0xc7, 0x6b, 0xa6, 0x2a, 0x55, 0x4e, 0x5f, 0x7c, 0x39, 0xb4, 0xfd,
+	0xa3, 0x4c, 0xf6, 0xce, 0x5c, 0xaa, 0x73, 0x23, 0x0c, 0xd0, 0x13, 0xb2, 0x3b, 0x11, 0x5a, 0x48,
+	0xf4, 0xbd, 0x96, 0xd7, 0x69, 0x74, 0x03, 0x76, 0x7b, 0x4a, 0xd6, 0xb7, 0xac, 0x5e, 0xfd, 0xea,
+	0xe6, 0xb0, 0xf4, 0xf5, 0xef, 0xb7, 0x23, 0x2f, 0x5c, 0x08, 0xe9, 0x01, 0xa9, 0x0f, 0x2e, 0x54,
+	0x3c, 0x8e, 0x52, 0x81, 0x7e, 0xa5, 0xe5, 0x75, 0xaa, 0x61, 0xcd, 0x02, 0x67, 0x02, 0x69, 0x9f,
+	0xec, 0x29, 0x2d, 0xe2, 0x0b, 0x88, 0xb4, 0x30, 0x80, 0x7e, 0xb5, 0x55, 0xe9, 0x34, 0xba, 0xed,
+	0x6d, 0x2e, 0x6f, 0x2d, 0x37, 0x14, 0x06, 0xd6, 0x9d, 0x1a, 0x6a, 0x09, 0x23, 0xcd, 0xc8, 0x83,
+	0x04, 0x20, 0x32, 0x6a, 0x0c, 0x79, 0x34, 0xd1, 0x90, 0x80, 0x86, 0x3c, 0x06, 0xf4, 0x77, 0xec,
+	0xe8, 0xa3, 0x6d, 0xa3, 0x4f, 0x01, 0xde, 0x15, 0x9a, 0xfe, 0x52, 0xb2, 0x6e, 0x71, 0x3f, 0xd9,
+	0x68, 0xe3, 0xeb, 0x6a, 0xad, 0x7c, 0xb7, 0x12, 0xd6, 0x06, 0x02, 0x21, 0x4a, 0x00, 0xda, 0x1f,
+	0x09, 0x59, 0x05, 0xa4, 0x4d, 0xb2, 0x33, 0x84, 0x5c, 0x49, 0x7b, 0x73, 0xf5, 0xd0, 0x15, 0xf4,
+	0x25, 0xa9, 0x16, 0x7f, 0xea, 0x97, 0x0b, 0xb0, 0xd7, 0x29, 0x1c, 0x7e, 0xdf, 0x1c, 0x1e, 0xb8,
+	0x37, 0xc4, 0xe1, 0x98, 0x65, 0x8a, 0x4b, 0x61, 0x46, 0xec, 0x0d, 0xa4, 0x22, 0xbe, 0x7c, 0x05,
+	0xb1, 0x0b, 0x60, 0x55, 0xed, 0x0f, 0x84, 0x6e, 0xe6, 0xa4, 0x5d, 0x72, 0x47, 0x0c, 0x87, 0x1a,
+	0xd0, 0xbd, 0x52, 0xbd, 0xe7, 0xff, 0xfc, 0xfe, 0xac, 0xb9, 0x58, 0x8b, 0x13, 0xd7, 0x39, 0x37,
+	0x3a, 0xcb, 0xd3, 0xf0, 0x3f, 0x71, 0x95, 0xae, 0xbc, 0x96, 0xae, 0x77, 0x7a, 0x35, 0x0b, 0xbc,
+	0xeb, 0x59, 0xe0, 0xfd, 0x99, 0x05, 0xde, 0x97, 0x79, 0x50, 0xba, 0x9e, 0x07, 0xa5, 0x5f, 0xf3,
+	0xa0, 0xf4, 0xfe, 0x69, 0x9a, 0x99, 0xd1, 0xa7, 0x01, 0x8b, 0x95, 0xe4, 0x30, 0x95, 0x0a, 0x17,
+	0xe7, 0xb4, 0xfb, 0x9c, 0x7f, 0x5e, 0x5b, 0x35, 0x73, 0x39, 0x01, 0x1c, 0xec, 0xda, 0x75, 0x7a,
+	0xf1, 0x6f, 0x00, 0xab, 0x1c, 0xb4, 0x0a, 0xfa, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -129,6 +270,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeTokenPreferences) > 0 {
+		for iNdEx := len(m.FeeTokenPreferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokenPreferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.OracleRates) > 0 {
+		for iNdEx := len(m.OracleRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.BlockGas != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGas))
 		i--
@@ -147,6 +316,83 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OracleRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeTokenPreference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeTokenPreference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeTokenPreference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -169,6 +415,50 @@ func (m *GenesisState) Size() (n int) {
 	if m.BlockGas != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockGas))
 	}
+	if len(m.OracleRates) > 0 {
+		for _, e := range m.OracleRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeTokenPreferences) > 0 {
+		for _, e := range m.FeeTokenPreferences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OracleRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *FeeTokenPreference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -259,6 +549,304 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleRates = append(m.OracleRates, OracleRate{})
+			if err := m.OracleRates[len(m.OracleRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokenPreferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokenPreferences = append(m.FeeTokenPreferences, FeeTokenPreference{})
+			if err := m.FeeTokenPreferences[len(m.FeeTokenPreferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeTokenPreference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTokenPreference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTokenPreference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,8 @@ package types
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -15,6 +17,13 @@ func TestGenesisTestSuite(t *testing.T) {
 }
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
+	addr := sdk.AccAddress([]byte("account_____________")).String()
+	feeTokenParams := DefaultParams()
+	feeTokenParams.FeeTokens = []FeeToken{
+		NewFixedRateFeeToken("ibc/fixed", math.LegacyOneDec()),
+		NewOracleFeeToken("ibc/oracle", addr),
+	}
+
 	testCases := []struct {
 		name     string
 		genState *GenesisState
@@ -28,11 +37,74 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		{
 			"valid genesis",
 			&GenesisState{
-				DefaultParams(),
-				uint64(1),
+				Params:   DefaultParams(),
+				BlockGas: uint64(1),
+			},
+			true,
+		},
+		{
+			"valid genesis with oracle rates and fee token preferences",
+			&GenesisState{
+				Params:              feeTokenParams,
+				OracleRates:         []OracleRate{{Denom: "ibc/oracle", Rate: math.LegacyOneDec()}},
+				FeeTokenPreferences: []FeeTokenPreference{{Address: addr, Denom: "ibc/fixed"}},
 			},
 			true,
 		},
+		{
+			"oracle rate of a fee token with a fixed rate",
+			&GenesisState{
+				Params:      feeTokenParams,
+				OracleRates: []OracleRate{{Denom: "ibc/fixed", Rate: math.LegacyOneDec()}},
+			},
+			false,
+		},
+		{
+			"non-positive oracle rate",
+			&GenesisState{
+				Params:      feeTokenParams,
+				OracleRates: []OracleRate{{Denom: "ibc/oracle", Rate: math.LegacyZeroDec()}},
+			},
+			false,
+		},
+		{
+			"duplicate oracle rate",
+			&GenesisState{
+				Params: feeTokenParams,
+				OracleRates: []OracleRate{
+					{Denom: "ibc/oracle", Rate: math.LegacyOneDec()},
+					{Denom: "ibc/oracle", Rate: math.LegacyOneDec()},
+				},
+			},
+			false,
+		},
+		{
+			"fee token preference of an unknown fee token",
+			&GenesisState{
+				Params:              feeTokenParams,
+				FeeTokenPreferences: []FeeTokenPreference{{Address: addr, Denom: "ibc/unknown"}},
+			},
+			false,
+		},
+		{
+			"fee token preference with an invalid address",
+			&GenesisState{
+				Params:              feeTokenParams,
+				FeeTokenPreferences: []FeeTokenPreference{{Address: "invalid", Denom: "ibc/fixed"}},
+			},
+			false,
+		},
+		{
+			"duplicate fee token preference",
+			&GenesisState{
+				Params: feeTokenParams,
+				FeeTokenPreferences: []FeeTokenPreference{
+					{Address: addr, Denom: "ibc/fixed"},
+					{Address: addr, Denom: "ibc/oracle"},
+				},
+			},
+			false,
+		},
 		{
 			"valid New genesis",
 			NewGenesisState(
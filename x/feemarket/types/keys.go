@@ -22,6 +22,8 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixFeeTokenRate
+	prefixFeeTokenPreference
 )
 
 const (
@@ -30,7 +32,9 @@ const (
 
 // KVStore key prefixes
 var (
-	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockGasWanted     = []byte{prefixBlockGasWanted}
+	KeyPrefixFeeTokenRate       = []byte{prefixFeeTokenRate}
+	KeyPrefixFeeTokenPreference = []byte{prefixFeeTokenPreference}
 )
 
 // Transient Store key prefixes
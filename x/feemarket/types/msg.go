@@ -7,7 +7,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetFeeTokenRate{}
+	_ sdk.Msg = &MsgSetFeeTokenPreference{}
+)
 
 // ValidateBasic does a sanity check of the provided data
 func (m *MsgUpdateParams) ValidateBasic() error {
@@ -22,3 +26,47 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetFeeTokenRate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Oracle); err != nil {
+		return errorsmod.Wrap(err, "invalid oracle address")
+	}
+
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return errorsmod.Wrap(err, "invalid fee token denom")
+	}
+
+	if m.Rate.IsNil() || !m.Rate.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidFeeTokenRate, "rate must be positive: %s", m.Rate)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetFeeTokenRate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetFeeTokenPreference) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if m.Denom == "" {
+		return nil
+	}
+
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return errorsmod.Wrap(err, "invalid fee token denom")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetFeeTokenPreference) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
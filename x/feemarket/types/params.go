@@ -105,6 +105,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateFeeTokens(p.FeeTokens); err != nil {
+		return err
+	}
+
 	return validateMinGasPrice(p.MinGasPrice)
 }
 
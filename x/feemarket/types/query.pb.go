@@ -275,6 +275,93 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryFeeTokenRateRequest defines the request type for querying the conversion
+// rate of a fee token.
+type QueryFeeTokenRateRequest struct {
+	// denom of the fee token
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryFeeTokenRateRequest) Reset()         { *m = QueryFeeTokenRateRequest{} }
+func (m *QueryFeeTokenRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokenRateRequest) ProtoMessage()    {}
+func (*QueryFeeTokenRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{6}
+}
+func (m *QueryFeeTokenRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTokenRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokenRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeTokenRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokenRateRequest.Merge(m, src)
+}
+func (m *QueryFeeTokenRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokenRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokenRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeTokenRateRequest proto.InternalMessageInfo
+
+func (m *QueryFeeTokenRateRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryFeeTokenRateResponse returns the conversion rate of a fee token.
+type QueryFeeTokenRateResponse struct {
+	// rate is the amount of the EVM denom, using 18 decimals, paid by each base
+	// unit of the token
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *QueryFeeTokenRateResponse) Reset()         { *m = QueryFeeTokenRateResponse{} }
+func (m *QueryFeeTokenRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokenRateResponse) ProtoMessage()    {}
+func (*QueryFeeTokenRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{7}
+}
+func (m *QueryFeeTokenRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTokenRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokenRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeTokenRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokenRateResponse.Merge(m, src)
+}
+func (m *QueryFeeTokenRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokenRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokenRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeTokenRateResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.feemarket.v1.QueryParamsResponse")
@@ -282,6 +369,8 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "ethermint.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "ethermint.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryFeeTokenRateRequest)(nil), "ethermint.feemarket.v1.QueryFeeTokenRateRequest")
+	proto.RegisterType((*QueryFeeTokenRateResponse)(nil), "ethermint.feemarket.v1.QueryFeeTokenRateResponse")
 }
 
 func init() {
@@ -289,37 +378,42 @@ func init() {
 }
 
 var fileDescriptor_71a07c1ffd85fde2 = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x18, 0x4c, 0xdb, 0xf1, 0xa2, 0x63, 0x5a, 0x64, 0x8d, 0x1b, 0x59, 0x54, 0xb4, 0xd6,
-	0x1d, 0x1b, 0x6f, 0xde, 0x0c, 0x52, 0x2f, 0x1e, 0x74, 0x4f, 0xe2, 0xa5, 0x4c, 0xd6, 0xd7, 0xcd,
-	0x92, 0xce, 0xbe, 0xed, 0xce, 0x24, 0x98, 0xab, 0xe0, 0xc5, 0x83, 0x08, 0xfe, 0x09, 0x2f, 0x82,
-	0x3f, 0xa3, 0xc7, 0x82, 0x17, 0xf1, 0x50, 0x24, 0x11, 0xfc, 0x1b, 0x92, 0x99, 0xd9, 0xe8, 0x6a,
-	0xa2, 0xb9, 0x0c, 0x8f, 0xb7, 0xdf, 0xfb, 0xbe, 0xef, 0x7d, 0x8f, 0xa5, 0x01, 0xe8, 0x3e, 0x14,
-	0x32, 0xcd, 0x34, 0x3f, 0x00, 0x90, 0xa2, 0x18, 0x80, 0xe6, 0xa3, 0x5d, 0x7e, 0x34, 0x84, 0x62,
-	0x1c, 0xe6, 0x05, 0x6a, 0x64, 0x5b, 0x73, 0x4c, 0x38, 0xc7, 0x84, 0xa3, 0x5d, 0xef, 0x82, 0x90,
-	0x69, 0x86, 0xdc, 0xbc, 0x16, 0xea, 0xdd, 0x58, 0x42, 0xf7, 0x6b, 0xce, 0xe2, 0x9a, 0x09, 0x26,
-	0x68, 0x4a, 0x3e, 0xab, 0x5c, 0xb7, 0x95, 0x20, 0x26, 0x87, 0xc0, 0x45, 0x9e, 0x72, 0x91, 0x65,
-	0xa8, 0x85, 0x4e, 0x31, 0x53, 0xf6, 0x6b, 0xd0, 0xa4, 0xec, 0xe9, 0xcc, 0xd5, 0x13, 0x51, 0x08,
-	0xa9, 0x22, 0x38, 0x1a, 0x82, 0xd2, 0xc1, 0x33, 0x7a, 0xb1, 0xd2, 0x55, 0x39, 0x66, 0x0a, 0xd8,
-	0x03, 0xda, 0xc8, 0x4d, 0xe7, 0x12, 0xb9, 0x4a, 0x6e, 0x9e, 0xeb, 0xf8, 0xe1, 0xe2, 0x25, 0x42,
-	0x3b, 0xd7, 0xdd, 0x38, 0x3e, 0x6d, 0xd7, 0x3e, 0xfc, 0xf8, 0xb4, 0x4d, 0x22, 0x37, 0x18, 0x6c,
-	0x3a, 0xe6, 0xae, 0x50, 0xb0, 0x07, 0x50, 0x0a, 0x46, 0xb4, 0x59, 0x6d, 0x3b, 0xc5, 0xfb, 0x74,
-	0xbd, 0x27, 0x14, 0xec, 0x1f, 0x00, 0x18, 0xcd, 0x8d, 0x6e, 0xfb, 0xeb, 0x69, 0xfb, 0x72, 0x8c,
-	0x4a, 0xa2, 0x52, 0x2f, 0x06, 0x61, 0x8a, 0x5c, 0x0a, 0xdd, 0x0f, 0x1f, 0x43, 0x22, 0xe2, 0xf1,
-	0x43, 0x88, 0xa3, 0xb5, 0x9e, 0xe5, 0x08, 0xb6, 0x4a, 0xce, 0x43, 0x8c, 0x07, 0x8f, 0xc4, 0x7c,
-	0xb9, 0x5b, 0x74, 0xf3, 0x8f, 0xbe, 0x13, 0x3b, 0x4f, 0xeb, 0x89, 0xb0, 0xbb, 0xd5, 0xa3, 0x59,
-	0xd9, 0xf9, 0x58, 0xa7, 0x67, 0x0d, 0x96, 0xbd, 0x26, 0xb4, 0x61, 0xb7, 0x62, 0xdb, 0xcb, 0xb6,
-	0xfe, 0x3b, 0x48, 0xef, 0xf6, 0x4a, 0x58, 0xab, 0x1f, 0x04, 0xaf, 0x3e, 0x7f, 0x7f, 0x7f, 0xa6,
-	0xc5, 0x3c, 0x0e, 0x23, 0x89, 0xaa, 0x7a, 0x6c, 0x9b, 0x1f, 0x7b, 0x43, 0xe8, 0x9a, 0x0b, 0x89,
-	0xfd, 0x9b, 0xbc, 0x9a, 0xb0, 0xb7, 0xb3, 0x1a, 0xd8, 0x59, 0xb9, 0x66, 0xac, 0xf8, 0xac, 0xb5,
-	0xc8, 0x4a, 0x79, 0x11, 0xf6, 0x96, 0xd0, 0xf5, 0x32, 0x45, 0xf6, 0x1f, 0x81, 0xea, 0x11, 0xbc,
-	0x3b, 0x2b, 0xa2, 0x9d, 0x9f, 0xeb, 0xc6, 0x4f, 0x9b, 0x5d, 0x59, 0xe8, 0x67, 0x86, 0xde, 0x4f,
-	0x84, 0xea, 0xee, 0x1d, 0x4f, 0x7c, 0x72, 0x32, 0xf1, 0xc9, 0xb7, 0x89, 0x4f, 0xde, 0x4d, 0xfd,
-	0xda, 0xc9, 0xd4, 0xaf, 0x7d, 0x99, 0xfa, 0xb5, 0xe7, 0x3b, 0x49, 0xaa, 0xfb, 0xc3, 0x5e, 0x18,
-	0xa3, 0x74, 0x14, 0xf6, 0x1d, 0x75, 0xee, 0xf2, 0x97, 0xbf, 0xd1, 0xe9, 0x71, 0x0e, 0xaa, 0xd7,
-	0x30, 0x3f, 0xc7, 0xbd, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x44, 0x02, 0x20, 0xd0, 0xc9, 0x03,
-	0x00, 0x00,
+	// 549 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0x8f, 0xe9, 0xff, 0x83, 0x01, 0x8e, 0xb4, 0x2a, 0x26, 0x38, 0xe8, 0x54, 0x50, 0x09, 0xc5,
+	0x97, 0x84, 0x0d, 0xb1, 0x10, 0xa1, 0xb0, 0x30, 0x80, 0xc5, 0x00, 0x2c, 0xd1, 0x25, 0x7d, 0x71,
+	0xac, 0xd4, 0xbe, 0xd4, 0x77, 0x89, 0xc8, 0x8a, 0xc4, 0xc2, 0x80, 0x90, 0xf8, 0x00, 0xac, 0x8c,
+	0x7c, 0x8c, 0x6e, 0x54, 0x62, 0x41, 0x0c, 0x15, 0x4a, 0x90, 0xf8, 0x1a, 0xc8, 0x77, 0xe7, 0x50,
+	0x83, 0xdb, 0x86, 0xe5, 0x74, 0x79, 0xf9, 0xfd, 0x7b, 0xf7, 0x9e, 0x8c, 0x08, 0xc8, 0x1e, 0xc4,
+	0x61, 0x10, 0x49, 0xda, 0x05, 0x08, 0x59, 0xdc, 0x07, 0x49, 0x47, 0x35, 0xba, 0x3f, 0x84, 0x78,
+	0xec, 0x0e, 0x62, 0x2e, 0x39, 0xde, 0x98, 0x61, 0xdc, 0x19, 0xc6, 0x1d, 0xd5, 0xec, 0x4b, 0x2c,
+	0x0c, 0x22, 0x4e, 0xd5, 0xa9, 0xa1, 0xf6, 0xcd, 0x13, 0xe4, 0xfe, 0xf0, 0x34, 0xae, 0xe8, 0x73,
+	0x9f, 0xab, 0x2b, 0x4d, 0x6e, 0xa6, 0x5a, 0xf2, 0x39, 0xf7, 0xf7, 0x80, 0xb2, 0x41, 0x40, 0x59,
+	0x14, 0x71, 0xc9, 0x64, 0xc0, 0x23, 0xa1, 0xff, 0x25, 0x45, 0x84, 0x9f, 0x26, 0xa9, 0x9e, 0xb0,
+	0x98, 0x85, 0xc2, 0x83, 0xfd, 0x21, 0x08, 0x49, 0x9e, 0xa3, 0xcb, 0x99, 0xaa, 0x18, 0xf0, 0x48,
+	0x00, 0x7e, 0x80, 0x96, 0x07, 0xaa, 0xb2, 0x69, 0x5d, 0xb7, 0xb6, 0xcf, 0xd7, 0x1d, 0x37, 0xbf,
+	0x09, 0x57, 0xf3, 0x1a, 0x6b, 0x07, 0x47, 0xe5, 0xc2, 0xa7, 0x5f, 0x9f, 0x2b, 0x96, 0x67, 0x88,
+	0x64, 0xdd, 0x28, 0x37, 0x98, 0x80, 0x26, 0x40, 0x6a, 0xe8, 0xa1, 0x62, 0xb6, 0x6c, 0x1c, 0xef,
+	0xa1, 0xd5, 0x36, 0x13, 0xd0, 0xea, 0x02, 0x28, 0xcf, 0xb5, 0x46, 0xf9, 0xfb, 0x51, 0xf9, 0x6a,
+	0x87, 0x8b, 0x90, 0x0b, 0xb1, 0xdb, 0x77, 0x03, 0x4e, 0x43, 0x26, 0x7b, 0xee, 0x63, 0xf0, 0x59,
+	0x67, 0xfc, 0x10, 0x3a, 0xde, 0x4a, 0x5b, 0x6b, 0x90, 0x8d, 0x54, 0x73, 0x8f, 0x77, 0xfa, 0x8f,
+	0xd8, 0xac, 0xb9, 0x5b, 0x68, 0xfd, 0xaf, 0xba, 0x31, 0xbb, 0x88, 0x16, 0x7c, 0xa6, 0x7b, 0x5b,
+	0xf0, 0x92, 0x2b, 0xa9, 0xa2, 0x4d, 0x05, 0x6d, 0x02, 0x3c, 0xe3, 0x7d, 0x88, 0x3c, 0x26, 0xd3,
+	0xc8, 0xb8, 0x88, 0x96, 0x76, 0x21, 0xe2, 0xa1, 0xce, 0xe5, 0xe9, 0x1f, 0xe4, 0x05, 0xba, 0x92,
+	0xc3, 0x30, 0x06, 0xf7, 0xd1, 0x62, 0xcc, 0x64, 0xda, 0xc9, 0x76, 0xf2, 0x3a, 0x67, 0x74, 0xa3,
+	0x1f, 0x4f, 0xb1, 0xea, 0x5f, 0x16, 0xd1, 0x92, 0xd2, 0xc6, 0x6f, 0x2c, 0xb4, 0xac, 0x9f, 0x18,
+	0x57, 0x4e, 0x1a, 0xc1, 0xbf, 0x53, 0xb5, 0x6f, 0xcf, 0x85, 0xd5, 0x59, 0x09, 0x79, 0xfd, 0xf5,
+	0xe7, 0x87, 0x73, 0x25, 0x6c, 0x53, 0x18, 0x85, 0x5c, 0x64, 0x37, 0x4f, 0x0f, 0x13, 0xbf, 0xb5,
+	0xd0, 0x8a, 0x99, 0x18, 0x3e, 0x5d, 0x3c, 0x3b, 0x6e, 0x7b, 0x67, 0x3e, 0xb0, 0x89, 0xb2, 0xa5,
+	0xa2, 0x38, 0xb8, 0x94, 0x17, 0x25, 0x5d, 0x0f, 0xfc, 0xce, 0x42, 0xab, 0xe9, 0x48, 0xf1, 0x19,
+	0x06, 0xd9, 0x8d, 0xb0, 0xef, 0xcc, 0x89, 0x36, 0x79, 0x6e, 0xa8, 0x3c, 0x65, 0x7c, 0x2d, 0x37,
+	0x4f, 0x82, 0x6e, 0xf9, 0x4c, 0xe0, 0x8f, 0x16, 0xba, 0x70, 0x7c, 0x0d, 0x70, 0xf5, 0x54, 0x9b,
+	0x9c, 0x1d, 0xb3, 0x6b, 0xff, 0xc1, 0x30, 0xe1, 0x2a, 0x2a, 0xdc, 0x16, 0x26, 0x79, 0xe1, 0xba,
+	0x00, 0x2d, 0x99, 0x50, 0x5a, 0x31, 0x93, 0xd0, 0x68, 0x1e, 0x4c, 0x1c, 0xeb, 0x70, 0xe2, 0x58,
+	0x3f, 0x26, 0x8e, 0xf5, 0x7e, 0xea, 0x14, 0x0e, 0xa7, 0x4e, 0xe1, 0xdb, 0xd4, 0x29, 0xbc, 0xdc,
+	0xf1, 0x03, 0xd9, 0x1b, 0xb6, 0xdd, 0x0e, 0x0f, 0x8d, 0x8e, 0x3e, 0x47, 0xf5, 0x2a, 0x7d, 0x75,
+	0x4c, 0x53, 0x8e, 0x07, 0x20, 0xda, 0xcb, 0xea, 0x5b, 0x72, 0xf7, 0xf7, 0x00, 0x77, 0x3c, 0x6b,
+	0xad, 0xf8, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -340,6 +434,8 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// FeeTokenRate queries the current conversion rate of a fee token
+	FeeTokenRate(ctx context.Context, in *QueryFeeTokenRateRequest, opts ...grpc.CallOption) (*QueryFeeTokenRateResponse, error)
 }
 
 type queryClient struct {
@@ -377,6 +473,15 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) FeeTokenRate(ctx context.Context, in *QueryFeeTokenRateRequest, opts ...grpc.CallOption) (*QueryFeeTokenRateResponse, error) {
+	out := new(QueryFeeTokenRateResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/FeeTokenRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
@@ -385,6 +490,8 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// FeeTokenRate queries the current conversion rate of a fee token
+	FeeTokenRate(context.Context, *QueryFeeTokenRateRequest) (*QueryFeeTokenRateResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -400,6 +507,9 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) FeeTokenRate(ctx context.Context, req *QueryFeeTokenRateRequest) (*QueryFeeTokenRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeTokenRate not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -459,6 +569,25 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeTokenRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTokenRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeTokenRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/FeeTokenRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeTokenRate(ctx, req.(*QueryFeeTokenRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -475,6 +604,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "FeeTokenRate",
+			Handler:    _Query_FeeTokenRate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/query.proto",
@@ -645,6 +778,69 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFeeTokenRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokenRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokenRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTokenRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokenRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokenRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -719,6 +915,30 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFeeTokenRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeTokenRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1113,6 +1333,172 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFeeTokenRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokenRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokenRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTokenRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokenRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokenRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -125,38 +126,245 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetFeeTokenRate defines a Msg for the oracle of a fee token to update its
+// conversion rate.
+type MsgSetFeeTokenRate struct {
+	// oracle is the address of the oracle of the fee token.
+	Oracle string `protobuf:"bytes,1,opt,name=oracle,proto3" json:"oracle,omitempty"`
+	// denom of the fee token.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the amount of the EVM denom, using 18 decimals, paid by each base
+	// unit of the token.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *MsgSetFeeTokenRate) Reset()         { *m = MsgSetFeeTokenRate{} }
+func (m *MsgSetFeeTokenRate) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeTokenRate) ProtoMessage()    {}
+func (*MsgSetFeeTokenRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78aff2584dbf2838, []int{2}
+}
+func (m *MsgSetFeeTokenRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeTokenRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeTokenRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeTokenRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeTokenRate.Merge(m, src)
+}
+func (m *MsgSetFeeTokenRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeTokenRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeTokenRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeTokenRate proto.InternalMessageInfo
+
+func (m *MsgSetFeeTokenRate) GetOracle() string {
+	if m != nil {
+		return m.Oracle
+	}
+	return ""
+}
+
+func (m *MsgSetFeeTokenRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgSetFeeTokenRateResponse defines the response structure for executing a
+// MsgSetFeeTokenRate message.
+type MsgSetFeeTokenRateResponse struct {
+}
+
+func (m *MsgSetFeeTokenRateResponse) Reset()         { *m = MsgSetFeeTokenRateResponse{} }
+func (m *MsgSetFeeTokenRateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeTokenRateResponse) ProtoMessage()    {}
+func (*MsgSetFeeTokenRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78aff2584dbf2838, []int{3}
+}
+func (m *MsgSetFeeTokenRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeTokenRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeTokenRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeTokenRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeTokenRateResponse.Merge(m, src)
+}
+func (m *MsgSetFeeTokenRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeTokenRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeTokenRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeTokenRateResponse proto.InternalMessageInfo
+
+// MsgSetFeeTokenPreference defines a Msg for an account to choose the fee token
+// used to pay the fees of its EVM transactions.
+type MsgSetFeeTokenPreference struct {
+	// sender is the address of the account paying the fees.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// denom of the fee token. The fees are paid in the EVM denom if it's empty.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgSetFeeTokenPreference) Reset()         { *m = MsgSetFeeTokenPreference{} }
+func (m *MsgSetFeeTokenPreference) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeTokenPreference) ProtoMessage()    {}
+func (*MsgSetFeeTokenPreference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78aff2584dbf2838, []int{4}
+}
+func (m *MsgSetFeeTokenPreference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeTokenPreference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeTokenPreference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeTokenPreference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeTokenPreference.Merge(m, src)
+}
+func (m *MsgSetFeeTokenPreference) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeTokenPreference) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeTokenPreference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeTokenPreference proto.InternalMessageInfo
+
+func (m *MsgSetFeeTokenPreference) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetFeeTokenPreference) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgSetFeeTokenPreferenceResponse defines the response structure for executing
+// a MsgSetFeeTokenPreference message.
+type MsgSetFeeTokenPreferenceResponse struct {
+}
+
+func (m *MsgSetFeeTokenPreferenceResponse) Reset()         { *m = MsgSetFeeTokenPreferenceResponse{} }
+func (m *MsgSetFeeTokenPreferenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeTokenPreferenceResponse) ProtoMessage()    {}
+func (*MsgSetFeeTokenPreferenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78aff2584dbf2838, []int{5}
+}
+func (m *MsgSetFeeTokenPreferenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeTokenPreferenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeTokenPreferenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeTokenPreferenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeTokenPreferenceResponse.Merge(m, src)
+}
+func (m *MsgSetFeeTokenPreferenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeTokenPreferenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeTokenPreferenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeTokenPreferenceResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "ethermint.feemarket.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ethermint.feemarket.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetFeeTokenRate)(nil), "ethermint.feemarket.v1.MsgSetFeeTokenRate")
+	proto.RegisterType((*MsgSetFeeTokenRateResponse)(nil), "ethermint.feemarket.v1.MsgSetFeeTokenRateResponse")
+	proto.RegisterType((*MsgSetFeeTokenPreference)(nil), "ethermint.feemarket.v1.MsgSetFeeTokenPreference")
+	proto.RegisterType((*MsgSetFeeTokenPreferenceResponse)(nil), "ethermint.feemarket.v1.MsgSetFeeTokenPreferenceResponse")
 }
 
 func init() { proto.RegisterFile("ethermint/feemarket/v1/tx.proto", fileDescriptor_78aff2584dbf2838) }
 
 var fileDescriptor_78aff2584dbf2838 = []byte{
-	// 355 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x2d, 0xc9, 0x48,
-	0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x4f, 0x4b, 0x4d, 0xcd, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1,
-	0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83, 0x2b, 0xd0,
-	0x83, 0x2b, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10,
-	0xa5, 0x52, 0xe2, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xfa, 0xb9, 0xc5, 0xe9, 0x20, 0x23, 0x72,
-	0x8b, 0xd3, 0xa1, 0x12, 0x92, 0x10, 0x89, 0x78, 0x30, 0x4f, 0x1f, 0xc2, 0x81, 0x4a, 0xa9, 0xe1,
-	0xb0, 0x1f, 0x61, 0x17, 0x44, 0x9d, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x44, 0x3f, 0x88, 0x05, 0x11,
-	0x55, 0x3a, 0xc4, 0xc8, 0xc5, 0xef, 0x5b, 0x9c, 0x1e, 0x5a, 0x90, 0x92, 0x58, 0x92, 0x1a, 0x90,
-	0x58, 0x94, 0x98, 0x5b, 0x2c, 0x64, 0xc6, 0xc5, 0x99, 0x58, 0x5a, 0x92, 0x91, 0x5f, 0x94, 0x59,
-	0x52, 0x29, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0x71, 0x69, 0x8b, 0xae, 0x08, 0xd4, 0x5a,
-	0xc7, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0xf4, 0x20, 0x84, 0x52,
-	0x21, 0x47, 0x2e, 0xb6, 0x02, 0xb0, 0x09, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x72, 0x7a,
-	0xd8, 0x7d, 0xae, 0x07, 0xb1, 0xc7, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68,
-	0x31, 0x06, 0x41, 0x35, 0x5a, 0x99, 0x34, 0x3d, 0xdf, 0xa0, 0x85, 0x30, 0xb2, 0xeb, 0xf9, 0x06,
-	0x2d, 0xc5, 0xd4, 0x32, 0x50, 0x90, 0x54, 0x20, 0xf9, 0x0e, 0xcd, 0xc1, 0x4a, 0x92, 0x5c, 0xe2,
-	0x68, 0x42, 0x41, 0xa9, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x46, 0x65, 0x5c, 0xcc, 0xbe, 0xc5,
-	0xe9, 0x42, 0x19, 0x5c, 0x3c, 0x28, 0x5e, 0x54, 0xc7, 0xe5, 0x34, 0x34, 0x73, 0xa4, 0xf4, 0x89,
-	0x54, 0x08, 0xb3, 0x50, 0x8a, 0xb5, 0x01, 0xe4, 0x21, 0x27, 0xb7, 0x13, 0x8f, 0xe4, 0x18, 0x2f,
-	0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18,
-	0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf,
-	0xd5, 0x87, 0x78, 0x0d, 0x42, 0x96, 0x19, 0x19, 0xa0, 0x78, 0xb2, 0xa4, 0xb2, 0x20, 0xb5, 0x38,
-	0x89, 0x0d, 0x1c, 0x4d, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x58, 0x97, 0xd3, 0x06, 0x66,
-	0x02, 0x00, 0x00,
+	// 549 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6f, 0x12, 0x41,
+	0x14, 0xc7, 0x99, 0xd6, 0x36, 0x61, 0x34, 0x69, 0xdc, 0xa0, 0xa5, 0xab, 0x59, 0x70, 0x63, 0x94,
+	0x10, 0xdd, 0x6d, 0xd1, 0x18, 0x43, 0xbc, 0x94, 0x98, 0x9e, 0x24, 0x69, 0xa8, 0x5e, 0xbc, 0x98,
+	0xe9, 0xee, 0xeb, 0xb0, 0xc1, 0xd9, 0xc1, 0x99, 0x29, 0x29, 0x37, 0xa3, 0x37, 0x4f, 0x7e, 0x02,
+	0xcf, 0x1e, 0x39, 0xf8, 0x09, 0x3c, 0xf5, 0x48, 0x3c, 0x19, 0x0f, 0x8d, 0x81, 0x03, 0x47, 0xbf,
+	0x82, 0xd9, 0x9d, 0x05, 0x64, 0x05, 0xc1, 0xcb, 0x84, 0x99, 0xf7, 0x7f, 0xef, 0xfd, 0x7f, 0xbc,
+	0x97, 0xc5, 0x05, 0x50, 0x4d, 0x10, 0x2c, 0x08, 0x95, 0x7b, 0x02, 0xc0, 0x88, 0x68, 0x81, 0x72,
+	0x3b, 0x7b, 0xae, 0x3a, 0x73, 0xda, 0x82, 0x2b, 0x6e, 0x5c, 0x9f, 0x08, 0x9c, 0x89, 0xc0, 0xe9,
+	0xec, 0x99, 0x57, 0x09, 0x0b, 0x42, 0xee, 0xc6, 0xa7, 0x96, 0x9a, 0xdb, 0x1e, 0x97, 0x8c, 0x4b,
+	0x97, 0x49, 0x1a, 0x95, 0x60, 0x92, 0x26, 0x81, 0x1d, 0x1d, 0x78, 0x15, 0xdf, 0x5c, 0x7d, 0x49,
+	0x42, 0x77, 0x16, 0xf4, 0x9f, 0xf6, 0xd2, 0xba, 0x1c, 0xe5, 0x94, 0xeb, 0xfc, 0xe8, 0x97, 0x7e,
+	0xb5, 0xbf, 0x22, 0xbc, 0x55, 0x97, 0xf4, 0x45, 0xdb, 0x27, 0x0a, 0x0e, 0x89, 0x20, 0x4c, 0x1a,
+	0x8f, 0x70, 0x96, 0x9c, 0xaa, 0x26, 0x17, 0x81, 0xea, 0xe6, 0x51, 0x11, 0x95, 0xb2, 0xb5, 0xfc,
+	0xb7, 0x2f, 0xf7, 0x73, 0x49, 0xdb, 0x7d, 0xdf, 0x17, 0x20, 0xe5, 0x91, 0x12, 0x41, 0x48, 0x1b,
+	0x53, 0xa9, 0xb1, 0x8f, 0x37, 0xdb, 0x71, 0x85, 0xfc, 0x5a, 0x11, 0x95, 0x2e, 0x57, 0x2c, 0x67,
+	0x3e, 0xb9, 0xa3, 0xfb, 0xd4, 0xb2, 0xe7, 0x17, 0x85, 0xcc, 0xe7, 0x51, 0xaf, 0x8c, 0x1a, 0x49,
+	0x62, 0xf5, 0xe1, 0xbb, 0x51, 0xaf, 0x3c, 0x2d, 0xf9, 0x61, 0xd4, 0x2b, 0xdf, 0x82, 0x4e, 0xf4,
+	0x97, 0x9c, 0xfd, 0x41, 0x97, 0x32, 0x6c, 0xef, 0xe0, 0xed, 0xd4, 0x53, 0x03, 0x64, 0x9b, 0x87,
+	0x12, 0xec, 0x3e, 0xc2, 0x46, 0x5d, 0xd2, 0x23, 0x50, 0x07, 0x00, 0xcf, 0x79, 0x0b, 0xc2, 0x06,
+	0x51, 0x60, 0xec, 0xe2, 0x4d, 0x2e, 0x88, 0xf7, 0x1a, 0x96, 0xf2, 0x25, 0x3a, 0x23, 0x87, 0x37,
+	0x7c, 0x08, 0x39, 0x8b, 0xd9, 0xb2, 0x0d, 0x7d, 0x31, 0x9e, 0xe0, 0x4b, 0x82, 0x28, 0xc8, 0xaf,
+	0xc7, 0x55, 0x4a, 0x11, 0xd0, 0x8f, 0x8b, 0xc2, 0x0d, 0x5d, 0x49, 0xfa, 0x2d, 0x27, 0xe0, 0x2e,
+	0x23, 0xaa, 0xe9, 0x3c, 0x03, 0x4a, 0xbc, 0xee, 0x53, 0xf0, 0x34, 0x6f, 0x9c, 0xa5, 0x69, 0x93,
+	0x06, 0x11, 0xea, 0xed, 0xb9, 0xa8, 0x29, 0xef, 0xf6, 0x4d, 0x6c, 0xfe, 0xfd, 0x3a, 0x01, 0xfe,
+	0x84, 0x70, 0x7e, 0x36, 0x7c, 0x28, 0xe0, 0x04, 0x04, 0x84, 0x5e, 0x8c, 0x2d, 0x21, 0xf4, 0x41,
+	0x2c, 0xc7, 0xd6, 0xba, 0xf9, 0xd8, 0xd5, 0x6a, 0x6c, 0x5c, 0x4b, 0x22, 0xe3, 0xe5, 0x65, 0xc6,
+	0xa7, 0x1e, 0x6c, 0x1b, 0x17, 0x17, 0xc5, 0xc6, 0x10, 0x95, 0x5f, 0x6b, 0x78, 0xbd, 0x2e, 0xa9,
+	0xd1, 0xc4, 0x57, 0x66, 0x36, 0xf3, 0xee, 0xa2, 0x8d, 0x4a, 0x8d, 0xdf, 0x74, 0x57, 0x14, 0x8e,
+	0x3b, 0x1a, 0x6f, 0xf0, 0x56, 0x7a, 0x47, 0xca, 0xff, 0xa8, 0x91, 0xd2, 0x9a, 0x95, 0xd5, 0xb5,
+	0x93, 0x96, 0xef, 0x11, 0xbe, 0xb6, 0x60, 0x4c, 0xab, 0x55, 0x9b, 0x66, 0x98, 0x8f, 0xff, 0x37,
+	0x63, 0xec, 0xc2, 0xdc, 0x78, 0x1b, 0x2d, 0x64, 0xed, 0xe0, 0x7c, 0x60, 0xa1, 0xfe, 0xc0, 0x42,
+	0x3f, 0x07, 0x16, 0xfa, 0x38, 0xb4, 0x32, 0xfd, 0xa1, 0x95, 0xf9, 0x3e, 0xb4, 0x32, 0x2f, 0xef,
+	0xd1, 0x40, 0x35, 0x4f, 0x8f, 0x1d, 0x8f, 0x33, 0x57, 0x8f, 0x59, 0x9f, 0x9d, 0xca, 0xee, 0xcc,
+	0xc0, 0x55, 0xb7, 0x0d, 0xf2, 0x78, 0x33, 0xfe, 0xac, 0x3c, 0xf8, 0x3d, 0x00, 0xf9, 0x76, 0x9c,
+	0x01, 0x16, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -174,6 +382,12 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/feemarket module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetFeeTokenRate defines a method for the oracle of a fee token to update
+	// its conversion rate to the EVM denom.
+	SetFeeTokenRate(ctx context.Context, in *MsgSetFeeTokenRate, opts ...grpc.CallOption) (*MsgSetFeeTokenRateResponse, error)
+	// SetFeeTokenPreference defines a method for an account to choose the fee
+	// token used to pay the fees of its EVM transactions.
+	SetFeeTokenPreference(ctx context.Context, in *MsgSetFeeTokenPreference, opts ...grpc.CallOption) (*MsgSetFeeTokenPreferenceResponse, error)
 }
 
 type msgClient struct {
@@ -193,11 +407,35 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetFeeTokenRate(ctx context.Context, in *MsgSetFeeTokenRate, opts ...grpc.CallOption) (*MsgSetFeeTokenRateResponse, error) {
+	out := new(MsgSetFeeTokenRateResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Msg/SetFeeTokenRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeeTokenPreference(ctx context.Context, in *MsgSetFeeTokenPreference, opts ...grpc.CallOption) (*MsgSetFeeTokenPreferenceResponse, error) {
+	out := new(MsgSetFeeTokenPreferenceResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Msg/SetFeeTokenPreference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/feemarket module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetFeeTokenRate defines a method for the oracle of a fee token to update
+	// its conversion rate to the EVM denom.
+	SetFeeTokenRate(context.Context, *MsgSetFeeTokenRate) (*MsgSetFeeTokenRateResponse, error)
+	// SetFeeTokenPreference defines a method for an account to choose the fee
+	// token used to pay the fees of its EVM transactions.
+	SetFeeTokenPreference(context.Context, *MsgSetFeeTokenPreference) (*MsgSetFeeTokenPreferenceResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -207,6 +445,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetFeeTokenRate(ctx context.Context, req *MsgSetFeeTokenRate) (*MsgSetFeeTokenRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeTokenRate not implemented")
+}
+func (*UnimplementedMsgServer) SetFeeTokenPreference(ctx context.Context, req *MsgSetFeeTokenPreference) (*MsgSetFeeTokenPreferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeTokenPreference not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -230,6 +474,43 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetFeeTokenRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeTokenRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeTokenRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Msg/SetFeeTokenRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeTokenRate(ctx, req.(*MsgSetFeeTokenRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeTokenPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeTokenPreference)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeTokenPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Msg/SetFeeTokenPreference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeTokenPreference(ctx, req.(*MsgSetFeeTokenPreference))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.feemarket.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -238,6 +519,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetFeeTokenRate",
+			Handler:    _Msg_SetFeeTokenRate_Handler,
+		},
+		{
+			MethodName: "SetFeeTokenPreference",
+			Handler:    _Msg_SetFeeTokenPreference_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/tx.proto",
@@ -306,6 +595,136 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetFeeTokenRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeTokenRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeTokenRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Oracle) > 0 {
+		i -= len(m.Oracle)
+		copy(dAtA[i:], m.Oracle)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Oracle)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeTokenRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeTokenRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeTokenRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeTokenPreference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeTokenPreference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeTokenPreference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeTokenPreferenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeTokenPreferenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeTokenPreferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -341,17 +760,71 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+func (m *MsgSetFeeTokenRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Oracle)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetFeeTokenRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFeeTokenPreference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetFeeTokenPreferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -512,6 +985,368 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetFeeTokenRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeTokenRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeTokenRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeeTokenRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeTokenRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeTokenRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeeTokenPreference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeTokenPreference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeTokenPreference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeeTokenPreferenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeTokenPreferenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeTokenPreferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0